@@ -5,6 +5,7 @@ import (
 	"flag"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/sirupsen/logrus"
@@ -16,16 +17,25 @@ import (
 )
 
 func main() {
+	// The verify subcommand checks the stored chain and exits
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		runVerify(os.Args[2:])
+		return
+	}
+
 	configPath := flag.String("config", "", "Path to the config file, if empty string defaults will be used")
+	watchConfig := flag.Duration("watch-config", 0, "Interval to check the config file for changes, 0 only reloads on SIGHUP")
+	recordFile := flag.String("record", "", "Record all lean node traffic to this archive file")
+	replayFile := flag.String("replay", "", "Replay a recorded traffic archive instead of contacting the lean nodes")
+	replaySpeed := flag.Float64("replay-speed", 0, "Replay speed relative to the recording, defaults to 1")
 	flag.Parse()
 
-	// Initialize logger
-	logger := utils.NewLogger()
-	logger.Infof("Starting PQ Devnet Visualizer backend...")
-
-	// Setup graceful shutdown context
-	ctx, cancel := setupSignalHandling(logger)
-	defer cancel()
+	// The flags take precedence over the config file the same way the environment does
+	setFlagEnv("INDEXER_RECORD_FILE", *recordFile)
+	setFlagEnv("INDEXER_REPLAY_FILE", *replayFile)
+	if *replaySpeed != 0 {
+		setFlagEnv("INDEXER_REPLAY_SPEED", strconv.FormatFloat(*replaySpeed, 'f', -1, 64))
+	}
 
 	// Parse config file
 	cfg := &types.Config{}
@@ -34,11 +44,29 @@ func main() {
 		logrus.Fatalf("error reading config file: %v", err)
 	}
 
+	// Initialize logger
+	logger, err := utils.NewLogger(&cfg.Logging)
+	if err != nil {
+		logrus.Fatalf("error initializing logger: %v", err)
+	}
+	logger.Infof("Starting PQ Devnet Visualizer backend...")
+
+	// Setup graceful shutdown context
+	ctx, cancel := setupSignalHandling(logger)
+	defer cancel()
+
 	// Initialize database instances
 	db.InitDB(&cfg.Database)
 
-	indexerInstance := indexer.NewIndexer(cfg, logger.WithField("service", "indexer"))
-	serverInstance := server.NewServer(indexerInstance, logger.WithField("service", "http"))
+	indexerInstance, err := indexer.NewIndexer(cfg, logger.WithField("service", "indexer"))
+	if err != nil {
+		logger.WithError(err).Fatalf("Indexer error")
+	}
+	serverInstance := server.NewServer(cfg, indexerInstance, logger.WithField("service", "http"))
+
+	// Reload config on SIGHUP or file change
+	reloader := newConfigReloader(*configPath, cfg, indexerInstance, serverInstance, logger)
+	go reloader.Run(ctx, *watchConfig)
 
 	go func() {
 		if err := indexerInstance.Start(ctx); err != nil {
@@ -53,6 +81,16 @@ func main() {
 	logger.Infof("PQ Devnet Visualizer backend terminated")
 }
 
+// setFlagEnv sets the environment variable of a config field given as a command line flag
+func setFlagEnv(key, value string) {
+	if value == "" {
+		return
+	}
+	if err := os.Setenv(key, value); err != nil {
+		logrus.Fatalf("error applying command line flag: %v", err)
+	}
+}
+
 // setupSignalHandling creates a context that cancels on interrupt signals
 func setupSignalHandling(logger logrus.FieldLogger) (context.Context, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
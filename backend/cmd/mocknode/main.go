@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/mocknode"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:5052", "Address of the first node, further nodes listen on the following ports")
+	count := flag.Int("count", 1, "Number of nodes to serve, all follow the same chain")
+	genesisTime := flag.Int64("genesis-time", 0, "Unix timestamp of slot 0, 0 uses the start time")
+	slotDuration := flag.Duration("slot-duration", 4*time.Second, "Slot duration")
+	validators := flag.Uint64("validators", 4, "Number of validators proposing in round robin order")
+	missedSlots := flag.Float64("missed-slot-prob", 0, "Probability that a slot has no block")
+	latency := flag.Duration("latency", 0, "Delay of every response")
+	errorRate := flag.Float64("error-rate", 0, "Probability that a request fails with status 500")
+	serveSSZ := flag.Bool("ssz", false, "Serve SSZ to requests accepting application/octet-stream")
+	seed := flag.Uint64("seed", 1, "Seed of the simulated chain")
+	fork := flag.String("fork", "devnet0", "Block layout from genesis, devnet0 or devnet1")
+	devnet1Slot := flag.Uint64("devnet1-slot", 0, "Slot from which blocks use the devnet1 layout, 0 keeps the genesis layout")
+	flag.Parse()
+
+	logger := logrus.New()
+
+	host, portText, err := net.SplitHostPort(*listen)
+	if err != nil {
+		logger.Fatalf("invalid listen address %v: %v", *listen, err)
+	}
+	port, err := strconv.Atoi(portText)
+	if err != nil {
+		logger.Fatalf("invalid listen port %v: %v", portText, err)
+	}
+
+	var activations []types.ForkActivation
+	if *devnet1Slot > 0 {
+		activations = append(activations, types.ForkActivation{Fork: types.ForkDevnet1, Slot: *devnet1Slot})
+	}
+	forks, err := types.NewForkSchedule(*fork, activations)
+	if err != nil {
+		logger.Fatalf("invalid fork schedule: %v", err)
+	}
+
+	genesis := time.Now().Truncate(time.Second)
+	if *genesisTime > 0 {
+		genesis = time.Unix(*genesisTime, 0)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	servers := make([]*http.Server, *count)
+	for i := range servers {
+		node := mocknode.New(mocknode.Config{
+			GenesisTime:           genesis,
+			SlotDuration:          *slotDuration,
+			Validators:            *validators,
+			MissedSlotProbability: *missedSlots,
+			Latency:               *latency,
+			ErrorRate:             *errorRate,
+			ServeSSZ:              *serveSSZ,
+			Seed:                  *seed,
+			Forks:                 forks,
+		})
+
+		address := net.JoinHostPort(host, strconv.Itoa(port+i))
+		servers[i] = &http.Server{
+			Addr:              address,
+			Handler:           node.Handler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		go func(server *http.Server) {
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.WithError(err).Error("Mock node stopped")
+				cancel()
+			}
+		}(servers[i])
+
+		logger.WithField("url", fmt.Sprintf("http://%s", address)).Info("Mock node listening")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"genesis_time":  genesis.Unix(),
+		"slot_duration": *slotDuration,
+	}).Info("Simulated chain started, set chain.genesisTime to match")
+
+	<-ctx.Done()
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	for _, server := range servers {
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.WithError(err).Warn("Error shutting down mock node")
+		}
+	}
+}
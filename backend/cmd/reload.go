@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/server"
+	"github.com/syjn99/leanView/backend/types"
+	"github.com/syjn99/leanView/backend/utils"
+)
+
+// configReloader re-reads the config on SIGHUP or file change and applies the
+// settings that can change at runtime: endpoints, log level and format, poll
+// interval, alert webhook and CORS origins. Everything else is reported as
+// requiring a restart.
+type configReloader struct {
+	path    string
+	current *types.Config
+	indexer *indexer.Indexer
+	server  *server.Server
+	logger  logrus.FieldLogger
+}
+
+// newConfigReloader creates a reloader starting from the already applied config
+func newConfigReloader(path string, current *types.Config, indexer *indexer.Indexer, server *server.Server, logger logrus.FieldLogger) *configReloader {
+	return &configReloader{
+		path:    path,
+		current: current,
+		indexer: indexer,
+		server:  server,
+		logger:  logger.WithField("component", "config_reloader"),
+	}
+}
+
+// Run reloads on SIGHUP, and on file modification if watchInterval is positive, until ctx is done
+func (r *configReloader) Run(ctx context.Context, watchInterval time.Duration) {
+	hangupChan := make(chan os.Signal, 1)
+	signal.Notify(hangupChan, syscall.SIGHUP)
+	defer signal.Stop(hangupChan)
+
+	var watchChan <-chan time.Time
+	var lastModTime time.Time
+	if watchInterval > 0 && r.path != "" {
+		ticker := time.NewTicker(watchInterval)
+		defer ticker.Stop()
+		watchChan = ticker.C
+		lastModTime = r.modTime()
+		r.logger.WithField("interval", watchInterval).Info("Watching config file for changes")
+	}
+
+	for {
+		select {
+		case <-hangupChan:
+			r.logger.Info("Received SIGHUP, reloading config")
+			r.reload()
+		case <-watchChan:
+			modTime := r.modTime()
+			if modTime.IsZero() || modTime.Equal(lastModTime) {
+				continue
+			}
+			lastModTime = modTime
+			r.logger.Info("Config file changed, reloading config")
+			r.reload()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// modTime returns the config file modification time, or the zero time if it cannot be read
+func (r *configReloader) modTime() time.Time {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		r.logger.WithError(err).Warn("Failed to stat config file")
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// reload reads the config and applies it, keeping the current config if it is invalid
+func (r *configReloader) reload() {
+	cfg := &types.Config{}
+	if err := utils.ReadConfig(cfg, r.path); err != nil {
+		r.logger.WithError(err).Error("Config reload failed, keeping the current config")
+		return
+	}
+
+	if err := utils.ReloadLogger(&cfg.Logging); err != nil {
+		r.logger.WithError(err).Error("Failed to apply logging config")
+	}
+
+	if err := r.indexer.ReloadEndpoints(cfg.LeanApi.Endpoints); err != nil {
+		// The current config keeps the endpoints in use, the next reload applies the file again
+		r.logger.WithError(err).Error("Failed to apply endpoint config, it is retried on the next reload")
+		cfg.LeanApi.Endpoints = r.current.LeanApi.Endpoints
+	}
+
+	r.indexer.GetPoller().SetPollInterval(cfg.Indexer.PollInterval)
+	r.indexer.GetAlerter().SetWebhook(cfg.Indexer.AlertWebhook)
+	r.server.SetCorsOrigins(cfg.Server.CorsOrigins)
+
+	for _, setting := range restartRequired(r.current, cfg) {
+		r.logger.WithField("setting", setting).Warn("Config change requires a restart to take effect")
+	}
+
+	r.current = cfg
+	r.logger.Info("Config reloaded")
+}
+
+// restartRequired lists the changed settings that are not applied by a reload
+func restartRequired(old, updated *types.Config) []string {
+	var settings []string
+
+	// Compare copies with the reloadable fields taken from the old config
+	newServer := updated.Server
+	newServer.CorsOrigins = old.Server.CorsOrigins
+	settings = append(settings, changedSettings("server", old.Server, newServer)...)
+
+	if old.Logging.FilePath != updated.Logging.FilePath {
+		settings = append(settings, "logging.filePath")
+	}
+
+	newIndexer := updated.Indexer
+	newIndexer.PollInterval = old.Indexer.PollInterval
+	newIndexer.AlertWebhook = old.Indexer.AlertWebhook
+	settings = append(settings, changedSettings("indexer", old.Indexer, newIndexer)...)
+
+	settings = append(settings, changedSettings("chain", old.Chain, updated.Chain)...)
+	settings = append(settings, changedSettings("database", old.Database, updated.Database)...)
+	settings = append(settings, changedSettings("health", old.Health, updated.Health)...)
+
+	return settings
+}
+
+// changedSettings lists the fields of a config section that differ by their YAML name, e.g.
+// indexer.quorumSize. Fields that are not read from YAML are not compared.
+func changedSettings(section string, old, updated any) []string {
+	oldValue, updatedValue := reflect.ValueOf(old), reflect.ValueOf(updated)
+
+	var settings []string
+	for i := 0; i < oldValue.NumField(); i++ {
+		name, _, _ := strings.Cut(oldValue.Type().Field(i).Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if !reflect.DeepEqual(oldValue.Field(i).Interface(), updatedValue.Field(i).Interface()) {
+			settings = append(settings, section+"."+name)
+		}
+	}
+	return settings
+}
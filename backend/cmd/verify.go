@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/types"
+	"github.com/syjn99/leanView/backend/utils"
+)
+
+// runVerify checks the stored chain once, prints the report and exits with status 1 if
+// issues remain
+func runVerify(args []string) {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to the config file, if empty string defaults will be used")
+	fromSlot := flags.Uint64("from", 0, "First slot to check")
+	refetch := flags.Bool("refetch", false, "Replace ranges with issues with the headers of a healthy client and check again")
+	if err := flags.Parse(args); err != nil {
+		logrus.Fatalf("error parsing flags: %v", err)
+	}
+
+	cfg := &types.Config{}
+	if err := utils.ReadConfig(cfg, *configPath); err != nil {
+		logrus.Fatalf("error reading config file: %v", err)
+	}
+
+	logger, err := utils.NewLogger(&cfg.Logging)
+	if err != nil {
+		logrus.Fatalf("error initializing logger: %v", err)
+	}
+
+	ctx, cancel := setupSignalHandling(logger)
+	defer cancel()
+
+	db.InitDB(&cfg.Database)
+
+	indexerInstance, err := indexer.NewIndexer(cfg, logger.WithField("service", "indexer"))
+	if err != nil {
+		logger.WithError(err).Fatalf("Indexer error")
+	}
+
+	// Refetching needs to know which clients are healthy
+	if *refetch {
+		indexerInstance.GetClientPool().CheckHealth(ctx)
+	}
+
+	report, err := indexerInstance.GetChainVerifier().Verify(ctx, *fromSlot, *refetch)
+	if err != nil {
+		logger.WithError(err).Fatalf("Verification error")
+	}
+
+	printReport(os.Stdout, report)
+	if len(report.Issues) > 0 {
+		os.Exit(1)
+	}
+}
+
+// printReport writes a human readable verification report
+func printReport(w io.Writer, report *types.IntegrityReport) {
+	fmt.Fprintf(w, "Checked %d headers from slot %d to slot %d in %v\n",
+		report.HeadersChecked, report.FromSlot, report.LastSlot, report.FinishedAt.Sub(report.StartedAt))
+	for _, slotRange := range report.Refetched {
+		fmt.Fprintf(w, "Refetched slots %d-%d\n", slotRange.Start, slotRange.End)
+	}
+
+	if len(report.Issues) == 0 {
+		fmt.Fprintln(w, "No integrity issues found")
+		return
+	}
+	fmt.Fprintf(w, "%d integrity issues:\n", len(report.Issues))
+	for _, issue := range report.Issues {
+		fmt.Fprintf(w, "  slot %d: %s: %s\n", issue.Slot, issue.Kind, issue.Detail)
+	}
+}
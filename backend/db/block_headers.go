@@ -10,13 +10,24 @@ import (
 
 // Write Operations (with transactions)
 
-// InsertBlockHeader inserts a new block header into the database
-func InsertBlockHeader(header *types.BlockHeader, tx *sqlx.Tx) error {
+// blockRoot returns the hash tree root stored with a header. Headers that do not hash, e.g.
+// with roots of the wrong length, are stored without one for the chain verifier to flag.
+func blockRoot(header *types.BlockHeader) []byte {
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return nil
+	}
+	return root[:]
+}
+
+// InsertBlockHeader inserts a new block header into the database along with the name of
+// the client it was fetched from
+func InsertBlockHeader(header *types.BlockHeader, client string, tx *sqlx.Tx) error {
 	_, err := tx.Exec(`
 		INSERT OR REPLACE INTO block_headers (
-			slot, proposer_index, parent_root, state_root, body_root
-		) VALUES (?, ?, ?, ?, ?)`,
-		header.Slot, header.ProposerIndex, header.ParentRoot, header.StateRoot, header.BodyRoot)
+			slot, proposer_index, parent_root, state_root, body_root, root, client
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		header.Slot, header.ProposerIndex, header.ParentRoot, header.StateRoot, header.BodyRoot, blockRoot(header), client)
 	if err != nil {
 		return fmt.Errorf("error inserting block header for slot %d: %w", header.Slot, err)
 	}
@@ -27,9 +38,9 @@ func InsertBlockHeader(header *types.BlockHeader, tx *sqlx.Tx) error {
 func UpdateBlockHeader(header *types.BlockHeader, tx *sqlx.Tx) error {
 	result, err := tx.Exec(`
 		UPDATE block_headers 
-		SET proposer_index = ?, parent_root = ?, state_root = ?, body_root = ?
+		SET proposer_index = ?, parent_root = ?, state_root = ?, body_root = ?, root = ?
 		WHERE slot = ?`,
-		header.ProposerIndex, header.ParentRoot, header.StateRoot, header.BodyRoot, header.Slot)
+		header.ProposerIndex, header.ParentRoot, header.StateRoot, header.BodyRoot, blockRoot(header), header.Slot)
 	if err != nil {
 		return fmt.Errorf("error updating block header for slot %d: %w", header.Slot, err)
 	}
@@ -65,23 +76,27 @@ func DeleteBlockHeader(slot uint64, tx *sqlx.Tx) error {
 	return nil
 }
 
-// InsertBlockHeaderBatch inserts multiple block headers in a single transaction
-func InsertBlockHeaderBatch(headers []*types.BlockHeader, tx *sqlx.Tx) error {
+// InsertBlockHeaderBatch inserts multiple block headers in a single transaction, clients
+// holds the name of the client each header was fetched from
+func InsertBlockHeaderBatch(headers []*types.BlockHeader, clients []string, tx *sqlx.Tx) error {
 	if len(headers) == 0 {
 		return nil
 	}
+	if len(clients) != len(headers) {
+		return fmt.Errorf("got %d clients for %d block headers", len(clients), len(headers))
+	}
 
 	stmt, err := tx.Preparex(`
 		INSERT OR REPLACE INTO block_headers (
-			slot, proposer_index, parent_root, state_root, body_root
-		) VALUES (?, ?, ?, ?, ?)`)
+			slot, proposer_index, parent_root, state_root, body_root, root, client
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`)
 	if err != nil {
 		return fmt.Errorf("error preparing batch insert statement: %w", err)
 	}
 	defer stmt.Close()
 
-	for _, header := range headers {
-		_, err := stmt.Exec(header.Slot, header.ProposerIndex, header.ParentRoot, header.StateRoot, header.BodyRoot)
+	for i, header := range headers {
+		_, err := stmt.Exec(header.Slot, header.ProposerIndex, header.ParentRoot, header.StateRoot, header.BodyRoot, blockRoot(header), clients[i])
 		if err != nil {
 			return fmt.Errorf("error inserting block header for slot %d in batch: %w", header.Slot, err)
 		}
@@ -90,6 +105,43 @@ func InsertBlockHeaderBatch(headers []*types.BlockHeader, tx *sqlx.Tx) error {
 	return nil
 }
 
+// backfillBlockHeaderRoots stores the root of headers inserted before block roots were stored
+func backfillBlockHeaderRoots() error {
+	headers := []*types.BlockHeader{}
+	err := ReaderDb.Select(&headers, `
+		SELECT slot, proposer_index, parent_root, state_root, body_root
+		FROM block_headers
+		WHERE root IS NULL`)
+	if err != nil {
+		return fmt.Errorf("error fetching block headers without root: %w", err)
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+
+	return RunDBTransaction(func(tx *sqlx.Tx) error {
+		for _, header := range headers {
+			root := blockRoot(header)
+			if root == nil {
+				continue
+			}
+			if _, err := tx.Exec(`UPDATE block_headers SET root = ? WHERE slot = ?`, root, header.Slot); err != nil {
+				return fmt.Errorf("error storing block root for slot %d: %w", header.Slot, err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteBlockHeadersInRange deletes the block headers within a slot range (inclusive)
+func DeleteBlockHeadersInRange(startSlot, endSlot uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM block_headers WHERE slot >= ? AND slot <= ?`, startSlot, endSlot)
+	if err != nil {
+		return fmt.Errorf("error deleting block headers in range %d-%d: %w", startSlot, endSlot, err)
+	}
+	return nil
+}
+
 // Read Operations (direct ReaderDb)
 
 // GetBlockHeaderBySlot retrieves a block header by its slot number
@@ -108,15 +160,13 @@ func GetBlockHeaderBySlot(slot uint64) (*types.BlockHeader, error) {
 	return header, nil
 }
 
-// GetBlockHeaderByRoot retrieves a block header by one of its roots (parent, state, or body)
+// GetBlockHeaderByRoot retrieves a block header by its block root
 func GetBlockHeaderByRoot(root []byte) (*types.BlockHeader, error) {
 	header := &types.BlockHeader{}
 	err := ReaderDb.Get(header, `
 		SELECT slot, proposer_index, parent_root, state_root, body_root
 		FROM block_headers
-		WHERE parent_root = ? OR state_root = ? OR body_root = ?
-		ORDER BY slot DESC
-		LIMIT 1`, root, root, root)
+		WHERE root = ?`, root)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -126,6 +176,76 @@ func GetBlockHeaderByRoot(root []byte) (*types.BlockHeader, error) {
 	return header, nil
 }
 
+// GetBlockHeaderClients returns the name of the client each stored header within a slot range
+// (inclusive) was fetched from, by slot. Headers stored before clients were recorded are left out.
+func GetBlockHeaderClients(startSlot, endSlot uint64) (map[uint64]string, error) {
+	rows := []struct {
+		Slot   uint64 `db:"slot"`
+		Client string `db:"client"`
+	}{}
+	err := ReaderDb.Select(&rows, `
+		SELECT slot, client
+		FROM block_headers
+		WHERE slot >= ? AND slot <= ? AND client != ''`, startSlot, endSlot)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching block header clients in range %d-%d: %w", startSlot, endSlot, err)
+	}
+
+	clients := make(map[uint64]string, len(rows))
+	for _, row := range rows {
+		clients[row.Slot] = row.Client
+	}
+	return clients, nil
+}
+
+// GetBlockHeadersByStateRoot retrieves all block headers with the given state root, highest slot first
+func GetBlockHeadersByStateRoot(stateRoot []byte) ([]*types.BlockHeader, error) {
+	return getBlockHeadersByRootColumn("state_root", stateRoot)
+}
+
+// GetBlockHeadersByBodyRoot retrieves all block headers with the given body root, highest slot first
+func GetBlockHeadersByBodyRoot(bodyRoot []byte) ([]*types.BlockHeader, error) {
+	return getBlockHeadersByRootColumn("body_root", bodyRoot)
+}
+
+// GetBlockHeadersByParentRoot retrieves all children of the given block root, highest slot first
+func GetBlockHeadersByParentRoot(parentRoot []byte) ([]*types.BlockHeader, error) {
+	return getBlockHeadersByRootColumn("parent_root", parentRoot)
+}
+
+// getBlockHeadersByRootColumn retrieves the block headers whose root column matches, column is
+// one of parent_root, state_root and body_root
+func getBlockHeadersByRootColumn(column string, root []byte) ([]*types.BlockHeader, error) {
+	headers := []*types.BlockHeader{}
+	err := ReaderDb.Select(&headers, `
+		SELECT slot, proposer_index, parent_root, state_root, body_root
+		FROM block_headers
+		WHERE `+column+` = ?
+		ORDER BY slot DESC`, root)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching block headers by %s: %w", column, err)
+	}
+	return headers, nil
+}
+
+// GetBlockHeaderByParentRoot retrieves the block header whose parent has the given block root
+func GetBlockHeaderByParentRoot(parentRoot []byte) (*types.BlockHeader, error) {
+	header := &types.BlockHeader{}
+	err := ReaderDb.Get(header, `
+		SELECT slot, proposer_index, parent_root, state_root, body_root
+		FROM block_headers
+		WHERE parent_root = ?
+		ORDER BY slot ASC
+		LIMIT 1`, parentRoot)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching block header by parent root: %w", err)
+	}
+	return header, nil
+}
+
 // GetBlockHeadersByProposer retrieves block headers by proposer index with a limit
 func GetBlockHeadersByProposer(proposerIndex uint64, limit int) ([]*types.BlockHeader, error) {
 	headers := []*types.BlockHeader{}
@@ -141,6 +261,68 @@ func GetBlockHeadersByProposer(proposerIndex uint64, limit int) ([]*types.BlockH
 	return headers, nil
 }
 
+// GetBlockHeaderBeforeSlot retrieves the latest block header with a slot strictly below the given slot
+func GetBlockHeaderBeforeSlot(slot uint64) (*types.BlockHeader, error) {
+	header := &types.BlockHeader{}
+	err := ReaderDb.Get(header, `
+		SELECT slot, proposer_index, parent_root, state_root, body_root
+		FROM block_headers
+		WHERE slot < ?
+		ORDER BY slot DESC
+		LIMIT 1`, slot)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching block header before slot %d: %w", slot, err)
+	}
+	return header, nil
+}
+
+// CountBlockHeadersByProposer returns the number of block headers proposed by the given index
+func CountBlockHeadersByProposer(proposerIndex uint64) (uint32, error) {
+	var count uint32
+	err := ReaderDb.Get(&count, `SELECT COUNT(*) FROM block_headers WHERE proposer_index = ?`, proposerIndex)
+	if err != nil {
+		return 0, fmt.Errorf("error counting block headers by proposer %d: %w", proposerIndex, err)
+	}
+	return count, nil
+}
+
+// GetMaxProposerIndex returns the highest proposer index seen, and false if no headers are stored
+func GetMaxProposerIndex() (uint64, bool, error) {
+	var maxIndex sql.NullInt64
+	err := ReaderDb.Get(&maxIndex, `SELECT MAX(proposer_index) FROM block_headers`)
+	if err != nil {
+		return 0, false, fmt.Errorf("error fetching max proposer index: %w", err)
+	}
+	if !maxIndex.Valid {
+		return 0, false, nil
+	}
+	return uint64(maxIndex.Int64), true, nil
+}
+
+// GetLastProposedSlots returns the latest slot proposed by each proposer index
+func GetLastProposedSlots() (map[uint64]uint64, error) {
+	rows := []struct {
+		ProposerIndex uint64 `db:"proposer_index"`
+		Slot          uint64 `db:"slot"`
+	}{}
+	err := ReaderDb.Select(&rows, `
+		SELECT proposer_index, MAX(slot) AS slot
+		FROM block_headers
+		GROUP BY proposer_index`)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching last proposed slots: %w", err)
+	}
+
+	lastSlots := make(map[uint64]uint64, len(rows))
+	for _, row := range rows {
+		lastSlots[row.ProposerIndex] = row.Slot
+	}
+	return lastSlots, nil
+}
+
 // GetLatestBlockHeaders retrieves the most recent block headers with a limit
 func GetLatestBlockHeaders(limit int) ([]*types.BlockHeader, error) {
 	headers := []*types.BlockHeader{}
@@ -173,6 +355,36 @@ func GetBlockHeadersInRange(startSlot, endSlot uint64) ([]*types.BlockHeader, er
 	return headers, nil
 }
 
+// GetBlockHeadersAfterSlot retrieves up to limit block headers after a slot in slot order
+func GetBlockHeadersAfterSlot(slot uint64, limit int) ([]*types.BlockHeader, error) {
+	headers := []*types.BlockHeader{}
+	err := ReaderDb.Select(&headers, `
+		SELECT slot, proposer_index, parent_root, state_root, body_root
+		FROM block_headers
+		WHERE slot > ?
+		ORDER BY slot ASC
+		LIMIT ?`, slot, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching block headers after slot %d: %w", slot, err)
+	}
+	return headers, nil
+}
+
+// GetBlockHeadersFromSlot retrieves up to limit block headers at or after a slot in slot order
+func GetBlockHeadersFromSlot(slot uint64, limit int) ([]*types.BlockHeader, error) {
+	headers := []*types.BlockHeader{}
+	err := ReaderDb.Select(&headers, `
+		SELECT slot, proposer_index, parent_root, state_root, body_root
+		FROM block_headers
+		WHERE slot >= ?
+		ORDER BY slot ASC
+		LIMIT ?`, slot, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching block headers from slot %d: %w", slot, err)
+	}
+	return headers, nil
+}
+
 // GetBlockHeadersPaginated retrieves block headers with pagination support
 func GetBlockHeadersPaginated(limit int, offset uint64, ascending bool) ([]*types.BlockHeader, error) {
 	headers := []*types.BlockHeader{}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// blockSignatureColumns are the columns of the block_signatures table
+const blockSignatureColumns = `block_root, slot, proposer_index, fork, signature, signature_size,
+	block_size, votes, client, observed_at`
+
+// sizeStatsColumns aggregate the block_signatures rows of a group into types.SizeStats
+const sizeStatsColumns = `COUNT(*) AS blocks, MIN(slot) AS first_slot, MAX(slot) AS last_slot,
+	MIN(signature_size) AS min_signature_size, MAX(signature_size) AS max_signature_size,
+	AVG(signature_size) AS avg_signature_size, MIN(block_size) AS min_block_size,
+	MAX(block_size) AS max_block_size, AVG(block_size) AS avg_block_size,
+	SUM(block_size) AS total_bytes`
+
+// Write Operations (with transactions)
+
+// InsertBlockSignature stores a block signature, keeping the first one stored for a block root
+func InsertBlockSignature(signature *types.BlockSignature, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+		INSERT OR IGNORE INTO block_signatures (`+blockSignatureColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		signature.BlockRoot, signature.Slot, signature.ProposerIndex, signature.Fork, signature.Signature,
+		signature.SignatureSize, signature.BlockSize, signature.Votes, signature.Client, signature.ObservedAt)
+	if err != nil {
+		return fmt.Errorf("error inserting block signature at slot %d: %w", signature.Slot, err)
+	}
+	return nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetBlockSignatureByRoot retrieves the signature of a block root, nil if none is stored
+func GetBlockSignatureByRoot(blockRoot []byte) (*types.BlockSignature, error) {
+	signature := &types.BlockSignature{}
+	err := ReaderDb.Get(signature, `
+		SELECT `+blockSignatureColumns+`
+		FROM block_signatures
+		WHERE block_root = ?`, blockRoot)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching block signature by root: %w", err)
+	}
+	return signature, nil
+}
+
+// GetBlockSignaturesBySlot retrieves the signatures of all stored blocks at a slot, first observed first
+func GetBlockSignaturesBySlot(slot uint64) ([]*types.BlockSignature, error) {
+	signatures := []*types.BlockSignature{}
+	err := ReaderDb.Select(&signatures, `
+		SELECT `+blockSignatureColumns+`
+		FROM block_signatures
+		WHERE slot = ?
+		ORDER BY observed_at`, slot)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching block signatures by slot %d: %w", slot, err)
+	}
+	return signatures, nil
+}
+
+// GetSizeBuckets aggregates the stored blocks per fork into buckets of bucketSlots slots,
+// starting at multiples of bucketSlots, latest bucket first. An empty fork includes all forks.
+func GetSizeBuckets(bucketSlots uint64, fork string, limit int) ([]*types.SizeBucket, error) {
+	buckets := []*types.SizeBucket{}
+	err := ReaderDb.Select(&buckets, `
+		SELECT (slot / ?) * ? AS start_slot, fork, `+sizeStatsColumns+`
+		FROM block_signatures
+		WHERE ? = '' OR fork = ?
+		GROUP BY start_slot, fork
+		ORDER BY start_slot DESC, first_slot DESC
+		LIMIT ?`, bucketSlots, bucketSlots, fork, fork, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting block size buckets: %w", err)
+	}
+	return buckets, nil
+}
+
+// GetForkSizeStats aggregates all stored blocks per fork, by first slot
+func GetForkSizeStats() ([]*types.ForkSizeStats, error) {
+	stats := []*types.ForkSizeStats{}
+	err := ReaderDb.Select(&stats, `
+		SELECT fork, `+sizeStatsColumns+`
+		FROM block_signatures
+		GROUP BY fork
+		ORDER BY first_slot`)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fork size stats: %w", err)
+	}
+	return stats, nil
+}
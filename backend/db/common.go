@@ -1,8 +1,10 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	_ "github.com/glebarez/go-sqlite"
@@ -17,6 +19,9 @@ var ReaderDb *sqlx.DB
 var writerDb *sqlx.DB
 var writerMutex sync.Mutex
 
+// lastWriteTime holds the unix milliseconds of the last committed write transaction
+var lastWriteTime atomic.Int64
+
 var logger = logrus.StandardLogger().WithField("module", "db")
 
 func InitDB(cfg *types.DatabaseConfig) {
@@ -71,6 +76,11 @@ func checkDbConn(dbConn *sqlx.DB, dataBaseName string) {
 	dbConnectionTimeout.Stop()
 }
 
+// Ping verifies the database connection is still alive
+func Ping(ctx context.Context) error {
+	return ReaderDb.PingContext(ctx)
+}
+
 func RunDBTransaction(handler func(tx *sqlx.Tx) error) error {
 	writerMutex.Lock()
 	defer writerMutex.Unlock()
@@ -92,5 +102,16 @@ func RunDBTransaction(handler func(tx *sqlx.Tx) error) error {
 		return fmt.Errorf("error committing db transaction: %v", err)
 	}
 
+	lastWriteTime.Store(time.Now().UnixMilli())
 	return nil
 }
+
+// GetLastWriteTime returns the time of the last committed write transaction,
+// or the zero time if nothing has been written since startup
+func GetLastWriteTime() time.Time {
+	millis := lastWriteTime.Load()
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Write Operations (with transactions)
+
+// UpsertDivergence stores a divergence, replacing an earlier one of the client at the slot
+func UpsertDivergence(divergence *types.Divergence, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+		INSERT OR REPLACE INTO divergences (
+			slot, client, root, header, majority_root, majority_header, agreeing, queried, detected_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		divergence.Slot, divergence.Client, divergence.Root, divergence.Header, divergence.MajorityRoot,
+		divergence.MajorityHeader, divergence.Agreeing, divergence.Queried, divergence.DetectedAt)
+	if err != nil {
+		return fmt.Errorf("error upserting divergence of %s at slot %d: %w", divergence.Client, divergence.Slot, err)
+	}
+	return nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetDivergences retrieves the most recent divergences, newest slot first
+func GetDivergences(limit int) ([]*types.Divergence, error) {
+	divergences := []*types.Divergence{}
+	err := ReaderDb.Select(&divergences, `
+		SELECT slot, client, root, header, majority_root, majority_header, agreeing, queried, detected_at
+		FROM divergences
+		ORDER BY slot DESC, client ASC
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting divergences: %w", err)
+	}
+	return divergences, nil
+}
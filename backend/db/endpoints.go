@@ -0,0 +1,74 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Write Operations (with transactions)
+
+// UpsertEndpointRecord inserts or replaces the stored record of an endpoint
+func UpsertEndpointRecord(record *types.EndpointRecord, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+		INSERT OR REPLACE INTO endpoints (
+			name, url, options, from_config, removed, is_primary, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		record.Name, record.Url, record.Options, record.FromConfig, record.Removed, record.IsPrimary, record.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error upserting endpoint %s: %w", record.Name, err)
+	}
+	return nil
+}
+
+// DeleteEndpointRecord deletes the stored record of an endpoint, if any
+func DeleteEndpointRecord(name string, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM endpoints WHERE name = ?`, name)
+	if err != nil {
+		return fmt.Errorf("error deleting endpoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// ClearPrimaryEndpoint unsets the primary flag on every stored endpoint
+func ClearPrimaryEndpoint(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`UPDATE endpoints SET is_primary = 0 WHERE is_primary = 1`)
+	if err != nil {
+		return fmt.Errorf("error clearing primary endpoint: %w", err)
+	}
+	return nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetEndpointRecords retrieves all stored endpoint records ordered by name
+func GetEndpointRecords() ([]*types.EndpointRecord, error) {
+	var records []*types.EndpointRecord
+	err := ReaderDb.Select(&records, `
+		SELECT name, url, options, from_config, removed, is_primary, updated_at
+		FROM endpoints
+		ORDER BY name ASC`)
+	if err != nil {
+		return nil, fmt.Errorf("error getting endpoint records: %w", err)
+	}
+	return records, nil
+}
+
+// GetEndpointRecord retrieves the stored record of an endpoint by name
+func GetEndpointRecord(name string) (*types.EndpointRecord, error) {
+	record := &types.EndpointRecord{}
+	err := ReaderDb.Get(record, `
+		SELECT name, url, options, from_config, removed, is_primary, updated_at
+		FROM endpoints
+		WHERE name = ?`, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting endpoint record %s: %w", name, err)
+	}
+	return record, nil
+}
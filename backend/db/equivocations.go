@@ -0,0 +1,48 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Write Operations (with transactions)
+
+// InsertEquivocation stores evidence unless the same pair of messages is already stored,
+// reporting whether it was new
+func InsertEquivocation(equivocation *types.Equivocation, tx *sqlx.Tx) (bool, error) {
+	result, err := tx.Exec(`
+		INSERT OR IGNORE INTO equivocations (
+			kind, validator_id, slot, root_a, root_b, evidence_a, evidence_b, client_a, client_b, detected_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		equivocation.Kind, equivocation.ValidatorId, equivocation.Slot, equivocation.RootA, equivocation.RootB,
+		equivocation.EvidenceA, equivocation.EvidenceB, equivocation.ClientA, equivocation.ClientB, equivocation.DetectedAt)
+	if err != nil {
+		return false, fmt.Errorf("error inserting %s of validator %d at slot %d: %w", equivocation.Kind, equivocation.ValidatorId, equivocation.Slot, err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error inserting %s of validator %d at slot %d: %w", equivocation.Kind, equivocation.ValidatorId, equivocation.Slot, err)
+	}
+	return inserted > 0, nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetEquivocations retrieves the most recent equivocations of a kind, or of every kind if
+// empty, newest slot first
+func GetEquivocations(kind string, limit int) ([]*types.Equivocation, error) {
+	equivocations := []*types.Equivocation{}
+	err := ReaderDb.Select(&equivocations, `
+		SELECT kind, validator_id, slot, root_a, root_b, evidence_a, evidence_b, client_a, client_b, detected_at
+		FROM equivocations
+		WHERE ? = '' OR kind = ?
+		ORDER BY slot DESC, detected_at DESC
+		LIMIT ?`, kind, kind, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting equivocations: %w", err)
+	}
+	return equivocations, nil
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Write Operations (with transactions)
+
+// InsertIntegrityIssue stores an issue, replacing an earlier issue of the same kind at the slot
+func InsertIntegrityIssue(issue *types.IntegrityIssue, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+		INSERT OR REPLACE INTO integrity_issues (
+			slot, kind, related_slot, detail, detected_at
+		) VALUES (?, ?, ?, ?, ?)`,
+		issue.Slot, issue.Kind, issue.RelatedSlot, issue.Detail, issue.DetectedAt)
+	if err != nil {
+		return fmt.Errorf("error inserting integrity issue at slot %d: %w", issue.Slot, err)
+	}
+	return nil
+}
+
+// DeleteIntegrityIssuesFromSlot deletes the issues at or after a slot before they are checked again
+func DeleteIntegrityIssuesFromSlot(slot uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM integrity_issues WHERE slot >= ?`, slot)
+	if err != nil {
+		return fmt.Errorf("error deleting integrity issues from slot %d: %w", slot, err)
+	}
+	return nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetIntegrityIssues retrieves the stored issues, newest slot first
+func GetIntegrityIssues(limit int) ([]*types.IntegrityIssue, error) {
+	issues := []*types.IntegrityIssue{}
+	err := ReaderDb.Select(&issues, `
+		SELECT slot, kind, related_slot, detail, detected_at
+		FROM integrity_issues
+		ORDER BY slot DESC, kind ASC
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting integrity issues: %w", err)
+	}
+	return issues, nil
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Write Operations (with transactions)
+
+// UpsertInvalidBlock stores a flagged block, replacing an earlier finding for the same block
+func UpsertInvalidBlock(block *types.InvalidBlock, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+		INSERT OR REPLACE INTO invalid_blocks (
+			root, slot, reason, state_root, computed_state_root, error, client, detected_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		block.Root, block.Slot, block.Reason, block.StateRoot, block.ComputedStateRoot, block.Error, block.Client, block.DetectedAt)
+	if err != nil {
+		return fmt.Errorf("error upserting invalid block at slot %d: %w", block.Slot, err)
+	}
+	return nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetInvalidBlocks retrieves the most recent flagged blocks, newest slot first
+func GetInvalidBlocks(limit int) ([]*types.InvalidBlock, error) {
+	blocks := []*types.InvalidBlock{}
+	err := ReaderDb.Select(&blocks, `
+		SELECT root, slot, reason, state_root, computed_state_root, error, client, detected_at
+		FROM invalid_blocks
+		ORDER BY slot DESC
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting invalid blocks: %w", err)
+	}
+	return blocks, nil
+}
@@ -89,5 +89,10 @@ func RunMigrations() error {
 		logger.Info("Database is up to date")
 	}
 
+	// Block roots cannot be computed in SQL, headers stored before they were are hashed here
+	if err := backfillBlockHeaderRoots(); err != nil {
+		return fmt.Errorf("failed to backfill block roots: %w", err)
+	}
+
 	return nil
 }
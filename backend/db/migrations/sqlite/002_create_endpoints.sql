@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS endpoints (
+    name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    from_config INTEGER NOT NULL DEFAULT 0,
+    removed INTEGER NOT NULL DEFAULT 0,
+    is_primary INTEGER NOT NULL DEFAULT 0,
+    updated_at INTEGER NOT NULL,
+    CONSTRAINT endpoints_pkey PRIMARY KEY (name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS endpoints;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS invalid_blocks (
+    root BLOB NOT NULL,
+    slot INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    state_root BLOB NOT NULL,
+    computed_state_root BLOB,
+    error TEXT NOT NULL DEFAULT '',
+    client TEXT NOT NULL,
+    detected_at INTEGER NOT NULL,
+    CONSTRAINT invalid_blocks_pkey PRIMARY KEY (root)
+);
+
+CREATE INDEX IF NOT EXISTS invalid_blocks_slot_idx
+    ON invalid_blocks (slot DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS invalid_blocks;
+-- +goose StatementEnd
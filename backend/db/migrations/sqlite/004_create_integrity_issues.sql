@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS integrity_issues (
+    slot INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    related_slot INTEGER NOT NULL DEFAULT 0,
+    detail TEXT NOT NULL DEFAULT '',
+    detected_at INTEGER NOT NULL,
+    CONSTRAINT integrity_issues_pkey PRIMARY KEY (slot, kind)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS integrity_issues;
+-- +goose StatementEnd
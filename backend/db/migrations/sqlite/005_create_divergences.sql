@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS divergences (
+    slot INTEGER NOT NULL,
+    client TEXT NOT NULL,
+    root BLOB,
+    header BLOB,
+    majority_root BLOB,
+    majority_header BLOB,
+    agreeing INTEGER NOT NULL,
+    queried INTEGER NOT NULL,
+    detected_at INTEGER NOT NULL,
+    CONSTRAINT divergences_pkey PRIMARY KEY (slot, client)
+);
+
+CREATE INDEX IF NOT EXISTS divergences_client_idx
+    ON divergences (client, slot DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS divergences;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS equivocations (
+    kind TEXT NOT NULL,
+    validator_id INTEGER NOT NULL,
+    slot INTEGER NOT NULL,
+    root_a BLOB NOT NULL,
+    root_b BLOB NOT NULL,
+    evidence_a BLOB NOT NULL,
+    evidence_b BLOB NOT NULL,
+    client_a TEXT NOT NULL,
+    client_b TEXT NOT NULL,
+    detected_at INTEGER NOT NULL,
+    CONSTRAINT equivocations_pkey PRIMARY KEY (kind, validator_id, root_a, root_b)
+);
+
+CREATE INDEX IF NOT EXISTS equivocations_slot_idx
+    ON equivocations (slot DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS equivocations;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS state_snapshots (
+    state_root BLOB NOT NULL,
+    slot INTEGER NOT NULL,
+    block_root BLOB NOT NULL,
+    client TEXT NOT NULL,
+    justified_slot INTEGER NOT NULL,
+    justified_root BLOB NOT NULL,
+    finalized_slot INTEGER NOT NULL,
+    finalized_root BLOB NOT NULL,
+    data BLOB NOT NULL,
+    checked_hashes INTEGER NOT NULL,
+    mismatched_hashes INTEGER NOT NULL,
+    unindexed_hashes INTEGER NOT NULL,
+    fetched_at INTEGER NOT NULL,
+    CONSTRAINT state_snapshots_pkey PRIMARY KEY (state_root)
+);
+
+CREATE INDEX IF NOT EXISTS state_snapshots_slot_idx
+    ON state_snapshots (slot DESC, fetched_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS state_snapshots;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS block_signatures (
+    block_root BLOB NOT NULL,
+    slot INTEGER NOT NULL,
+    proposer_index INTEGER NOT NULL,
+    fork TEXT NOT NULL,
+    signature BLOB NOT NULL,
+    signature_size INTEGER NOT NULL,
+    block_size INTEGER NOT NULL,
+    votes INTEGER NOT NULL,
+    client TEXT NOT NULL,
+    observed_at INTEGER NOT NULL,
+    CONSTRAINT block_signatures_pkey PRIMARY KEY (block_root)
+);
+
+CREATE INDEX IF NOT EXISTS block_signatures_slot_idx
+    ON block_signatures (slot DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS block_signatures;
+-- +goose StatementEnd
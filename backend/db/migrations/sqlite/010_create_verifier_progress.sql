@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS verifier_progress (
+    block_root BLOB NOT NULL,
+    slot INTEGER NOT NULL,
+    data BLOB NOT NULL,
+    justified_at TEXT NOT NULL,
+    saved_at INTEGER NOT NULL,
+    CONSTRAINT verifier_progress_pkey PRIMARY KEY (block_root)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS verifier_progress;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE block_headers ADD COLUMN root BLOB;
+
+CREATE INDEX IF NOT EXISTS block_headers_root_idx
+    ON block_headers (root);
+
+CREATE INDEX IF NOT EXISTS block_headers_body_root_idx
+    ON block_headers (body_root);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS block_headers_body_root_idx;
+DROP INDEX IF EXISTS block_headers_root_idx;
+ALTER TABLE block_headers DROP COLUMN root;
+-- +goose StatementEnd
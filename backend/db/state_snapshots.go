@@ -0,0 +1,98 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// stateSnapshotColumns are the columns of the state_snapshots table without the state data
+const stateSnapshotColumns = `state_root, slot, block_root, client, justified_slot, justified_root,
+	finalized_slot, finalized_root, checked_hashes, mismatched_hashes, unindexed_hashes, fetched_at,
+	LENGTH(data) AS size`
+
+// Write Operations (with transactions)
+
+// UpsertStateSnapshot stores a snapshot, replacing an earlier snapshot of the same state
+func UpsertStateSnapshot(snapshot *types.StateSnapshot, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+		INSERT OR REPLACE INTO state_snapshots (
+			state_root, slot, block_root, client, justified_slot, justified_root, finalized_slot,
+			finalized_root, data, checked_hashes, mismatched_hashes, unindexed_hashes, fetched_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		snapshot.StateRoot, snapshot.Slot, snapshot.BlockRoot, snapshot.Client, snapshot.JustifiedSlot,
+		snapshot.JustifiedRoot, snapshot.FinalizedSlot, snapshot.FinalizedRoot, snapshot.Data,
+		snapshot.CheckedHashes, snapshot.MismatchedHashes, snapshot.UnindexedHashes, snapshot.FetchedAt)
+	if err != nil {
+		return fmt.Errorf("error upserting state snapshot at slot %d: %w", snapshot.Slot, err)
+	}
+	return nil
+}
+
+// PruneStateSnapshots deletes all but the given number of snapshots with the highest slots
+func PruneStateSnapshots(keep int, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+		DELETE FROM state_snapshots
+		WHERE state_root NOT IN (
+			SELECT state_root FROM state_snapshots
+			ORDER BY slot DESC, fetched_at DESC
+			LIMIT ?
+		)`, keep)
+	if err != nil {
+		return fmt.Errorf("error pruning state snapshots: %w", err)
+	}
+	return nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetStateSnapshotByRoot retrieves the snapshot of a state root, nil if none is stored
+func GetStateSnapshotByRoot(stateRoot []byte) (*types.StateSnapshot, error) {
+	snapshot := &types.StateSnapshot{}
+	err := ReaderDb.Get(snapshot, `
+		SELECT `+stateSnapshotColumns+`, data
+		FROM state_snapshots
+		WHERE state_root = ?`, stateRoot)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching state snapshot by root: %w", err)
+	}
+	return snapshot, nil
+}
+
+// GetStateSnapshotBySlot retrieves the latest fetched snapshot at a slot, nil if none is stored
+func GetStateSnapshotBySlot(slot uint64) (*types.StateSnapshot, error) {
+	snapshot := &types.StateSnapshot{}
+	err := ReaderDb.Get(snapshot, `
+		SELECT `+stateSnapshotColumns+`, data
+		FROM state_snapshots
+		WHERE slot = ?
+		ORDER BY fetched_at DESC
+		LIMIT 1`, slot)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching state snapshot by slot %d: %w", slot, err)
+	}
+	return snapshot, nil
+}
+
+// GetLatestStateSnapshots retrieves snapshots without their state data, highest slot first
+func GetLatestStateSnapshots(limit int) ([]*types.StateSnapshot, error) {
+	snapshots := []*types.StateSnapshot{}
+	err := ReaderDb.Select(&snapshots, `
+		SELECT `+stateSnapshotColumns+`
+		FROM state_snapshots
+		ORDER BY slot DESC, fetched_at DESC
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest state snapshots: %w", err)
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Write Operations (with transactions)
+
+// SaveVerifierProgress replaces the stored verifier progress
+func SaveVerifierProgress(progress *types.VerifierProgress, tx *sqlx.Tx) error {
+	if _, err := tx.Exec(`DELETE FROM verifier_progress`); err != nil {
+		return fmt.Errorf("error clearing verifier progress: %w", err)
+	}
+	_, err := tx.Exec(`
+		INSERT INTO verifier_progress (
+			block_root, slot, data, justified_at, saved_at
+		) VALUES (?, ?, ?, ?, ?)`,
+		progress.BlockRoot, progress.Slot, progress.Data, progress.JustifiedAt, progress.SavedAt)
+	if err != nil {
+		return fmt.Errorf("error saving verifier progress at slot %d: %w", progress.Slot, err)
+	}
+	return nil
+}
+
+// Read Operations (direct ReaderDb)
+
+// GetVerifierProgress retrieves the stored verifier progress, nil if none is stored
+func GetVerifierProgress() (*types.VerifierProgress, error) {
+	progress := &types.VerifierProgress{}
+	err := ReaderDb.Get(progress, `
+		SELECT block_root, slot, data, justified_at, saved_at
+		FROM verifier_progress
+		ORDER BY slot DESC
+		LIMIT 1`)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting verifier progress: %w", err)
+	}
+	return progress, nil
+}
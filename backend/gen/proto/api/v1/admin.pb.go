@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/admin.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Endpoint describes a lean node endpoint in the client pool
+type Endpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"` // Credentials redacted
+	IsPrimary     bool                   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	IsHealthy     bool                   `protobuf:"varint,4,opt,name=is_healthy,json=isHealthy,proto3" json:"is_healthy,omitempty"`
+	FromConfig    bool                   `protobuf:"varint,5,opt,name=from_config,json=fromConfig,proto3" json:"from_config,omitempty"`            // Whether the endpoint is defined in the config file
+	LastCheckedMs int64                  `protobuf:"varint,6,opt,name=last_checked_ms,json=lastCheckedMs,proto3" json:"last_checked_ms,omitempty"` // Unix timestamp in milliseconds of the last health check
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`                // Last health check error (empty if healthy)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Endpoint) Reset() {
+	*x = Endpoint{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endpoint) ProtoMessage() {}
+
+func (x *Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Endpoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Endpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Endpoint) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *Endpoint) GetIsHealthy() bool {
+	if x != nil {
+		return x.IsHealthy
+	}
+	return false
+}
+
+func (x *Endpoint) GetFromConfig() bool {
+	if x != nil {
+		return x.FromConfig
+	}
+	return false
+}
+
+func (x *Endpoint) GetLastCheckedMs() int64 {
+	if x != nil {
+		return x.LastCheckedMs
+	}
+	return 0
+}
+
+func (x *Endpoint) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type ListEndpointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEndpointsRequest) Reset() {
+	*x = ListEndpointsRequest{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEndpointsRequest) ProtoMessage() {}
+
+func (x *ListEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type ListEndpointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*Endpoint            `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEndpointsResponse) Reset() {
+	*x = ListEndpointsResponse{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEndpointsResponse) ProtoMessage() {}
+
+func (x *ListEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEndpointsResponse) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+// AddEndpointRequest takes the endpoint options of the config file. Auth secrets and header
+// values are only accepted as files or environment variables on the indexer host, as the
+// endpoint is stored.
+type AddEndpointRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                 // Unique endpoint name
+	Url                   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                                                                   // Base URL of the lean node API
+	Headers               map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Rejected, header values are given by header_files or header_env
+	Username              string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`                                                                         // Basic auth username
+	PasswordFile          string                 `protobuf:"bytes,5,opt,name=password_file,json=passwordFile,proto3" json:"password_file,omitempty"`
+	PasswordEnv           string                 `protobuf:"bytes,6,opt,name=password_env,json=passwordEnv,proto3" json:"password_env,omitempty"`
+	BearerTokenFile       string                 `protobuf:"bytes,7,opt,name=bearer_token_file,json=bearerTokenFile,proto3" json:"bearer_token_file,omitempty"`
+	BearerTokenEnv        string                 `protobuf:"bytes,8,opt,name=bearer_token_env,json=bearerTokenEnv,proto3" json:"bearer_token_env,omitempty"`
+	TlsCaFile             string                 `protobuf:"bytes,9,opt,name=tls_ca_file,json=tlsCaFile,proto3" json:"tls_ca_file,omitempty"` // PEM bundle trusted in addition to the system roots
+	TlsInsecureSkipVerify bool                   `protobuf:"varint,10,opt,name=tls_insecure_skip_verify,json=tlsInsecureSkipVerify,proto3" json:"tls_insecure_skip_verify,omitempty"`
+	Proxy                 string                 `protobuf:"bytes,11,opt,name=proxy,proto3" json:"proxy,omitempty"`                                                                                                          // Proxy URL, empty uses the environment and "none" connects directly
+	TimeoutMs             uint64                 `protobuf:"varint,12,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`                                                                                // Overrides indexer.httpTimeout (0 keeps it)
+	HealthTimeoutMs       uint64                 `protobuf:"varint,13,opt,name=health_timeout_ms,json=healthTimeoutMs,proto3" json:"health_timeout_ms,omitempty"`                                                            // Overrides indexer.healthTimeout (0 keeps it)
+	HeaderFiles           map[string]string      `protobuf:"bytes,14,rep,name=header_files,json=headerFiles,proto3" json:"header_files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Header name to the file its value is read from
+	HeaderEnv             map[string]string      `protobuf:"bytes,15,rep,name=header_env,json=headerEnv,proto3" json:"header_env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`       // Header name to the environment variable holding its value
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AddEndpointRequest) Reset() {
+	*x = AddEndpointRequest{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEndpointRequest) ProtoMessage() {}
+
+func (x *AddEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEndpointRequest.ProtoReflect.Descriptor instead.
+func (*AddEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddEndpointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *AddEndpointRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetPasswordFile() string {
+	if x != nil {
+		return x.PasswordFile
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetPasswordEnv() string {
+	if x != nil {
+		return x.PasswordEnv
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetBearerTokenFile() string {
+	if x != nil {
+		return x.BearerTokenFile
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetBearerTokenEnv() string {
+	if x != nil {
+		return x.BearerTokenEnv
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetTlsCaFile() string {
+	if x != nil {
+		return x.TlsCaFile
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetTlsInsecureSkipVerify() bool {
+	if x != nil {
+		return x.TlsInsecureSkipVerify
+	}
+	return false
+}
+
+func (x *AddEndpointRequest) GetProxy() string {
+	if x != nil {
+		return x.Proxy
+	}
+	return ""
+}
+
+func (x *AddEndpointRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *AddEndpointRequest) GetHealthTimeoutMs() uint64 {
+	if x != nil {
+		return x.HealthTimeoutMs
+	}
+	return 0
+}
+
+func (x *AddEndpointRequest) GetHeaderFiles() map[string]string {
+	if x != nil {
+		return x.HeaderFiles
+	}
+	return nil
+}
+
+func (x *AddEndpointRequest) GetHeaderEnv() map[string]string {
+	if x != nil {
+		return x.HeaderEnv
+	}
+	return nil
+}
+
+type AddEndpointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*Endpoint            `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"` // Endpoints after the change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEndpointResponse) Reset() {
+	*x = AddEndpointResponse{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEndpointResponse) ProtoMessage() {}
+
+func (x *AddEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEndpointResponse.ProtoReflect.Descriptor instead.
+func (*AddEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddEndpointResponse) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type RemoveEndpointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEndpointRequest) Reset() {
+	*x = RemoveEndpointRequest{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEndpointRequest) ProtoMessage() {}
+
+func (x *RemoveEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEndpointRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoveEndpointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RemoveEndpointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*Endpoint            `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"` // Endpoints after the change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEndpointResponse) Reset() {
+	*x = RemoveEndpointResponse{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEndpointResponse) ProtoMessage() {}
+
+func (x *RemoveEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEndpointResponse.ProtoReflect.Descriptor instead.
+func (*RemoveEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveEndpointResponse) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type SetPrimaryEndpointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryEndpointRequest) Reset() {
+	*x = SetPrimaryEndpointRequest{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryEndpointRequest) ProtoMessage() {}
+
+func (x *SetPrimaryEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryEndpointRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetPrimaryEndpointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetPrimaryEndpointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*Endpoint            `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"` // Endpoints after the change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryEndpointResponse) Reset() {
+	*x = SetPrimaryEndpointResponse{}
+	mi := &file_proto_api_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryEndpointResponse) ProtoMessage() {}
+
+func (x *SetPrimaryEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryEndpointResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetPrimaryEndpointResponse) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+var File_proto_api_v1_admin_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/api/v1/admin.proto\x12\x06api.v1\"\xd6\x01\n" +
+	"\bEndpoint\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimary\x12\x1d\n" +
+	"\n" +
+	"is_healthy\x18\x04 \x01(\bR\tisHealthy\x12\x1f\n" +
+	"\vfrom_config\x18\x05 \x01(\bR\n" +
+	"fromConfig\x12&\n" +
+	"\x0flast_checked_ms\x18\x06 \x01(\x03R\rlastCheckedMs\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\"\x16\n" +
+	"\x14ListEndpointsRequest\"G\n" +
+	"\x15ListEndpointsResponse\x12.\n" +
+	"\tendpoints\x18\x01 \x03(\v2\x10.api.v1.EndpointR\tendpoints\"\xc5\x06\n" +
+	"\x12AddEndpointRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12A\n" +
+	"\aheaders\x18\x03 \x03(\v2'.api.v1.AddEndpointRequest.HeadersEntryR\aheaders\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12#\n" +
+	"\rpassword_file\x18\x05 \x01(\tR\fpasswordFile\x12!\n" +
+	"\fpassword_env\x18\x06 \x01(\tR\vpasswordEnv\x12*\n" +
+	"\x11bearer_token_file\x18\a \x01(\tR\x0fbearerTokenFile\x12(\n" +
+	"\x10bearer_token_env\x18\b \x01(\tR\x0ebearerTokenEnv\x12\x1e\n" +
+	"\vtls_ca_file\x18\t \x01(\tR\ttlsCaFile\x127\n" +
+	"\x18tls_insecure_skip_verify\x18\n" +
+	" \x01(\bR\x15tlsInsecureSkipVerify\x12\x14\n" +
+	"\x05proxy\x18\v \x01(\tR\x05proxy\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\f \x01(\x04R\ttimeoutMs\x12*\n" +
+	"\x11health_timeout_ms\x18\r \x01(\x04R\x0fhealthTimeoutMs\x12N\n" +
+	"\fheader_files\x18\x0e \x03(\v2+.api.v1.AddEndpointRequest.HeaderFilesEntryR\vheaderFiles\x12H\n" +
+	"\n" +
+	"header_env\x18\x0f \x03(\v2).api.v1.AddEndpointRequest.HeaderEnvEntryR\theaderEnv\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10HeaderFilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eHeaderEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x13AddEndpointResponse\x12.\n" +
+	"\tendpoints\x18\x01 \x03(\v2\x10.api.v1.EndpointR\tendpoints\"+\n" +
+	"\x15RemoveEndpointRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"H\n" +
+	"\x16RemoveEndpointResponse\x12.\n" +
+	"\tendpoints\x18\x01 \x03(\v2\x10.api.v1.EndpointR\tendpoints\"/\n" +
+	"\x19SetPrimaryEndpointRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x1aSetPrimaryEndpointResponse\x12.\n" +
+	"\tendpoints\x18\x01 \x03(\v2\x10.api.v1.EndpointR\tendpoints2\xd2\x02\n" +
+	"\fAdminService\x12L\n" +
+	"\rListEndpoints\x12\x1c.api.v1.ListEndpointsRequest\x1a\x1d.api.v1.ListEndpointsResponse\x12F\n" +
+	"\vAddEndpoint\x12\x1a.api.v1.AddEndpointRequest\x1a\x1b.api.v1.AddEndpointResponse\x12O\n" +
+	"\x0eRemoveEndpoint\x12\x1d.api.v1.RemoveEndpointRequest\x1a\x1e.api.v1.RemoveEndpointResponse\x12[\n" +
+	"\x12SetPrimaryEndpoint\x12!.api.v1.SetPrimaryEndpointRequest\x1a\".api.v1.SetPrimaryEndpointResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_admin_proto_rawDescOnce sync.Once
+	file_proto_api_v1_admin_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_admin_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_admin_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_admin_proto_rawDesc), len(file_proto_api_v1_admin_proto_rawDesc)))
+	})
+	return file_proto_api_v1_admin_proto_rawDescData
+}
+
+var file_proto_api_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_api_v1_admin_proto_goTypes = []any{
+	(*Endpoint)(nil),                   // 0: api.v1.Endpoint
+	(*ListEndpointsRequest)(nil),       // 1: api.v1.ListEndpointsRequest
+	(*ListEndpointsResponse)(nil),      // 2: api.v1.ListEndpointsResponse
+	(*AddEndpointRequest)(nil),         // 3: api.v1.AddEndpointRequest
+	(*AddEndpointResponse)(nil),        // 4: api.v1.AddEndpointResponse
+	(*RemoveEndpointRequest)(nil),      // 5: api.v1.RemoveEndpointRequest
+	(*RemoveEndpointResponse)(nil),     // 6: api.v1.RemoveEndpointResponse
+	(*SetPrimaryEndpointRequest)(nil),  // 7: api.v1.SetPrimaryEndpointRequest
+	(*SetPrimaryEndpointResponse)(nil), // 8: api.v1.SetPrimaryEndpointResponse
+	nil,                                // 9: api.v1.AddEndpointRequest.HeadersEntry
+	nil,                                // 10: api.v1.AddEndpointRequest.HeaderFilesEntry
+	nil,                                // 11: api.v1.AddEndpointRequest.HeaderEnvEntry
+}
+var file_proto_api_v1_admin_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListEndpointsResponse.endpoints:type_name -> api.v1.Endpoint
+	9,  // 1: api.v1.AddEndpointRequest.headers:type_name -> api.v1.AddEndpointRequest.HeadersEntry
+	10, // 2: api.v1.AddEndpointRequest.header_files:type_name -> api.v1.AddEndpointRequest.HeaderFilesEntry
+	11, // 3: api.v1.AddEndpointRequest.header_env:type_name -> api.v1.AddEndpointRequest.HeaderEnvEntry
+	0,  // 4: api.v1.AddEndpointResponse.endpoints:type_name -> api.v1.Endpoint
+	0,  // 5: api.v1.RemoveEndpointResponse.endpoints:type_name -> api.v1.Endpoint
+	0,  // 6: api.v1.SetPrimaryEndpointResponse.endpoints:type_name -> api.v1.Endpoint
+	1,  // 7: api.v1.AdminService.ListEndpoints:input_type -> api.v1.ListEndpointsRequest
+	3,  // 8: api.v1.AdminService.AddEndpoint:input_type -> api.v1.AddEndpointRequest
+	5,  // 9: api.v1.AdminService.RemoveEndpoint:input_type -> api.v1.RemoveEndpointRequest
+	7,  // 10: api.v1.AdminService.SetPrimaryEndpoint:input_type -> api.v1.SetPrimaryEndpointRequest
+	2,  // 11: api.v1.AdminService.ListEndpoints:output_type -> api.v1.ListEndpointsResponse
+	4,  // 12: api.v1.AdminService.AddEndpoint:output_type -> api.v1.AddEndpointResponse
+	6,  // 13: api.v1.AdminService.RemoveEndpoint:output_type -> api.v1.RemoveEndpointResponse
+	8,  // 14: api.v1.AdminService.SetPrimaryEndpoint:output_type -> api.v1.SetPrimaryEndpointResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_admin_proto_init() }
+func file_proto_api_v1_admin_proto_init() {
+	if File_proto_api_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_admin_proto_rawDesc), len(file_proto_api_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_admin_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_admin_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_admin_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_admin_proto = out.File
+	file_proto_api_v1_admin_proto_goTypes = nil
+	file_proto_api_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/admin.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "api.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceListEndpointsProcedure is the fully-qualified name of the AdminService's
+	// ListEndpoints RPC.
+	AdminServiceListEndpointsProcedure = "/api.v1.AdminService/ListEndpoints"
+	// AdminServiceAddEndpointProcedure is the fully-qualified name of the AdminService's AddEndpoint
+	// RPC.
+	AdminServiceAddEndpointProcedure = "/api.v1.AdminService/AddEndpoint"
+	// AdminServiceRemoveEndpointProcedure is the fully-qualified name of the AdminService's
+	// RemoveEndpoint RPC.
+	AdminServiceRemoveEndpointProcedure = "/api.v1.AdminService/RemoveEndpoint"
+	// AdminServiceSetPrimaryEndpointProcedure is the fully-qualified name of the AdminService's
+	// SetPrimaryEndpoint RPC.
+	AdminServiceSetPrimaryEndpointProcedure = "/api.v1.AdminService/SetPrimaryEndpoint"
+)
+
+// AdminServiceClient is a client for the api.v1.AdminService service.
+type AdminServiceClient interface {
+	// List all endpoints in the client pool
+	ListEndpoints(context.Context, *connect.Request[v1.ListEndpointsRequest]) (*connect.Response[v1.ListEndpointsResponse], error)
+	// Add a new endpoint to the client pool
+	AddEndpoint(context.Context, *connect.Request[v1.AddEndpointRequest]) (*connect.Response[v1.AddEndpointResponse], error)
+	// Remove an endpoint from the client pool
+	RemoveEndpoint(context.Context, *connect.Request[v1.RemoveEndpointRequest]) (*connect.Response[v1.RemoveEndpointResponse], error)
+	// Make an endpoint the preferred client for polling
+	SetPrimaryEndpoint(context.Context, *connect.Request[v1.SetPrimaryEndpointRequest]) (*connect.Response[v1.SetPrimaryEndpointResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the api.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_proto_api_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		listEndpoints: connect.NewClient[v1.ListEndpointsRequest, v1.ListEndpointsResponse](
+			httpClient,
+			baseURL+AdminServiceListEndpointsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListEndpoints")),
+			connect.WithClientOptions(opts...),
+		),
+		addEndpoint: connect.NewClient[v1.AddEndpointRequest, v1.AddEndpointResponse](
+			httpClient,
+			baseURL+AdminServiceAddEndpointProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("AddEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		removeEndpoint: connect.NewClient[v1.RemoveEndpointRequest, v1.RemoveEndpointResponse](
+			httpClient,
+			baseURL+AdminServiceRemoveEndpointProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RemoveEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		setPrimaryEndpoint: connect.NewClient[v1.SetPrimaryEndpointRequest, v1.SetPrimaryEndpointResponse](
+			httpClient,
+			baseURL+AdminServiceSetPrimaryEndpointProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetPrimaryEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	listEndpoints      *connect.Client[v1.ListEndpointsRequest, v1.ListEndpointsResponse]
+	addEndpoint        *connect.Client[v1.AddEndpointRequest, v1.AddEndpointResponse]
+	removeEndpoint     *connect.Client[v1.RemoveEndpointRequest, v1.RemoveEndpointResponse]
+	setPrimaryEndpoint *connect.Client[v1.SetPrimaryEndpointRequest, v1.SetPrimaryEndpointResponse]
+}
+
+// ListEndpoints calls api.v1.AdminService.ListEndpoints.
+func (c *adminServiceClient) ListEndpoints(ctx context.Context, req *connect.Request[v1.ListEndpointsRequest]) (*connect.Response[v1.ListEndpointsResponse], error) {
+	return c.listEndpoints.CallUnary(ctx, req)
+}
+
+// AddEndpoint calls api.v1.AdminService.AddEndpoint.
+func (c *adminServiceClient) AddEndpoint(ctx context.Context, req *connect.Request[v1.AddEndpointRequest]) (*connect.Response[v1.AddEndpointResponse], error) {
+	return c.addEndpoint.CallUnary(ctx, req)
+}
+
+// RemoveEndpoint calls api.v1.AdminService.RemoveEndpoint.
+func (c *adminServiceClient) RemoveEndpoint(ctx context.Context, req *connect.Request[v1.RemoveEndpointRequest]) (*connect.Response[v1.RemoveEndpointResponse], error) {
+	return c.removeEndpoint.CallUnary(ctx, req)
+}
+
+// SetPrimaryEndpoint calls api.v1.AdminService.SetPrimaryEndpoint.
+func (c *adminServiceClient) SetPrimaryEndpoint(ctx context.Context, req *connect.Request[v1.SetPrimaryEndpointRequest]) (*connect.Response[v1.SetPrimaryEndpointResponse], error) {
+	return c.setPrimaryEndpoint.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the api.v1.AdminService service.
+type AdminServiceHandler interface {
+	// List all endpoints in the client pool
+	ListEndpoints(context.Context, *connect.Request[v1.ListEndpointsRequest]) (*connect.Response[v1.ListEndpointsResponse], error)
+	// Add a new endpoint to the client pool
+	AddEndpoint(context.Context, *connect.Request[v1.AddEndpointRequest]) (*connect.Response[v1.AddEndpointResponse], error)
+	// Remove an endpoint from the client pool
+	RemoveEndpoint(context.Context, *connect.Request[v1.RemoveEndpointRequest]) (*connect.Response[v1.RemoveEndpointResponse], error)
+	// Make an endpoint the preferred client for polling
+	SetPrimaryEndpoint(context.Context, *connect.Request[v1.SetPrimaryEndpointRequest]) (*connect.Response[v1.SetPrimaryEndpointResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_proto_api_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceListEndpointsHandler := connect.NewUnaryHandler(
+		AdminServiceListEndpointsProcedure,
+		svc.ListEndpoints,
+		connect.WithSchema(adminServiceMethods.ByName("ListEndpoints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceAddEndpointHandler := connect.NewUnaryHandler(
+		AdminServiceAddEndpointProcedure,
+		svc.AddEndpoint,
+		connect.WithSchema(adminServiceMethods.ByName("AddEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRemoveEndpointHandler := connect.NewUnaryHandler(
+		AdminServiceRemoveEndpointProcedure,
+		svc.RemoveEndpoint,
+		connect.WithSchema(adminServiceMethods.ByName("RemoveEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetPrimaryEndpointHandler := connect.NewUnaryHandler(
+		AdminServiceSetPrimaryEndpointProcedure,
+		svc.SetPrimaryEndpoint,
+		connect.WithSchema(adminServiceMethods.ByName("SetPrimaryEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceListEndpointsProcedure:
+			adminServiceListEndpointsHandler.ServeHTTP(w, r)
+		case AdminServiceAddEndpointProcedure:
+			adminServiceAddEndpointHandler.ServeHTTP(w, r)
+		case AdminServiceRemoveEndpointProcedure:
+			adminServiceRemoveEndpointHandler.ServeHTTP(w, r)
+		case AdminServiceSetPrimaryEndpointProcedure:
+			adminServiceSetPrimaryEndpointHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ListEndpoints(context.Context, *connect.Request[v1.ListEndpointsRequest]) (*connect.Response[v1.ListEndpointsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.ListEndpoints is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) AddEndpoint(context.Context, *connect.Request[v1.AddEndpointRequest]) (*connect.Response[v1.AddEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.AddEndpoint is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RemoveEndpoint(context.Context, *connect.Request[v1.RemoveEndpointRequest]) (*connect.Response[v1.RemoveEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.RemoveEndpoint is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetPrimaryEndpoint(context.Context, *connect.Request[v1.SetPrimaryEndpointRequest]) (*connect.Response[v1.SetPrimaryEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.SetPrimaryEndpoint is not implemented"))
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/chainquery.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ChainQueryServiceName is the fully-qualified name of the ChainQueryService service.
+	ChainQueryServiceName = "api.v1.ChainQueryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ChainQueryServiceIsAncestorProcedure is the fully-qualified name of the ChainQueryService's
+	// IsAncestor RPC.
+	ChainQueryServiceIsAncestorProcedure = "/api.v1.ChainQueryService/IsAncestor"
+	// ChainQueryServiceGetCommonAncestorProcedure is the fully-qualified name of the
+	// ChainQueryService's GetCommonAncestor RPC.
+	ChainQueryServiceGetCommonAncestorProcedure = "/api.v1.ChainQueryService/GetCommonAncestor"
+	// ChainQueryServiceGetAncestorAtSlotProcedure is the fully-qualified name of the
+	// ChainQueryService's GetAncestorAtSlot RPC.
+	ChainQueryServiceGetAncestorAtSlotProcedure = "/api.v1.ChainQueryService/GetAncestorAtSlot"
+	// ChainQueryServiceGetBranchProcedure is the fully-qualified name of the ChainQueryService's
+	// GetBranch RPC.
+	ChainQueryServiceGetBranchProcedure = "/api.v1.ChainQueryService/GetBranch"
+)
+
+// ChainQueryServiceClient is a client for the api.v1.ChainQueryService service.
+type ChainQueryServiceClient interface {
+	// Check whether a block is another block or one of its ancestors
+	IsAncestor(context.Context, *connect.Request[v1.IsAncestorRequest]) (*connect.Response[v1.IsAncestorResponse], error)
+	// Get the latest block two blocks both descend from
+	GetCommonAncestor(context.Context, *connect.Request[v1.GetCommonAncestorRequest]) (*connect.Response[v1.GetCommonAncestorResponse], error)
+	// Get the block at a slot on the chain of a head block
+	GetAncestorAtSlot(context.Context, *connect.Request[v1.GetAncestorAtSlotRequest]) (*connect.Response[v1.GetAncestorAtSlotResponse], error)
+	// Get the blocks of a block's branch from the indexed chain up to its tip
+	GetBranch(context.Context, *connect.Request[v1.GetBranchRequest]) (*connect.Response[v1.GetBranchResponse], error)
+}
+
+// NewChainQueryServiceClient constructs a client for the api.v1.ChainQueryService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChainQueryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChainQueryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	chainQueryServiceMethods := v1.File_proto_api_v1_chainquery_proto.Services().ByName("ChainQueryService").Methods()
+	return &chainQueryServiceClient{
+		isAncestor: connect.NewClient[v1.IsAncestorRequest, v1.IsAncestorResponse](
+			httpClient,
+			baseURL+ChainQueryServiceIsAncestorProcedure,
+			connect.WithSchema(chainQueryServiceMethods.ByName("IsAncestor")),
+			connect.WithClientOptions(opts...),
+		),
+		getCommonAncestor: connect.NewClient[v1.GetCommonAncestorRequest, v1.GetCommonAncestorResponse](
+			httpClient,
+			baseURL+ChainQueryServiceGetCommonAncestorProcedure,
+			connect.WithSchema(chainQueryServiceMethods.ByName("GetCommonAncestor")),
+			connect.WithClientOptions(opts...),
+		),
+		getAncestorAtSlot: connect.NewClient[v1.GetAncestorAtSlotRequest, v1.GetAncestorAtSlotResponse](
+			httpClient,
+			baseURL+ChainQueryServiceGetAncestorAtSlotProcedure,
+			connect.WithSchema(chainQueryServiceMethods.ByName("GetAncestorAtSlot")),
+			connect.WithClientOptions(opts...),
+		),
+		getBranch: connect.NewClient[v1.GetBranchRequest, v1.GetBranchResponse](
+			httpClient,
+			baseURL+ChainQueryServiceGetBranchProcedure,
+			connect.WithSchema(chainQueryServiceMethods.ByName("GetBranch")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// chainQueryServiceClient implements ChainQueryServiceClient.
+type chainQueryServiceClient struct {
+	isAncestor        *connect.Client[v1.IsAncestorRequest, v1.IsAncestorResponse]
+	getCommonAncestor *connect.Client[v1.GetCommonAncestorRequest, v1.GetCommonAncestorResponse]
+	getAncestorAtSlot *connect.Client[v1.GetAncestorAtSlotRequest, v1.GetAncestorAtSlotResponse]
+	getBranch         *connect.Client[v1.GetBranchRequest, v1.GetBranchResponse]
+}
+
+// IsAncestor calls api.v1.ChainQueryService.IsAncestor.
+func (c *chainQueryServiceClient) IsAncestor(ctx context.Context, req *connect.Request[v1.IsAncestorRequest]) (*connect.Response[v1.IsAncestorResponse], error) {
+	return c.isAncestor.CallUnary(ctx, req)
+}
+
+// GetCommonAncestor calls api.v1.ChainQueryService.GetCommonAncestor.
+func (c *chainQueryServiceClient) GetCommonAncestor(ctx context.Context, req *connect.Request[v1.GetCommonAncestorRequest]) (*connect.Response[v1.GetCommonAncestorResponse], error) {
+	return c.getCommonAncestor.CallUnary(ctx, req)
+}
+
+// GetAncestorAtSlot calls api.v1.ChainQueryService.GetAncestorAtSlot.
+func (c *chainQueryServiceClient) GetAncestorAtSlot(ctx context.Context, req *connect.Request[v1.GetAncestorAtSlotRequest]) (*connect.Response[v1.GetAncestorAtSlotResponse], error) {
+	return c.getAncestorAtSlot.CallUnary(ctx, req)
+}
+
+// GetBranch calls api.v1.ChainQueryService.GetBranch.
+func (c *chainQueryServiceClient) GetBranch(ctx context.Context, req *connect.Request[v1.GetBranchRequest]) (*connect.Response[v1.GetBranchResponse], error) {
+	return c.getBranch.CallUnary(ctx, req)
+}
+
+// ChainQueryServiceHandler is an implementation of the api.v1.ChainQueryService service.
+type ChainQueryServiceHandler interface {
+	// Check whether a block is another block or one of its ancestors
+	IsAncestor(context.Context, *connect.Request[v1.IsAncestorRequest]) (*connect.Response[v1.IsAncestorResponse], error)
+	// Get the latest block two blocks both descend from
+	GetCommonAncestor(context.Context, *connect.Request[v1.GetCommonAncestorRequest]) (*connect.Response[v1.GetCommonAncestorResponse], error)
+	// Get the block at a slot on the chain of a head block
+	GetAncestorAtSlot(context.Context, *connect.Request[v1.GetAncestorAtSlotRequest]) (*connect.Response[v1.GetAncestorAtSlotResponse], error)
+	// Get the blocks of a block's branch from the indexed chain up to its tip
+	GetBranch(context.Context, *connect.Request[v1.GetBranchRequest]) (*connect.Response[v1.GetBranchResponse], error)
+}
+
+// NewChainQueryServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChainQueryServiceHandler(svc ChainQueryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	chainQueryServiceMethods := v1.File_proto_api_v1_chainquery_proto.Services().ByName("ChainQueryService").Methods()
+	chainQueryServiceIsAncestorHandler := connect.NewUnaryHandler(
+		ChainQueryServiceIsAncestorProcedure,
+		svc.IsAncestor,
+		connect.WithSchema(chainQueryServiceMethods.ByName("IsAncestor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	chainQueryServiceGetCommonAncestorHandler := connect.NewUnaryHandler(
+		ChainQueryServiceGetCommonAncestorProcedure,
+		svc.GetCommonAncestor,
+		connect.WithSchema(chainQueryServiceMethods.ByName("GetCommonAncestor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	chainQueryServiceGetAncestorAtSlotHandler := connect.NewUnaryHandler(
+		ChainQueryServiceGetAncestorAtSlotProcedure,
+		svc.GetAncestorAtSlot,
+		connect.WithSchema(chainQueryServiceMethods.ByName("GetAncestorAtSlot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	chainQueryServiceGetBranchHandler := connect.NewUnaryHandler(
+		ChainQueryServiceGetBranchProcedure,
+		svc.GetBranch,
+		connect.WithSchema(chainQueryServiceMethods.ByName("GetBranch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.ChainQueryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChainQueryServiceIsAncestorProcedure:
+			chainQueryServiceIsAncestorHandler.ServeHTTP(w, r)
+		case ChainQueryServiceGetCommonAncestorProcedure:
+			chainQueryServiceGetCommonAncestorHandler.ServeHTTP(w, r)
+		case ChainQueryServiceGetAncestorAtSlotProcedure:
+			chainQueryServiceGetAncestorAtSlotHandler.ServeHTTP(w, r)
+		case ChainQueryServiceGetBranchProcedure:
+			chainQueryServiceGetBranchHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChainQueryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChainQueryServiceHandler struct{}
+
+func (UnimplementedChainQueryServiceHandler) IsAncestor(context.Context, *connect.Request[v1.IsAncestorRequest]) (*connect.Response[v1.IsAncestorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChainQueryService.IsAncestor is not implemented"))
+}
+
+func (UnimplementedChainQueryServiceHandler) GetCommonAncestor(context.Context, *connect.Request[v1.GetCommonAncestorRequest]) (*connect.Response[v1.GetCommonAncestorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChainQueryService.GetCommonAncestor is not implemented"))
+}
+
+func (UnimplementedChainQueryServiceHandler) GetAncestorAtSlot(context.Context, *connect.Request[v1.GetAncestorAtSlotRequest]) (*connect.Response[v1.GetAncestorAtSlotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChainQueryService.GetAncestorAtSlot is not implemented"))
+}
+
+func (UnimplementedChainQueryServiceHandler) GetBranch(context.Context, *connect.Request[v1.GetBranchRequest]) (*connect.Response[v1.GetBranchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChainQueryService.GetBranch is not implemented"))
+}
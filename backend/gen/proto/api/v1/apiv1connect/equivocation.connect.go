@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/equivocation.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EquivocationServiceName is the fully-qualified name of the EquivocationService service.
+	EquivocationServiceName = "api.v1.EquivocationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EquivocationServiceGetEquivocationsProcedure is the fully-qualified name of the
+	// EquivocationService's GetEquivocations RPC.
+	EquivocationServiceGetEquivocationsProcedure = "/api.v1.EquivocationService/GetEquivocations"
+)
+
+// EquivocationServiceClient is a client for the api.v1.EquivocationService service.
+type EquivocationServiceClient interface {
+	// Get detected double proposals, double votes and surround votes
+	GetEquivocations(context.Context, *connect.Request[v1.GetEquivocationsRequest]) (*connect.Response[v1.GetEquivocationsResponse], error)
+}
+
+// NewEquivocationServiceClient constructs a client for the api.v1.EquivocationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEquivocationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EquivocationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	equivocationServiceMethods := v1.File_proto_api_v1_equivocation_proto.Services().ByName("EquivocationService").Methods()
+	return &equivocationServiceClient{
+		getEquivocations: connect.NewClient[v1.GetEquivocationsRequest, v1.GetEquivocationsResponse](
+			httpClient,
+			baseURL+EquivocationServiceGetEquivocationsProcedure,
+			connect.WithSchema(equivocationServiceMethods.ByName("GetEquivocations")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// equivocationServiceClient implements EquivocationServiceClient.
+type equivocationServiceClient struct {
+	getEquivocations *connect.Client[v1.GetEquivocationsRequest, v1.GetEquivocationsResponse]
+}
+
+// GetEquivocations calls api.v1.EquivocationService.GetEquivocations.
+func (c *equivocationServiceClient) GetEquivocations(ctx context.Context, req *connect.Request[v1.GetEquivocationsRequest]) (*connect.Response[v1.GetEquivocationsResponse], error) {
+	return c.getEquivocations.CallUnary(ctx, req)
+}
+
+// EquivocationServiceHandler is an implementation of the api.v1.EquivocationService service.
+type EquivocationServiceHandler interface {
+	// Get detected double proposals, double votes and surround votes
+	GetEquivocations(context.Context, *connect.Request[v1.GetEquivocationsRequest]) (*connect.Response[v1.GetEquivocationsResponse], error)
+}
+
+// NewEquivocationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEquivocationServiceHandler(svc EquivocationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	equivocationServiceMethods := v1.File_proto_api_v1_equivocation_proto.Services().ByName("EquivocationService").Methods()
+	equivocationServiceGetEquivocationsHandler := connect.NewUnaryHandler(
+		EquivocationServiceGetEquivocationsProcedure,
+		svc.GetEquivocations,
+		connect.WithSchema(equivocationServiceMethods.ByName("GetEquivocations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.EquivocationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EquivocationServiceGetEquivocationsProcedure:
+			equivocationServiceGetEquivocationsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEquivocationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEquivocationServiceHandler struct{}
+
+func (UnimplementedEquivocationServiceHandler) GetEquivocations(context.Context, *connect.Request[v1.GetEquivocationsRequest]) (*connect.Response[v1.GetEquivocationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.EquivocationService.GetEquivocations is not implemented"))
+}
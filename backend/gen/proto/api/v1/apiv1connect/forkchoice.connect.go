@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/forkchoice.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ForkChoiceServiceName is the fully-qualified name of the ForkChoiceService service.
+	ForkChoiceServiceName = "api.v1.ForkChoiceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ForkChoiceServiceGetForkChoiceTreeProcedure is the fully-qualified name of the
+	// ForkChoiceService's GetForkChoiceTree RPC.
+	ForkChoiceServiceGetForkChoiceTreeProcedure = "/api.v1.ForkChoiceService/GetForkChoiceTree"
+)
+
+// ForkChoiceServiceClient is a client for the api.v1.ForkChoiceService service.
+type ForkChoiceServiceClient interface {
+	// Get the blocks since the finalized checkpoint with vote weights and each client's head
+	GetForkChoiceTree(context.Context, *connect.Request[v1.GetForkChoiceTreeRequest]) (*connect.Response[v1.GetForkChoiceTreeResponse], error)
+}
+
+// NewForkChoiceServiceClient constructs a client for the api.v1.ForkChoiceService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewForkChoiceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ForkChoiceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	forkChoiceServiceMethods := v1.File_proto_api_v1_forkchoice_proto.Services().ByName("ForkChoiceService").Methods()
+	return &forkChoiceServiceClient{
+		getForkChoiceTree: connect.NewClient[v1.GetForkChoiceTreeRequest, v1.GetForkChoiceTreeResponse](
+			httpClient,
+			baseURL+ForkChoiceServiceGetForkChoiceTreeProcedure,
+			connect.WithSchema(forkChoiceServiceMethods.ByName("GetForkChoiceTree")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// forkChoiceServiceClient implements ForkChoiceServiceClient.
+type forkChoiceServiceClient struct {
+	getForkChoiceTree *connect.Client[v1.GetForkChoiceTreeRequest, v1.GetForkChoiceTreeResponse]
+}
+
+// GetForkChoiceTree calls api.v1.ForkChoiceService.GetForkChoiceTree.
+func (c *forkChoiceServiceClient) GetForkChoiceTree(ctx context.Context, req *connect.Request[v1.GetForkChoiceTreeRequest]) (*connect.Response[v1.GetForkChoiceTreeResponse], error) {
+	return c.getForkChoiceTree.CallUnary(ctx, req)
+}
+
+// ForkChoiceServiceHandler is an implementation of the api.v1.ForkChoiceService service.
+type ForkChoiceServiceHandler interface {
+	// Get the blocks since the finalized checkpoint with vote weights and each client's head
+	GetForkChoiceTree(context.Context, *connect.Request[v1.GetForkChoiceTreeRequest]) (*connect.Response[v1.GetForkChoiceTreeResponse], error)
+}
+
+// NewForkChoiceServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewForkChoiceServiceHandler(svc ForkChoiceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	forkChoiceServiceMethods := v1.File_proto_api_v1_forkchoice_proto.Services().ByName("ForkChoiceService").Methods()
+	forkChoiceServiceGetForkChoiceTreeHandler := connect.NewUnaryHandler(
+		ForkChoiceServiceGetForkChoiceTreeProcedure,
+		svc.GetForkChoiceTree,
+		connect.WithSchema(forkChoiceServiceMethods.ByName("GetForkChoiceTree")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.ForkChoiceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ForkChoiceServiceGetForkChoiceTreeProcedure:
+			forkChoiceServiceGetForkChoiceTreeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedForkChoiceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedForkChoiceServiceHandler struct{}
+
+func (UnimplementedForkChoiceServiceHandler) GetForkChoiceTree(context.Context, *connect.Request[v1.GetForkChoiceTreeRequest]) (*connect.Response[v1.GetForkChoiceTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ForkChoiceService.GetForkChoiceTree is not implemented"))
+}
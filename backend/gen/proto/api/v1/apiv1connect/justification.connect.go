@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/justification.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// JustificationServiceName is the fully-qualified name of the JustificationService service.
+	JustificationServiceName = "api.v1.JustificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// JustificationServiceGetJustificationProgressProcedure is the fully-qualified name of the
+	// JustificationService's GetJustificationProgress RPC.
+	JustificationServiceGetJustificationProgressProcedure = "/api.v1.JustificationService/GetJustificationProgress"
+)
+
+// JustificationServiceClient is a client for the api.v1.JustificationService service.
+type JustificationServiceClient interface {
+	// Get the votes of every candidate target slot since the finalized slot
+	GetJustificationProgress(context.Context, *connect.Request[v1.GetJustificationProgressRequest]) (*connect.Response[v1.GetJustificationProgressResponse], error)
+}
+
+// NewJustificationServiceClient constructs a client for the api.v1.JustificationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJustificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JustificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	justificationServiceMethods := v1.File_proto_api_v1_justification_proto.Services().ByName("JustificationService").Methods()
+	return &justificationServiceClient{
+		getJustificationProgress: connect.NewClient[v1.GetJustificationProgressRequest, v1.GetJustificationProgressResponse](
+			httpClient,
+			baseURL+JustificationServiceGetJustificationProgressProcedure,
+			connect.WithSchema(justificationServiceMethods.ByName("GetJustificationProgress")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// justificationServiceClient implements JustificationServiceClient.
+type justificationServiceClient struct {
+	getJustificationProgress *connect.Client[v1.GetJustificationProgressRequest, v1.GetJustificationProgressResponse]
+}
+
+// GetJustificationProgress calls api.v1.JustificationService.GetJustificationProgress.
+func (c *justificationServiceClient) GetJustificationProgress(ctx context.Context, req *connect.Request[v1.GetJustificationProgressRequest]) (*connect.Response[v1.GetJustificationProgressResponse], error) {
+	return c.getJustificationProgress.CallUnary(ctx, req)
+}
+
+// JustificationServiceHandler is an implementation of the api.v1.JustificationService service.
+type JustificationServiceHandler interface {
+	// Get the votes of every candidate target slot since the finalized slot
+	GetJustificationProgress(context.Context, *connect.Request[v1.GetJustificationProgressRequest]) (*connect.Response[v1.GetJustificationProgressResponse], error)
+}
+
+// NewJustificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJustificationServiceHandler(svc JustificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	justificationServiceMethods := v1.File_proto_api_v1_justification_proto.Services().ByName("JustificationService").Methods()
+	justificationServiceGetJustificationProgressHandler := connect.NewUnaryHandler(
+		JustificationServiceGetJustificationProgressProcedure,
+		svc.GetJustificationProgress,
+		connect.WithSchema(justificationServiceMethods.ByName("GetJustificationProgress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.JustificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JustificationServiceGetJustificationProgressProcedure:
+			justificationServiceGetJustificationProgressHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJustificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJustificationServiceHandler struct{}
+
+func (UnimplementedJustificationServiceHandler) GetJustificationProgress(context.Context, *connect.Request[v1.GetJustificationProgressRequest]) (*connect.Response[v1.GetJustificationProgressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.JustificationService.GetJustificationProgress is not implemented"))
+}
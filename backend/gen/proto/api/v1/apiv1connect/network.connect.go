@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/network.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NetworkServiceName is the fully-qualified name of the NetworkService service.
+	NetworkServiceName = "api.v1.NetworkService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NetworkServiceGetNetworkSummaryProcedure is the fully-qualified name of the NetworkService's
+	// GetNetworkSummary RPC.
+	NetworkServiceGetNetworkSummaryProcedure = "/api.v1.NetworkService/GetNetworkSummary"
+)
+
+// NetworkServiceClient is a client for the api.v1.NetworkService service.
+type NetworkServiceClient interface {
+	// Get a summary of chain progress, finality and client health
+	GetNetworkSummary(context.Context, *connect.Request[v1.GetNetworkSummaryRequest]) (*connect.Response[v1.GetNetworkSummaryResponse], error)
+}
+
+// NewNetworkServiceClient constructs a client for the api.v1.NetworkService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNetworkServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NetworkServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	networkServiceMethods := v1.File_proto_api_v1_network_proto.Services().ByName("NetworkService").Methods()
+	return &networkServiceClient{
+		getNetworkSummary: connect.NewClient[v1.GetNetworkSummaryRequest, v1.GetNetworkSummaryResponse](
+			httpClient,
+			baseURL+NetworkServiceGetNetworkSummaryProcedure,
+			connect.WithSchema(networkServiceMethods.ByName("GetNetworkSummary")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// networkServiceClient implements NetworkServiceClient.
+type networkServiceClient struct {
+	getNetworkSummary *connect.Client[v1.GetNetworkSummaryRequest, v1.GetNetworkSummaryResponse]
+}
+
+// GetNetworkSummary calls api.v1.NetworkService.GetNetworkSummary.
+func (c *networkServiceClient) GetNetworkSummary(ctx context.Context, req *connect.Request[v1.GetNetworkSummaryRequest]) (*connect.Response[v1.GetNetworkSummaryResponse], error) {
+	return c.getNetworkSummary.CallUnary(ctx, req)
+}
+
+// NetworkServiceHandler is an implementation of the api.v1.NetworkService service.
+type NetworkServiceHandler interface {
+	// Get a summary of chain progress, finality and client health
+	GetNetworkSummary(context.Context, *connect.Request[v1.GetNetworkSummaryRequest]) (*connect.Response[v1.GetNetworkSummaryResponse], error)
+}
+
+// NewNetworkServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNetworkServiceHandler(svc NetworkServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	networkServiceMethods := v1.File_proto_api_v1_network_proto.Services().ByName("NetworkService").Methods()
+	networkServiceGetNetworkSummaryHandler := connect.NewUnaryHandler(
+		NetworkServiceGetNetworkSummaryProcedure,
+		svc.GetNetworkSummary,
+		connect.WithSchema(networkServiceMethods.ByName("GetNetworkSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.NetworkService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NetworkServiceGetNetworkSummaryProcedure:
+			networkServiceGetNetworkSummaryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNetworkServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNetworkServiceHandler struct{}
+
+func (UnimplementedNetworkServiceHandler) GetNetworkSummary(context.Context, *connect.Request[v1.GetNetworkSummaryRequest]) (*connect.Response[v1.GetNetworkSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.NetworkService.GetNetworkSummary is not implemented"))
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/proof.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProofServiceName is the fully-qualified name of the ProofService service.
+	ProofServiceName = "api.v1.ProofService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProofServiceGetHeaderProofProcedure is the fully-qualified name of the ProofService's
+	// GetHeaderProof RPC.
+	ProofServiceGetHeaderProofProcedure = "/api.v1.ProofService/GetHeaderProof"
+	// ProofServiceDiffHeadersProcedure is the fully-qualified name of the ProofService's DiffHeaders
+	// RPC.
+	ProofServiceDiffHeadersProcedure = "/api.v1.ProofService/DiffHeaders"
+)
+
+// ProofServiceClient is a client for the api.v1.ProofService service.
+type ProofServiceClient interface {
+	// Get Merkle proofs of the fields of a block header
+	GetHeaderProof(context.Context, *connect.Request[v1.GetHeaderProofRequest]) (*connect.Response[v1.GetHeaderProofResponse], error)
+	// Compare two block headers field by field, with proofs of every differing field
+	DiffHeaders(context.Context, *connect.Request[v1.DiffHeadersRequest]) (*connect.Response[v1.DiffHeadersResponse], error)
+}
+
+// NewProofServiceClient constructs a client for the api.v1.ProofService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProofServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProofServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	proofServiceMethods := v1.File_proto_api_v1_proof_proto.Services().ByName("ProofService").Methods()
+	return &proofServiceClient{
+		getHeaderProof: connect.NewClient[v1.GetHeaderProofRequest, v1.GetHeaderProofResponse](
+			httpClient,
+			baseURL+ProofServiceGetHeaderProofProcedure,
+			connect.WithSchema(proofServiceMethods.ByName("GetHeaderProof")),
+			connect.WithClientOptions(opts...),
+		),
+		diffHeaders: connect.NewClient[v1.DiffHeadersRequest, v1.DiffHeadersResponse](
+			httpClient,
+			baseURL+ProofServiceDiffHeadersProcedure,
+			connect.WithSchema(proofServiceMethods.ByName("DiffHeaders")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// proofServiceClient implements ProofServiceClient.
+type proofServiceClient struct {
+	getHeaderProof *connect.Client[v1.GetHeaderProofRequest, v1.GetHeaderProofResponse]
+	diffHeaders    *connect.Client[v1.DiffHeadersRequest, v1.DiffHeadersResponse]
+}
+
+// GetHeaderProof calls api.v1.ProofService.GetHeaderProof.
+func (c *proofServiceClient) GetHeaderProof(ctx context.Context, req *connect.Request[v1.GetHeaderProofRequest]) (*connect.Response[v1.GetHeaderProofResponse], error) {
+	return c.getHeaderProof.CallUnary(ctx, req)
+}
+
+// DiffHeaders calls api.v1.ProofService.DiffHeaders.
+func (c *proofServiceClient) DiffHeaders(ctx context.Context, req *connect.Request[v1.DiffHeadersRequest]) (*connect.Response[v1.DiffHeadersResponse], error) {
+	return c.diffHeaders.CallUnary(ctx, req)
+}
+
+// ProofServiceHandler is an implementation of the api.v1.ProofService service.
+type ProofServiceHandler interface {
+	// Get Merkle proofs of the fields of a block header
+	GetHeaderProof(context.Context, *connect.Request[v1.GetHeaderProofRequest]) (*connect.Response[v1.GetHeaderProofResponse], error)
+	// Compare two block headers field by field, with proofs of every differing field
+	DiffHeaders(context.Context, *connect.Request[v1.DiffHeadersRequest]) (*connect.Response[v1.DiffHeadersResponse], error)
+}
+
+// NewProofServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProofServiceHandler(svc ProofServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	proofServiceMethods := v1.File_proto_api_v1_proof_proto.Services().ByName("ProofService").Methods()
+	proofServiceGetHeaderProofHandler := connect.NewUnaryHandler(
+		ProofServiceGetHeaderProofProcedure,
+		svc.GetHeaderProof,
+		connect.WithSchema(proofServiceMethods.ByName("GetHeaderProof")),
+		connect.WithHandlerOptions(opts...),
+	)
+	proofServiceDiffHeadersHandler := connect.NewUnaryHandler(
+		ProofServiceDiffHeadersProcedure,
+		svc.DiffHeaders,
+		connect.WithSchema(proofServiceMethods.ByName("DiffHeaders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.ProofService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProofServiceGetHeaderProofProcedure:
+			proofServiceGetHeaderProofHandler.ServeHTTP(w, r)
+		case ProofServiceDiffHeadersProcedure:
+			proofServiceDiffHeadersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProofServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProofServiceHandler struct{}
+
+func (UnimplementedProofServiceHandler) GetHeaderProof(context.Context, *connect.Request[v1.GetHeaderProofRequest]) (*connect.Response[v1.GetHeaderProofResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ProofService.GetHeaderProof is not implemented"))
+}
+
+func (UnimplementedProofServiceHandler) DiffHeaders(context.Context, *connect.Request[v1.DiffHeadersRequest]) (*connect.Response[v1.DiffHeadersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ProofService.DiffHeaders is not implemented"))
+}
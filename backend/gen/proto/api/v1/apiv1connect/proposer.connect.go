@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/proposer.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProposerServiceName is the fully-qualified name of the ProposerService service.
+	ProposerServiceName = "api.v1.ProposerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProposerServiceGetProposerStatsProcedure is the fully-qualified name of the ProposerService's
+	// GetProposerStats RPC.
+	ProposerServiceGetProposerStatsProcedure = "/api.v1.ProposerService/GetProposerStats"
+	// ProposerServiceGetProposerLeaderboardProcedure is the fully-qualified name of the
+	// ProposerService's GetProposerLeaderboard RPC.
+	ProposerServiceGetProposerLeaderboardProcedure = "/api.v1.ProposerService/GetProposerLeaderboard"
+)
+
+// ProposerServiceClient is a client for the api.v1.ProposerService service.
+type ProposerServiceClient interface {
+	// Get proposal statistics for a single proposer index
+	GetProposerStats(context.Context, *connect.Request[v1.GetProposerStatsRequest]) (*connect.Response[v1.GetProposerStatsResponse], error)
+	// Get all proposers sorted by miss rate over a slot window
+	GetProposerLeaderboard(context.Context, *connect.Request[v1.GetProposerLeaderboardRequest]) (*connect.Response[v1.GetProposerLeaderboardResponse], error)
+}
+
+// NewProposerServiceClient constructs a client for the api.v1.ProposerService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProposerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProposerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	proposerServiceMethods := v1.File_proto_api_v1_proposer_proto.Services().ByName("ProposerService").Methods()
+	return &proposerServiceClient{
+		getProposerStats: connect.NewClient[v1.GetProposerStatsRequest, v1.GetProposerStatsResponse](
+			httpClient,
+			baseURL+ProposerServiceGetProposerStatsProcedure,
+			connect.WithSchema(proposerServiceMethods.ByName("GetProposerStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getProposerLeaderboard: connect.NewClient[v1.GetProposerLeaderboardRequest, v1.GetProposerLeaderboardResponse](
+			httpClient,
+			baseURL+ProposerServiceGetProposerLeaderboardProcedure,
+			connect.WithSchema(proposerServiceMethods.ByName("GetProposerLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// proposerServiceClient implements ProposerServiceClient.
+type proposerServiceClient struct {
+	getProposerStats       *connect.Client[v1.GetProposerStatsRequest, v1.GetProposerStatsResponse]
+	getProposerLeaderboard *connect.Client[v1.GetProposerLeaderboardRequest, v1.GetProposerLeaderboardResponse]
+}
+
+// GetProposerStats calls api.v1.ProposerService.GetProposerStats.
+func (c *proposerServiceClient) GetProposerStats(ctx context.Context, req *connect.Request[v1.GetProposerStatsRequest]) (*connect.Response[v1.GetProposerStatsResponse], error) {
+	return c.getProposerStats.CallUnary(ctx, req)
+}
+
+// GetProposerLeaderboard calls api.v1.ProposerService.GetProposerLeaderboard.
+func (c *proposerServiceClient) GetProposerLeaderboard(ctx context.Context, req *connect.Request[v1.GetProposerLeaderboardRequest]) (*connect.Response[v1.GetProposerLeaderboardResponse], error) {
+	return c.getProposerLeaderboard.CallUnary(ctx, req)
+}
+
+// ProposerServiceHandler is an implementation of the api.v1.ProposerService service.
+type ProposerServiceHandler interface {
+	// Get proposal statistics for a single proposer index
+	GetProposerStats(context.Context, *connect.Request[v1.GetProposerStatsRequest]) (*connect.Response[v1.GetProposerStatsResponse], error)
+	// Get all proposers sorted by miss rate over a slot window
+	GetProposerLeaderboard(context.Context, *connect.Request[v1.GetProposerLeaderboardRequest]) (*connect.Response[v1.GetProposerLeaderboardResponse], error)
+}
+
+// NewProposerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProposerServiceHandler(svc ProposerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	proposerServiceMethods := v1.File_proto_api_v1_proposer_proto.Services().ByName("ProposerService").Methods()
+	proposerServiceGetProposerStatsHandler := connect.NewUnaryHandler(
+		ProposerServiceGetProposerStatsProcedure,
+		svc.GetProposerStats,
+		connect.WithSchema(proposerServiceMethods.ByName("GetProposerStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	proposerServiceGetProposerLeaderboardHandler := connect.NewUnaryHandler(
+		ProposerServiceGetProposerLeaderboardProcedure,
+		svc.GetProposerLeaderboard,
+		connect.WithSchema(proposerServiceMethods.ByName("GetProposerLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.ProposerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProposerServiceGetProposerStatsProcedure:
+			proposerServiceGetProposerStatsHandler.ServeHTTP(w, r)
+		case ProposerServiceGetProposerLeaderboardProcedure:
+			proposerServiceGetProposerLeaderboardHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProposerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProposerServiceHandler struct{}
+
+func (UnimplementedProposerServiceHandler) GetProposerStats(context.Context, *connect.Request[v1.GetProposerStatsRequest]) (*connect.Response[v1.GetProposerStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ProposerService.GetProposerStats is not implemented"))
+}
+
+func (UnimplementedProposerServiceHandler) GetProposerLeaderboard(context.Context, *connect.Request[v1.GetProposerLeaderboardRequest]) (*connect.Response[v1.GetProposerLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ProposerService.GetProposerLeaderboard is not implemented"))
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/signature.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SignatureServiceName is the fully-qualified name of the SignatureService service.
+	SignatureServiceName = "api.v1.SignatureService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SignatureServiceGetBlockSignaturesProcedure is the fully-qualified name of the SignatureService's
+	// GetBlockSignatures RPC.
+	SignatureServiceGetBlockSignaturesProcedure = "/api.v1.SignatureService/GetBlockSignatures"
+	// SignatureServiceGetSizeTrendProcedure is the fully-qualified name of the SignatureService's
+	// GetSizeTrend RPC.
+	SignatureServiceGetSizeTrendProcedure = "/api.v1.SignatureService/GetSizeTrend"
+)
+
+// SignatureServiceClient is a client for the api.v1.SignatureService service.
+type SignatureServiceClient interface {
+	// Get the stored signatures of the blocks at a slot or of a block root
+	GetBlockSignatures(context.Context, *connect.Request[v1.GetBlockSignaturesRequest]) (*connect.Response[v1.GetBlockSignaturesResponse], error)
+	// Get signature and block size statistics per slot range and per fork
+	GetSizeTrend(context.Context, *connect.Request[v1.GetSizeTrendRequest]) (*connect.Response[v1.GetSizeTrendResponse], error)
+}
+
+// NewSignatureServiceClient constructs a client for the api.v1.SignatureService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSignatureServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SignatureServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	signatureServiceMethods := v1.File_proto_api_v1_signature_proto.Services().ByName("SignatureService").Methods()
+	return &signatureServiceClient{
+		getBlockSignatures: connect.NewClient[v1.GetBlockSignaturesRequest, v1.GetBlockSignaturesResponse](
+			httpClient,
+			baseURL+SignatureServiceGetBlockSignaturesProcedure,
+			connect.WithSchema(signatureServiceMethods.ByName("GetBlockSignatures")),
+			connect.WithClientOptions(opts...),
+		),
+		getSizeTrend: connect.NewClient[v1.GetSizeTrendRequest, v1.GetSizeTrendResponse](
+			httpClient,
+			baseURL+SignatureServiceGetSizeTrendProcedure,
+			connect.WithSchema(signatureServiceMethods.ByName("GetSizeTrend")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// signatureServiceClient implements SignatureServiceClient.
+type signatureServiceClient struct {
+	getBlockSignatures *connect.Client[v1.GetBlockSignaturesRequest, v1.GetBlockSignaturesResponse]
+	getSizeTrend       *connect.Client[v1.GetSizeTrendRequest, v1.GetSizeTrendResponse]
+}
+
+// GetBlockSignatures calls api.v1.SignatureService.GetBlockSignatures.
+func (c *signatureServiceClient) GetBlockSignatures(ctx context.Context, req *connect.Request[v1.GetBlockSignaturesRequest]) (*connect.Response[v1.GetBlockSignaturesResponse], error) {
+	return c.getBlockSignatures.CallUnary(ctx, req)
+}
+
+// GetSizeTrend calls api.v1.SignatureService.GetSizeTrend.
+func (c *signatureServiceClient) GetSizeTrend(ctx context.Context, req *connect.Request[v1.GetSizeTrendRequest]) (*connect.Response[v1.GetSizeTrendResponse], error) {
+	return c.getSizeTrend.CallUnary(ctx, req)
+}
+
+// SignatureServiceHandler is an implementation of the api.v1.SignatureService service.
+type SignatureServiceHandler interface {
+	// Get the stored signatures of the blocks at a slot or of a block root
+	GetBlockSignatures(context.Context, *connect.Request[v1.GetBlockSignaturesRequest]) (*connect.Response[v1.GetBlockSignaturesResponse], error)
+	// Get signature and block size statistics per slot range and per fork
+	GetSizeTrend(context.Context, *connect.Request[v1.GetSizeTrendRequest]) (*connect.Response[v1.GetSizeTrendResponse], error)
+}
+
+// NewSignatureServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSignatureServiceHandler(svc SignatureServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	signatureServiceMethods := v1.File_proto_api_v1_signature_proto.Services().ByName("SignatureService").Methods()
+	signatureServiceGetBlockSignaturesHandler := connect.NewUnaryHandler(
+		SignatureServiceGetBlockSignaturesProcedure,
+		svc.GetBlockSignatures,
+		connect.WithSchema(signatureServiceMethods.ByName("GetBlockSignatures")),
+		connect.WithHandlerOptions(opts...),
+	)
+	signatureServiceGetSizeTrendHandler := connect.NewUnaryHandler(
+		SignatureServiceGetSizeTrendProcedure,
+		svc.GetSizeTrend,
+		connect.WithSchema(signatureServiceMethods.ByName("GetSizeTrend")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.SignatureService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SignatureServiceGetBlockSignaturesProcedure:
+			signatureServiceGetBlockSignaturesHandler.ServeHTTP(w, r)
+		case SignatureServiceGetSizeTrendProcedure:
+			signatureServiceGetSizeTrendHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSignatureServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSignatureServiceHandler struct{}
+
+func (UnimplementedSignatureServiceHandler) GetBlockSignatures(context.Context, *connect.Request[v1.GetBlockSignaturesRequest]) (*connect.Response[v1.GetBlockSignaturesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.SignatureService.GetBlockSignatures is not implemented"))
+}
+
+func (UnimplementedSignatureServiceHandler) GetSizeTrend(context.Context, *connect.Request[v1.GetSizeTrendRequest]) (*connect.Response[v1.GetSizeTrendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.SignatureService.GetSizeTrend is not implemented"))
+}
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/api/v1/state.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StateServiceName is the fully-qualified name of the StateService service.
+	StateServiceName = "api.v1.StateService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StateServiceGetStateProcedure is the fully-qualified name of the StateService's GetState RPC.
+	StateServiceGetStateProcedure = "/api.v1.StateService/GetState"
+	// StateServiceGetStateSnapshotsProcedure is the fully-qualified name of the StateService's
+	// GetStateSnapshots RPC.
+	StateServiceGetStateSnapshotsProcedure = "/api.v1.StateService/GetStateSnapshots"
+)
+
+// StateServiceClient is a client for the api.v1.StateService service.
+type StateServiceClient interface {
+	// Get a state's checkpoints and justification bitfields, with its block hashes compared to the indexed chain
+	GetState(context.Context, *connect.Request[v1.GetStateRequest]) (*connect.Response[v1.GetStateResponse], error)
+	// List the stored state snapshots
+	GetStateSnapshots(context.Context, *connect.Request[v1.GetStateSnapshotsRequest]) (*connect.Response[v1.GetStateSnapshotsResponse], error)
+}
+
+// NewStateServiceClient constructs a client for the api.v1.StateService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StateServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	stateServiceMethods := v1.File_proto_api_v1_state_proto.Services().ByName("StateService").Methods()
+	return &stateServiceClient{
+		getState: connect.NewClient[v1.GetStateRequest, v1.GetStateResponse](
+			httpClient,
+			baseURL+StateServiceGetStateProcedure,
+			connect.WithSchema(stateServiceMethods.ByName("GetState")),
+			connect.WithClientOptions(opts...),
+		),
+		getStateSnapshots: connect.NewClient[v1.GetStateSnapshotsRequest, v1.GetStateSnapshotsResponse](
+			httpClient,
+			baseURL+StateServiceGetStateSnapshotsProcedure,
+			connect.WithSchema(stateServiceMethods.ByName("GetStateSnapshots")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// stateServiceClient implements StateServiceClient.
+type stateServiceClient struct {
+	getState          *connect.Client[v1.GetStateRequest, v1.GetStateResponse]
+	getStateSnapshots *connect.Client[v1.GetStateSnapshotsRequest, v1.GetStateSnapshotsResponse]
+}
+
+// GetState calls api.v1.StateService.GetState.
+func (c *stateServiceClient) GetState(ctx context.Context, req *connect.Request[v1.GetStateRequest]) (*connect.Response[v1.GetStateResponse], error) {
+	return c.getState.CallUnary(ctx, req)
+}
+
+// GetStateSnapshots calls api.v1.StateService.GetStateSnapshots.
+func (c *stateServiceClient) GetStateSnapshots(ctx context.Context, req *connect.Request[v1.GetStateSnapshotsRequest]) (*connect.Response[v1.GetStateSnapshotsResponse], error) {
+	return c.getStateSnapshots.CallUnary(ctx, req)
+}
+
+// StateServiceHandler is an implementation of the api.v1.StateService service.
+type StateServiceHandler interface {
+	// Get a state's checkpoints and justification bitfields, with its block hashes compared to the indexed chain
+	GetState(context.Context, *connect.Request[v1.GetStateRequest]) (*connect.Response[v1.GetStateResponse], error)
+	// List the stored state snapshots
+	GetStateSnapshots(context.Context, *connect.Request[v1.GetStateSnapshotsRequest]) (*connect.Response[v1.GetStateSnapshotsResponse], error)
+}
+
+// NewStateServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStateServiceHandler(svc StateServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	stateServiceMethods := v1.File_proto_api_v1_state_proto.Services().ByName("StateService").Methods()
+	stateServiceGetStateHandler := connect.NewUnaryHandler(
+		StateServiceGetStateProcedure,
+		svc.GetState,
+		connect.WithSchema(stateServiceMethods.ByName("GetState")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stateServiceGetStateSnapshotsHandler := connect.NewUnaryHandler(
+		StateServiceGetStateSnapshotsProcedure,
+		svc.GetStateSnapshots,
+		connect.WithSchema(stateServiceMethods.ByName("GetStateSnapshots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.StateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StateServiceGetStateProcedure:
+			stateServiceGetStateHandler.ServeHTTP(w, r)
+		case StateServiceGetStateSnapshotsProcedure:
+			stateServiceGetStateSnapshotsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStateServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStateServiceHandler struct{}
+
+func (UnimplementedStateServiceHandler) GetState(context.Context, *connect.Request[v1.GetStateRequest]) (*connect.Response[v1.GetStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.StateService.GetState is not implemented"))
+}
+
+func (UnimplementedStateServiceHandler) GetStateSnapshots(context.Context, *connect.Request[v1.GetStateSnapshotsRequest]) (*connect.Response[v1.GetStateSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.StateService.GetStateSnapshots is not implemented"))
+}
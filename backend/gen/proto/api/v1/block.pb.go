@@ -182,11 +182,13 @@ func (*GetLatestBlockHeaderRequest) Descriptor() ([]byte, []int) {
 }
 
 type GetLatestBlockHeaderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlockHeader   *BlockHeader           `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
-	BlockRoot     string                 `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BlockHeader    *BlockHeader           `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	BlockRoot      string                 `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ProposerClient string                 `protobuf:"bytes,3,opt,name=proposer_client,json=proposerClient,proto3" json:"proposer_client,omitempty"` // Client running the proposer (empty if unknown)
+	SourceClient   string                 `protobuf:"bytes,4,opt,name=source_client,json=sourceClient,proto3" json:"source_client,omitempty"`       // Client the header was fetched from (empty if unknown)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetLatestBlockHeaderResponse) Reset() {
@@ -233,6 +235,20 @@ func (x *GetLatestBlockHeaderResponse) GetBlockRoot() string {
 	return ""
 }
 
+func (x *GetLatestBlockHeaderResponse) GetProposerClient() string {
+	if x != nil {
+		return x.ProposerClient
+	}
+	return ""
+}
+
+func (x *GetLatestBlockHeaderResponse) GetSourceClient() string {
+	if x != nil {
+		return x.SourceClient
+	}
+	return ""
+}
+
 // Request for paginated block headers
 type GetBlockHeadersRequest struct {
 	state         protoimpl.MessageState           `protogen:"open.v1"`
@@ -365,11 +381,13 @@ func (x *GetBlockHeadersResponse) GetNextOffset() uint64 {
 
 // Block header with computed root
 type BlockHeaderWithRoot struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        *BlockHeader           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	BlockRoot     string                 `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"` // Hex encoded with 0x prefix
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Header         *BlockHeader           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BlockRoot      string                 `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`                // Hex encoded with 0x prefix
+	ProposerClient string                 `protobuf:"bytes,3,opt,name=proposer_client,json=proposerClient,proto3" json:"proposer_client,omitempty"` // Client running the proposer (empty if unknown)
+	SourceClient   string                 `protobuf:"bytes,4,opt,name=source_client,json=sourceClient,proto3" json:"source_client,omitempty"`       // Client the header was fetched from (empty if unknown)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BlockHeaderWithRoot) Reset() {
@@ -416,6 +434,20 @@ func (x *BlockHeaderWithRoot) GetBlockRoot() string {
 	return ""
 }
 
+func (x *BlockHeaderWithRoot) GetProposerClient() string {
+	if x != nil {
+		return x.ProposerClient
+	}
+	return ""
+}
+
+func (x *BlockHeaderWithRoot) GetSourceClient() string {
+	if x != nil {
+		return x.SourceClient
+	}
+	return ""
+}
+
 var File_proto_api_v1_block_proto protoreflect.FileDescriptor
 
 const file_proto_api_v1_block_proto_rawDesc = "" +
@@ -429,11 +461,13 @@ const file_proto_api_v1_block_proto_rawDesc = "" +
 	"\n" +
 	"state_root\x18\x04 \x01(\tR\tstateRoot\x12\x1b\n" +
 	"\tbody_root\x18\x05 \x01(\tR\bbodyRoot\"\x1d\n" +
-	"\x1bGetLatestBlockHeaderRequest\"u\n" +
+	"\x1bGetLatestBlockHeaderRequest\"\xc3\x01\n" +
 	"\x1cGetLatestBlockHeaderResponse\x126\n" +
 	"\fblock_header\x18\x01 \x01(\v2\x13.api.v1.BlockHeaderR\vblockHeader\x12\x1d\n" +
 	"\n" +
-	"block_root\x18\x02 \x01(\tR\tblockRoot\"\xb9\x01\n" +
+	"block_root\x18\x02 \x01(\tR\tblockRoot\x12'\n" +
+	"\x0fproposer_client\x18\x03 \x01(\tR\x0eproposerClient\x12#\n" +
+	"\rsource_client\x18\x04 \x01(\tR\fsourceClient\"\xb9\x01\n" +
 	"\x16GetBlockHeadersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\rR\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x04R\x06offset\x12G\n" +
@@ -448,11 +482,13 @@ const file_proto_api_v1_block_proto_rawDesc = "" +
 	"totalCount\x12\x19\n" +
 	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
 	"\vnext_offset\x18\x04 \x01(\x04R\n" +
-	"nextOffset\"a\n" +
+	"nextOffset\"\xaf\x01\n" +
 	"\x13BlockHeaderWithRoot\x12+\n" +
 	"\x06header\x18\x01 \x01(\v2\x13.api.v1.BlockHeaderR\x06header\x12\x1d\n" +
 	"\n" +
-	"block_root\x18\x02 \x01(\tR\tblockRoot2\xc5\x01\n" +
+	"block_root\x18\x02 \x01(\tR\tblockRoot\x12'\n" +
+	"\x0fproposer_client\x18\x03 \x01(\tR\x0eproposerClient\x12#\n" +
+	"\rsource_client\x18\x04 \x01(\tR\fsourceClient2\xc5\x01\n" +
 	"\fBlockService\x12a\n" +
 	"\x14GetLatestBlockHeader\x12#.api.v1.GetLatestBlockHeaderRequest\x1a$.api.v1.GetLatestBlockHeaderResponse\x12R\n" +
 	"\x0fGetBlockHeaders\x12\x1e.api.v1.GetBlockHeadersRequest\x1a\x1f.api.v1.GetBlockHeadersResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
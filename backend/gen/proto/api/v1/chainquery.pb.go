@@ -0,0 +1,560 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/chainquery.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// IsAncestorRequest - roots are hex encoded with 0x prefix
+type IsAncestorRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AncestorRoot   string                 `protobuf:"bytes,1,opt,name=ancestor_root,json=ancestorRoot,proto3" json:"ancestor_root,omitempty"`
+	DescendantRoot string                 `protobuf:"bytes,2,opt,name=descendant_root,json=descendantRoot,proto3" json:"descendant_root,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IsAncestorRequest) Reset() {
+	*x = IsAncestorRequest{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsAncestorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAncestorRequest) ProtoMessage() {}
+
+func (x *IsAncestorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAncestorRequest.ProtoReflect.Descriptor instead.
+func (*IsAncestorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IsAncestorRequest) GetAncestorRoot() string {
+	if x != nil {
+		return x.AncestorRoot
+	}
+	return ""
+}
+
+func (x *IsAncestorRequest) GetDescendantRoot() string {
+	if x != nil {
+		return x.DescendantRoot
+	}
+	return ""
+}
+
+type IsAncestorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsAncestor    bool                   `protobuf:"varint,1,opt,name=is_ancestor,json=isAncestor,proto3" json:"is_ancestor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsAncestorResponse) Reset() {
+	*x = IsAncestorResponse{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsAncestorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAncestorResponse) ProtoMessage() {}
+
+func (x *IsAncestorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAncestorResponse.ProtoReflect.Descriptor instead.
+func (*IsAncestorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IsAncestorResponse) GetIsAncestor() bool {
+	if x != nil {
+		return x.IsAncestor
+	}
+	return false
+}
+
+// GetCommonAncestorRequest - roots are hex encoded with 0x prefix, e.g. the heads of two clients
+type GetCommonAncestorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootA         string                 `protobuf:"bytes,1,opt,name=root_a,json=rootA,proto3" json:"root_a,omitempty"`
+	RootB         string                 `protobuf:"bytes,2,opt,name=root_b,json=rootB,proto3" json:"root_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommonAncestorRequest) Reset() {
+	*x = GetCommonAncestorRequest{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonAncestorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonAncestorRequest) ProtoMessage() {}
+
+func (x *GetCommonAncestorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonAncestorRequest.ProtoReflect.Descriptor instead.
+func (*GetCommonAncestorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCommonAncestorRequest) GetRootA() string {
+	if x != nil {
+		return x.RootA
+	}
+	return ""
+}
+
+func (x *GetCommonAncestorRequest) GetRootB() string {
+	if x != nil {
+		return x.RootB
+	}
+	return ""
+}
+
+type GetCommonAncestorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *BlockHeaderWithRoot   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	DistanceA     uint64                 `protobuf:"varint,2,opt,name=distance_a,json=distanceA,proto3" json:"distance_a,omitempty"` // Slots from the common ancestor to block A
+	DistanceB     uint64                 `protobuf:"varint,3,opt,name=distance_b,json=distanceB,proto3" json:"distance_b,omitempty"` // Slots from the common ancestor to block B
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommonAncestorResponse) Reset() {
+	*x = GetCommonAncestorResponse{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonAncestorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonAncestorResponse) ProtoMessage() {}
+
+func (x *GetCommonAncestorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonAncestorResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonAncestorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCommonAncestorResponse) GetBlock() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *GetCommonAncestorResponse) GetDistanceA() uint64 {
+	if x != nil {
+		return x.DistanceA
+	}
+	return 0
+}
+
+func (x *GetCommonAncestorResponse) GetDistanceB() uint64 {
+	if x != nil {
+		return x.DistanceB
+	}
+	return 0
+}
+
+// GetAncestorAtSlotRequest - head_root is hex encoded with 0x prefix
+type GetAncestorAtSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HeadRoot      string                 `protobuf:"bytes,1,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"`
+	Slot          uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAncestorAtSlotRequest) Reset() {
+	*x = GetAncestorAtSlotRequest{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAncestorAtSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAncestorAtSlotRequest) ProtoMessage() {}
+
+func (x *GetAncestorAtSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAncestorAtSlotRequest.ProtoReflect.Descriptor instead.
+func (*GetAncestorAtSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAncestorAtSlotRequest) GetHeadRoot() string {
+	if x != nil {
+		return x.HeadRoot
+	}
+	return ""
+}
+
+func (x *GetAncestorAtSlotRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type GetAncestorAtSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *BlockHeaderWithRoot   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`                                   // Latest block at or before the slot
+	IsEmptySlot   bool                   `protobuf:"varint,2,opt,name=is_empty_slot,json=isEmptySlot,proto3" json:"is_empty_slot,omitempty"` // No block at the slot on this chain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAncestorAtSlotResponse) Reset() {
+	*x = GetAncestorAtSlotResponse{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAncestorAtSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAncestorAtSlotResponse) ProtoMessage() {}
+
+func (x *GetAncestorAtSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAncestorAtSlotResponse.ProtoReflect.Descriptor instead.
+func (*GetAncestorAtSlotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAncestorAtSlotResponse) GetBlock() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *GetAncestorAtSlotResponse) GetIsEmptySlot() bool {
+	if x != nil {
+		return x.IsEmptySlot
+	}
+	return false
+}
+
+// GetBranchRequest - root is hex encoded with 0x prefix
+type GetBranchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBranchRequest) Reset() {
+	*x = GetBranchRequest{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBranchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBranchRequest) ProtoMessage() {}
+
+func (x *GetBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBranchRequest.ProtoReflect.Descriptor instead.
+func (*GetBranchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBranchRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+type GetBranchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BlockHeaderWithRoot   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`                                   // Last block on the indexed chain (unset if the ancestry is unknown)
+	Blocks        []*BlockHeaderWithRoot `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`                               // Blocks after the base up to the tip, ordered by slot
+	Tip           *BlockHeaderWithRoot   `protobuf:"bytes,3,opt,name=tip,proto3" json:"tip,omitempty"`                                     // Highest block of the branch
+	IsCanonical   bool                   `protobuf:"varint,4,opt,name=is_canonical,json=isCanonical,proto3" json:"is_canonical,omitempty"` // The block is on the indexed chain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBranchResponse) Reset() {
+	*x = GetBranchResponse{}
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBranchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBranchResponse) ProtoMessage() {}
+
+func (x *GetBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_chainquery_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBranchResponse.ProtoReflect.Descriptor instead.
+func (*GetBranchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_chainquery_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBranchResponse) GetBase() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetBranchResponse) GetBlocks() []*BlockHeaderWithRoot {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *GetBranchResponse) GetTip() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.Tip
+	}
+	return nil
+}
+
+func (x *GetBranchResponse) GetIsCanonical() bool {
+	if x != nil {
+		return x.IsCanonical
+	}
+	return false
+}
+
+var File_proto_api_v1_chainquery_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_chainquery_proto_rawDesc = "" +
+	"\n" +
+	"\x1dproto/api/v1/chainquery.proto\x12\x06api.v1\x1a\x18proto/api/v1/block.proto\"a\n" +
+	"\x11IsAncestorRequest\x12#\n" +
+	"\rancestor_root\x18\x01 \x01(\tR\fancestorRoot\x12'\n" +
+	"\x0fdescendant_root\x18\x02 \x01(\tR\x0edescendantRoot\"5\n" +
+	"\x12IsAncestorResponse\x12\x1f\n" +
+	"\vis_ancestor\x18\x01 \x01(\bR\n" +
+	"isAncestor\"H\n" +
+	"\x18GetCommonAncestorRequest\x12\x15\n" +
+	"\x06root_a\x18\x01 \x01(\tR\x05rootA\x12\x15\n" +
+	"\x06root_b\x18\x02 \x01(\tR\x05rootB\"\x8c\x01\n" +
+	"\x19GetCommonAncestorResponse\x121\n" +
+	"\x05block\x18\x01 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x05block\x12\x1d\n" +
+	"\n" +
+	"distance_a\x18\x02 \x01(\x04R\tdistanceA\x12\x1d\n" +
+	"\n" +
+	"distance_b\x18\x03 \x01(\x04R\tdistanceB\"K\n" +
+	"\x18GetAncestorAtSlotRequest\x12\x1b\n" +
+	"\thead_root\x18\x01 \x01(\tR\bheadRoot\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x04R\x04slot\"r\n" +
+	"\x19GetAncestorAtSlotResponse\x121\n" +
+	"\x05block\x18\x01 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x05block\x12\"\n" +
+	"\ris_empty_slot\x18\x02 \x01(\bR\visEmptySlot\"&\n" +
+	"\x10GetBranchRequest\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\"\xcb\x01\n" +
+	"\x11GetBranchResponse\x12/\n" +
+	"\x04base\x18\x01 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x04base\x123\n" +
+	"\x06blocks\x18\x02 \x03(\v2\x1b.api.v1.BlockHeaderWithRootR\x06blocks\x12-\n" +
+	"\x03tip\x18\x03 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x03tip\x12!\n" +
+	"\fis_canonical\x18\x04 \x01(\bR\visCanonical2\xce\x02\n" +
+	"\x11ChainQueryService\x12C\n" +
+	"\n" +
+	"IsAncestor\x12\x19.api.v1.IsAncestorRequest\x1a\x1a.api.v1.IsAncestorResponse\x12X\n" +
+	"\x11GetCommonAncestor\x12 .api.v1.GetCommonAncestorRequest\x1a!.api.v1.GetCommonAncestorResponse\x12X\n" +
+	"\x11GetAncestorAtSlot\x12 .api.v1.GetAncestorAtSlotRequest\x1a!.api.v1.GetAncestorAtSlotResponse\x12@\n" +
+	"\tGetBranch\x12\x18.api.v1.GetBranchRequest\x1a\x19.api.v1.GetBranchResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_chainquery_proto_rawDescOnce sync.Once
+	file_proto_api_v1_chainquery_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_chainquery_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_chainquery_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_chainquery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_chainquery_proto_rawDesc), len(file_proto_api_v1_chainquery_proto_rawDesc)))
+	})
+	return file_proto_api_v1_chainquery_proto_rawDescData
+}
+
+var file_proto_api_v1_chainquery_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_api_v1_chainquery_proto_goTypes = []any{
+	(*IsAncestorRequest)(nil),         // 0: api.v1.IsAncestorRequest
+	(*IsAncestorResponse)(nil),        // 1: api.v1.IsAncestorResponse
+	(*GetCommonAncestorRequest)(nil),  // 2: api.v1.GetCommonAncestorRequest
+	(*GetCommonAncestorResponse)(nil), // 3: api.v1.GetCommonAncestorResponse
+	(*GetAncestorAtSlotRequest)(nil),  // 4: api.v1.GetAncestorAtSlotRequest
+	(*GetAncestorAtSlotResponse)(nil), // 5: api.v1.GetAncestorAtSlotResponse
+	(*GetBranchRequest)(nil),          // 6: api.v1.GetBranchRequest
+	(*GetBranchResponse)(nil),         // 7: api.v1.GetBranchResponse
+	(*BlockHeaderWithRoot)(nil),       // 8: api.v1.BlockHeaderWithRoot
+}
+var file_proto_api_v1_chainquery_proto_depIdxs = []int32{
+	8, // 0: api.v1.GetCommonAncestorResponse.block:type_name -> api.v1.BlockHeaderWithRoot
+	8, // 1: api.v1.GetAncestorAtSlotResponse.block:type_name -> api.v1.BlockHeaderWithRoot
+	8, // 2: api.v1.GetBranchResponse.base:type_name -> api.v1.BlockHeaderWithRoot
+	8, // 3: api.v1.GetBranchResponse.blocks:type_name -> api.v1.BlockHeaderWithRoot
+	8, // 4: api.v1.GetBranchResponse.tip:type_name -> api.v1.BlockHeaderWithRoot
+	0, // 5: api.v1.ChainQueryService.IsAncestor:input_type -> api.v1.IsAncestorRequest
+	2, // 6: api.v1.ChainQueryService.GetCommonAncestor:input_type -> api.v1.GetCommonAncestorRequest
+	4, // 7: api.v1.ChainQueryService.GetAncestorAtSlot:input_type -> api.v1.GetAncestorAtSlotRequest
+	6, // 8: api.v1.ChainQueryService.GetBranch:input_type -> api.v1.GetBranchRequest
+	1, // 9: api.v1.ChainQueryService.IsAncestor:output_type -> api.v1.IsAncestorResponse
+	3, // 10: api.v1.ChainQueryService.GetCommonAncestor:output_type -> api.v1.GetCommonAncestorResponse
+	5, // 11: api.v1.ChainQueryService.GetAncestorAtSlot:output_type -> api.v1.GetAncestorAtSlotResponse
+	7, // 12: api.v1.ChainQueryService.GetBranch:output_type -> api.v1.GetBranchResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_chainquery_proto_init() }
+func file_proto_api_v1_chainquery_proto_init() {
+	if File_proto_api_v1_chainquery_proto != nil {
+		return
+	}
+	file_proto_api_v1_block_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_chainquery_proto_rawDesc), len(file_proto_api_v1_chainquery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_chainquery_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_chainquery_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_chainquery_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_chainquery_proto = out.File
+	file_proto_api_v1_chainquery_proto_goTypes = nil
+	file_proto_api_v1_chainquery_proto_depIdxs = nil
+}
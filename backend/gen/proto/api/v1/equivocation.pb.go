@@ -0,0 +1,483 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/equivocation.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Vote is a validator's vote for a head, and a target justified from a source
+type Vote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorId   uint64                 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	Slot          uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	HeadRoot      string                 `protobuf:"bytes,3,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"` // Hex encoded with 0x prefix
+	HeadSlot      uint64                 `protobuf:"varint,4,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	TargetRoot    string                 `protobuf:"bytes,5,opt,name=target_root,json=targetRoot,proto3" json:"target_root,omitempty"` // Hex encoded with 0x prefix
+	TargetSlot    uint64                 `protobuf:"varint,6,opt,name=target_slot,json=targetSlot,proto3" json:"target_slot,omitempty"`
+	SourceRoot    string                 `protobuf:"bytes,7,opt,name=source_root,json=sourceRoot,proto3" json:"source_root,omitempty"` // Hex encoded with 0x prefix
+	SourceSlot    uint64                 `protobuf:"varint,8,opt,name=source_slot,json=sourceSlot,proto3" json:"source_slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_equivocation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vote) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+func (x *Vote) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Vote) GetHeadRoot() string {
+	if x != nil {
+		return x.HeadRoot
+	}
+	return ""
+}
+
+func (x *Vote) GetHeadSlot() uint64 {
+	if x != nil {
+		return x.HeadSlot
+	}
+	return 0
+}
+
+func (x *Vote) GetTargetRoot() string {
+	if x != nil {
+		return x.TargetRoot
+	}
+	return ""
+}
+
+func (x *Vote) GetTargetSlot() uint64 {
+	if x != nil {
+		return x.TargetSlot
+	}
+	return 0
+}
+
+func (x *Vote) GetSourceRoot() string {
+	if x != nil {
+		return x.SourceRoot
+	}
+	return ""
+}
+
+func (x *Vote) GetSourceSlot() uint64 {
+	if x != nil {
+		return x.SourceSlot
+	}
+	return 0
+}
+
+// EquivocationEvidence is one of the two conflicting messages
+type EquivocationEvidence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`     // Hash tree root of the header or vote, hex encoded with 0x prefix
+	Client        string                 `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"` // Client the message was observed from
+	Block         *BlockHeaderWithRoot   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`   // Set for double proposals
+	Vote          *Vote                  `protobuf:"bytes,4,opt,name=vote,proto3" json:"vote,omitempty"`     // Set for double and surround votes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquivocationEvidence) Reset() {
+	*x = EquivocationEvidence{}
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquivocationEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquivocationEvidence) ProtoMessage() {}
+
+func (x *EquivocationEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquivocationEvidence.ProtoReflect.Descriptor instead.
+func (*EquivocationEvidence) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_equivocation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EquivocationEvidence) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *EquivocationEvidence) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *EquivocationEvidence) GetBlock() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *EquivocationEvidence) GetVote() *Vote {
+	if x != nil {
+		return x.Vote
+	}
+	return nil
+}
+
+// Equivocation is a validator signing two conflicting messages
+type Equivocation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                                              // double_proposal, double_vote or surround_vote
+	ValidatorId     uint64                 `protobuf:"varint,2,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`            // Proposer index or voting validator
+	ValidatorClient string                 `protobuf:"bytes,3,opt,name=validator_client,json=validatorClient,proto3" json:"validator_client,omitempty"` // Client running the validator (empty if unknown)
+	Slot            uint64                 `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`                                             // Slot of message a
+	A               *EquivocationEvidence  `protobuf:"bytes,5,opt,name=a,proto3" json:"a,omitempty"`                                                    // Observed first, the surrounding vote for surround votes
+	B               *EquivocationEvidence  `protobuf:"bytes,6,opt,name=b,proto3" json:"b,omitempty"`
+	DetectedAt      int64                  `protobuf:"varint,7,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"` // Unix timestamp in milliseconds
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Equivocation) Reset() {
+	*x = Equivocation{}
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Equivocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Equivocation) ProtoMessage() {}
+
+func (x *Equivocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Equivocation.ProtoReflect.Descriptor instead.
+func (*Equivocation) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_equivocation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Equivocation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Equivocation) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+func (x *Equivocation) GetValidatorClient() string {
+	if x != nil {
+		return x.ValidatorClient
+	}
+	return ""
+}
+
+func (x *Equivocation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Equivocation) GetA() *EquivocationEvidence {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *Equivocation) GetB() *EquivocationEvidence {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *Equivocation) GetDetectedAt() int64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
+// GetEquivocationsRequest - newest slot first
+type GetEquivocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`    // Empty for every kind
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Max equivocations to return (default: 50, max: 500)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquivocationsRequest) Reset() {
+	*x = GetEquivocationsRequest{}
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquivocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquivocationsRequest) ProtoMessage() {}
+
+func (x *GetEquivocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquivocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetEquivocationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_equivocation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetEquivocationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetEquivocationsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetEquivocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Equivocations []*Equivocation        `protobuf:"bytes,1,rep,name=equivocations,proto3" json:"equivocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquivocationsResponse) Reset() {
+	*x = GetEquivocationsResponse{}
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquivocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquivocationsResponse) ProtoMessage() {}
+
+func (x *GetEquivocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_equivocation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquivocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetEquivocationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_equivocation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetEquivocationsResponse) GetEquivocations() []*Equivocation {
+	if x != nil {
+		return x.Equivocations
+	}
+	return nil
+}
+
+var File_proto_api_v1_equivocation_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_equivocation_proto_rawDesc = "" +
+	"\n" +
+	"\x1fproto/api/v1/equivocation.proto\x12\x06api.v1\x1a\x18proto/api/v1/block.proto\"\xfb\x01\n" +
+	"\x04Vote\x12!\n" +
+	"\fvalidator_id\x18\x01 \x01(\x04R\vvalidatorId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x04R\x04slot\x12\x1b\n" +
+	"\thead_root\x18\x03 \x01(\tR\bheadRoot\x12\x1b\n" +
+	"\thead_slot\x18\x04 \x01(\x04R\bheadSlot\x12\x1f\n" +
+	"\vtarget_root\x18\x05 \x01(\tR\n" +
+	"targetRoot\x12\x1f\n" +
+	"\vtarget_slot\x18\x06 \x01(\x04R\n" +
+	"targetSlot\x12\x1f\n" +
+	"\vsource_root\x18\a \x01(\tR\n" +
+	"sourceRoot\x12\x1f\n" +
+	"\vsource_slot\x18\b \x01(\x04R\n" +
+	"sourceSlot\"\x97\x01\n" +
+	"\x14EquivocationEvidence\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x16\n" +
+	"\x06client\x18\x02 \x01(\tR\x06client\x121\n" +
+	"\x05block\x18\x03 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x05block\x12 \n" +
+	"\x04vote\x18\x04 \x01(\v2\f.api.v1.VoteR\x04vote\"\xfd\x01\n" +
+	"\fEquivocation\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12!\n" +
+	"\fvalidator_id\x18\x02 \x01(\x04R\vvalidatorId\x12)\n" +
+	"\x10validator_client\x18\x03 \x01(\tR\x0fvalidatorClient\x12\x12\n" +
+	"\x04slot\x18\x04 \x01(\x04R\x04slot\x12*\n" +
+	"\x01a\x18\x05 \x01(\v2\x1c.api.v1.EquivocationEvidenceR\x01a\x12*\n" +
+	"\x01b\x18\x06 \x01(\v2\x1c.api.v1.EquivocationEvidenceR\x01b\x12\x1f\n" +
+	"\vdetected_at\x18\a \x01(\x03R\n" +
+	"detectedAt\"C\n" +
+	"\x17GetEquivocationsRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"V\n" +
+	"\x18GetEquivocationsResponse\x12:\n" +
+	"\requivocations\x18\x01 \x03(\v2\x14.api.v1.EquivocationR\requivocations2l\n" +
+	"\x13EquivocationService\x12U\n" +
+	"\x10GetEquivocations\x12\x1f.api.v1.GetEquivocationsRequest\x1a .api.v1.GetEquivocationsResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_equivocation_proto_rawDescOnce sync.Once
+	file_proto_api_v1_equivocation_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_equivocation_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_equivocation_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_equivocation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_equivocation_proto_rawDesc), len(file_proto_api_v1_equivocation_proto_rawDesc)))
+	})
+	return file_proto_api_v1_equivocation_proto_rawDescData
+}
+
+var file_proto_api_v1_equivocation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_api_v1_equivocation_proto_goTypes = []any{
+	(*Vote)(nil),                     // 0: api.v1.Vote
+	(*EquivocationEvidence)(nil),     // 1: api.v1.EquivocationEvidence
+	(*Equivocation)(nil),             // 2: api.v1.Equivocation
+	(*GetEquivocationsRequest)(nil),  // 3: api.v1.GetEquivocationsRequest
+	(*GetEquivocationsResponse)(nil), // 4: api.v1.GetEquivocationsResponse
+	(*BlockHeaderWithRoot)(nil),      // 5: api.v1.BlockHeaderWithRoot
+}
+var file_proto_api_v1_equivocation_proto_depIdxs = []int32{
+	5, // 0: api.v1.EquivocationEvidence.block:type_name -> api.v1.BlockHeaderWithRoot
+	0, // 1: api.v1.EquivocationEvidence.vote:type_name -> api.v1.Vote
+	1, // 2: api.v1.Equivocation.a:type_name -> api.v1.EquivocationEvidence
+	1, // 3: api.v1.Equivocation.b:type_name -> api.v1.EquivocationEvidence
+	2, // 4: api.v1.GetEquivocationsResponse.equivocations:type_name -> api.v1.Equivocation
+	3, // 5: api.v1.EquivocationService.GetEquivocations:input_type -> api.v1.GetEquivocationsRequest
+	4, // 6: api.v1.EquivocationService.GetEquivocations:output_type -> api.v1.GetEquivocationsResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_equivocation_proto_init() }
+func file_proto_api_v1_equivocation_proto_init() {
+	if File_proto_api_v1_equivocation_proto != nil {
+		return
+	}
+	file_proto_api_v1_block_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_equivocation_proto_rawDesc), len(file_proto_api_v1_equivocation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_equivocation_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_equivocation_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_equivocation_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_equivocation_proto = out.File
+	file_proto_api_v1_equivocation_proto_goTypes = nil
+	file_proto_api_v1_equivocation_proto_depIdxs = nil
+}
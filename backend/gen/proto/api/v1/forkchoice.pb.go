@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/forkchoice.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ForkChoiceNode is a block of the tree, parent_root links it to its parent node
+type ForkChoiceNode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Root           string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`                               // Hex encoded with 0x prefix
+	ParentRoot     string                 `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"` // Hex encoded with 0x prefix, may not be in the tree
+	Slot           uint64                 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex  uint64                 `protobuf:"varint,4,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	ProposerClient string                 `protobuf:"bytes,5,opt,name=proposer_client,json=proposerClient,proto3" json:"proposer_client,omitempty"` // Client running the proposer (empty if unknown)
+	Weight         uint64                 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`                                      // Latest votes for this block or one of its descendants
+	IsHead         bool                   `protobuf:"varint,7,opt,name=is_head,json=isHead,proto3" json:"is_head,omitempty"`                        // Head selected by LMD-GHOST
+	IsIndexed      bool                   `protobuf:"varint,8,opt,name=is_indexed,json=isIndexed,proto3" json:"is_indexed,omitempty"`               // On the chain stored by the indexer
+	IsJustified    bool                   `protobuf:"varint,9,opt,name=is_justified,json=isJustified,proto3" json:"is_justified,omitempty"`         // Latest justified checkpoint
+	IsFinalized    bool                   `protobuf:"varint,10,opt,name=is_finalized,json=isFinalized,proto3" json:"is_finalized,omitempty"`        // Latest finalized checkpoint
+	ClientHeads    []string               `protobuf:"bytes,11,rep,name=client_heads,json=clientHeads,proto3" json:"client_heads,omitempty"`         // Clients reporting this block as their head
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ForkChoiceNode) Reset() {
+	*x = ForkChoiceNode{}
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkChoiceNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkChoiceNode) ProtoMessage() {}
+
+func (x *ForkChoiceNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkChoiceNode.ProtoReflect.Descriptor instead.
+func (*ForkChoiceNode) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_forkchoice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForkChoiceNode) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *ForkChoiceNode) GetParentRoot() string {
+	if x != nil {
+		return x.ParentRoot
+	}
+	return ""
+}
+
+func (x *ForkChoiceNode) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ForkChoiceNode) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *ForkChoiceNode) GetProposerClient() string {
+	if x != nil {
+		return x.ProposerClient
+	}
+	return ""
+}
+
+func (x *ForkChoiceNode) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ForkChoiceNode) GetIsHead() bool {
+	if x != nil {
+		return x.IsHead
+	}
+	return false
+}
+
+func (x *ForkChoiceNode) GetIsIndexed() bool {
+	if x != nil {
+		return x.IsIndexed
+	}
+	return false
+}
+
+func (x *ForkChoiceNode) GetIsJustified() bool {
+	if x != nil {
+		return x.IsJustified
+	}
+	return false
+}
+
+func (x *ForkChoiceNode) GetIsFinalized() bool {
+	if x != nil {
+		return x.IsFinalized
+	}
+	return false
+}
+
+func (x *ForkChoiceNode) GetClientHeads() []string {
+	if x != nil {
+		return x.ClientHeads
+	}
+	return nil
+}
+
+// ForkChoiceClientHead is the head a client last reported
+type ForkChoiceClientHead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Root          string                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"` // Hex encoded with 0x prefix
+	Slot          uint64                 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	InTree        bool                   `protobuf:"varint,4,opt,name=in_tree,json=inTree,proto3" json:"in_tree,omitempty"`                // False if the block is not in the tree, e.g. never indexed
+	MatchesHead   bool                   `protobuf:"varint,5,opt,name=matches_head,json=matchesHead,proto3" json:"matches_head,omitempty"` // Same block as the LMD-GHOST head
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForkChoiceClientHead) Reset() {
+	*x = ForkChoiceClientHead{}
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkChoiceClientHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkChoiceClientHead) ProtoMessage() {}
+
+func (x *ForkChoiceClientHead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkChoiceClientHead.ProtoReflect.Descriptor instead.
+func (*ForkChoiceClientHead) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_forkchoice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForkChoiceClientHead) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *ForkChoiceClientHead) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *ForkChoiceClientHead) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ForkChoiceClientHead) GetInTree() bool {
+	if x != nil {
+		return x.InTree
+	}
+	return false
+}
+
+func (x *ForkChoiceClientHead) GetMatchesHead() bool {
+	if x != nil {
+		return x.MatchesHead
+	}
+	return false
+}
+
+// GetForkChoiceTreeRequest - fetch the whole tree
+type GetForkChoiceTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForkChoiceTreeRequest) Reset() {
+	*x = GetForkChoiceTreeRequest{}
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForkChoiceTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForkChoiceTreeRequest) ProtoMessage() {}
+
+func (x *GetForkChoiceTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForkChoiceTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetForkChoiceTreeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_forkchoice_proto_rawDescGZIP(), []int{2}
+}
+
+type GetForkChoiceTreeResponse struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Nodes           []*ForkChoiceNode       `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`                       // Ordered by slot, parents before their children
+	HeadRoot        string                  `protobuf:"bytes,2,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"` // LMD-GHOST head (empty if the tree is empty)
+	HeadSlot        uint64                  `protobuf:"varint,3,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	IndexedHeadRoot string                  `protobuf:"bytes,4,opt,name=indexed_head_root,json=indexedHeadRoot,proto3" json:"indexed_head_root,omitempty"` // Head of the chain stored by the indexer
+	IndexedHeadSlot uint64                  `protobuf:"varint,5,opt,name=indexed_head_slot,json=indexedHeadSlot,proto3" json:"indexed_head_slot,omitempty"`
+	StartRoot       string                  `protobuf:"bytes,6,opt,name=start_root,json=startRoot,proto3" json:"start_root,omitempty"` // Block LMD-GHOST started from: justified or the tree's root
+	StartSlot       uint64                  `protobuf:"varint,7,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	LatestVotes     uint32                  `protobuf:"varint,8,opt,name=latest_votes,json=latestVotes,proto3" json:"latest_votes,omitempty"` // Validators with a vote counted
+	ClientHeads     []*ForkChoiceClientHead `protobuf:"bytes,9,rep,name=client_heads,json=clientHeads,proto3" json:"client_heads,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetForkChoiceTreeResponse) Reset() {
+	*x = GetForkChoiceTreeResponse{}
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForkChoiceTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForkChoiceTreeResponse) ProtoMessage() {}
+
+func (x *GetForkChoiceTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_forkchoice_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForkChoiceTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetForkChoiceTreeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_forkchoice_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetForkChoiceTreeResponse) GetNodes() []*ForkChoiceNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetForkChoiceTreeResponse) GetHeadRoot() string {
+	if x != nil {
+		return x.HeadRoot
+	}
+	return ""
+}
+
+func (x *GetForkChoiceTreeResponse) GetHeadSlot() uint64 {
+	if x != nil {
+		return x.HeadSlot
+	}
+	return 0
+}
+
+func (x *GetForkChoiceTreeResponse) GetIndexedHeadRoot() string {
+	if x != nil {
+		return x.IndexedHeadRoot
+	}
+	return ""
+}
+
+func (x *GetForkChoiceTreeResponse) GetIndexedHeadSlot() uint64 {
+	if x != nil {
+		return x.IndexedHeadSlot
+	}
+	return 0
+}
+
+func (x *GetForkChoiceTreeResponse) GetStartRoot() string {
+	if x != nil {
+		return x.StartRoot
+	}
+	return ""
+}
+
+func (x *GetForkChoiceTreeResponse) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *GetForkChoiceTreeResponse) GetLatestVotes() uint32 {
+	if x != nil {
+		return x.LatestVotes
+	}
+	return 0
+}
+
+func (x *GetForkChoiceTreeResponse) GetClientHeads() []*ForkChoiceClientHead {
+	if x != nil {
+		return x.ClientHeads
+	}
+	return nil
+}
+
+var File_proto_api_v1_forkchoice_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_forkchoice_proto_rawDesc = "" +
+	"\n" +
+	"\x1dproto/api/v1/forkchoice.proto\x12\x06api.v1\"\xe2\x02\n" +
+	"\x0eForkChoiceNode\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x1f\n" +
+	"\vparent_root\x18\x02 \x01(\tR\n" +
+	"parentRoot\x12\x12\n" +
+	"\x04slot\x18\x03 \x01(\x04R\x04slot\x12%\n" +
+	"\x0eproposer_index\x18\x04 \x01(\x04R\rproposerIndex\x12'\n" +
+	"\x0fproposer_client\x18\x05 \x01(\tR\x0eproposerClient\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x04R\x06weight\x12\x17\n" +
+	"\ais_head\x18\a \x01(\bR\x06isHead\x12\x1d\n" +
+	"\n" +
+	"is_indexed\x18\b \x01(\bR\tisIndexed\x12!\n" +
+	"\fis_justified\x18\t \x01(\bR\visJustified\x12!\n" +
+	"\fis_finalized\x18\n" +
+	" \x01(\bR\visFinalized\x12!\n" +
+	"\fclient_heads\x18\v \x03(\tR\vclientHeads\"\x92\x01\n" +
+	"\x14ForkChoiceClientHead\x12\x16\n" +
+	"\x06client\x18\x01 \x01(\tR\x06client\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\x12\x12\n" +
+	"\x04slot\x18\x03 \x01(\x04R\x04slot\x12\x17\n" +
+	"\ain_tree\x18\x04 \x01(\bR\x06inTree\x12!\n" +
+	"\fmatches_head\x18\x05 \x01(\bR\vmatchesHead\"\x1a\n" +
+	"\x18GetForkChoiceTreeRequest\"\xfd\x02\n" +
+	"\x19GetForkChoiceTreeResponse\x12,\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x16.api.v1.ForkChoiceNodeR\x05nodes\x12\x1b\n" +
+	"\thead_root\x18\x02 \x01(\tR\bheadRoot\x12\x1b\n" +
+	"\thead_slot\x18\x03 \x01(\x04R\bheadSlot\x12*\n" +
+	"\x11indexed_head_root\x18\x04 \x01(\tR\x0findexedHeadRoot\x12*\n" +
+	"\x11indexed_head_slot\x18\x05 \x01(\x04R\x0findexedHeadSlot\x12\x1d\n" +
+	"\n" +
+	"start_root\x18\x06 \x01(\tR\tstartRoot\x12\x1d\n" +
+	"\n" +
+	"start_slot\x18\a \x01(\x04R\tstartSlot\x12!\n" +
+	"\flatest_votes\x18\b \x01(\rR\vlatestVotes\x12?\n" +
+	"\fclient_heads\x18\t \x03(\v2\x1c.api.v1.ForkChoiceClientHeadR\vclientHeads2m\n" +
+	"\x11ForkChoiceService\x12X\n" +
+	"\x11GetForkChoiceTree\x12 .api.v1.GetForkChoiceTreeRequest\x1a!.api.v1.GetForkChoiceTreeResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_forkchoice_proto_rawDescOnce sync.Once
+	file_proto_api_v1_forkchoice_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_forkchoice_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_forkchoice_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_forkchoice_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_forkchoice_proto_rawDesc), len(file_proto_api_v1_forkchoice_proto_rawDesc)))
+	})
+	return file_proto_api_v1_forkchoice_proto_rawDescData
+}
+
+var file_proto_api_v1_forkchoice_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_api_v1_forkchoice_proto_goTypes = []any{
+	(*ForkChoiceNode)(nil),            // 0: api.v1.ForkChoiceNode
+	(*ForkChoiceClientHead)(nil),      // 1: api.v1.ForkChoiceClientHead
+	(*GetForkChoiceTreeRequest)(nil),  // 2: api.v1.GetForkChoiceTreeRequest
+	(*GetForkChoiceTreeResponse)(nil), // 3: api.v1.GetForkChoiceTreeResponse
+}
+var file_proto_api_v1_forkchoice_proto_depIdxs = []int32{
+	0, // 0: api.v1.GetForkChoiceTreeResponse.nodes:type_name -> api.v1.ForkChoiceNode
+	1, // 1: api.v1.GetForkChoiceTreeResponse.client_heads:type_name -> api.v1.ForkChoiceClientHead
+	2, // 2: api.v1.ForkChoiceService.GetForkChoiceTree:input_type -> api.v1.GetForkChoiceTreeRequest
+	3, // 3: api.v1.ForkChoiceService.GetForkChoiceTree:output_type -> api.v1.GetForkChoiceTreeResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_forkchoice_proto_init() }
+func file_proto_api_v1_forkchoice_proto_init() {
+	if File_proto_api_v1_forkchoice_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_forkchoice_proto_rawDesc), len(file_proto_api_v1_forkchoice_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_forkchoice_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_forkchoice_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_forkchoice_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_forkchoice_proto = out.File
+	file_proto_api_v1_forkchoice_proto_goTypes = nil
+	file_proto_api_v1_forkchoice_proto_depIdxs = nil
+}
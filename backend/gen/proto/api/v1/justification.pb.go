@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/justification.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JustificationTarget is a slot that votes may justify
+type JustificationTarget struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Slot            uint64                 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root            string                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`                // Block root hex encoded with 0x prefix
+	Votes           uint64                 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`             // Validators whose vote counts, 0 once justified since the state drops its votes
+	Justifiable     bool                   `protobuf:"varint,4,opt,name=justifiable,proto3" json:"justifiable,omitempty"` // Justifiable relative to the finalized slot
+	Justified       bool                   `protobuf:"varint,5,opt,name=justified,proto3" json:"justified,omitempty"`
+	JustifiedAtSlot uint64                 `protobuf:"varint,6,opt,name=justified_at_slot,json=justifiedAtSlot,proto3" json:"justified_at_slot,omitempty"` // Slot of the block whose votes justified it, 0 if unknown
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JustificationTarget) Reset() {
+	*x = JustificationTarget{}
+	mi := &file_proto_api_v1_justification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JustificationTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JustificationTarget) ProtoMessage() {}
+
+func (x *JustificationTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_justification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JustificationTarget.ProtoReflect.Descriptor instead.
+func (*JustificationTarget) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_justification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JustificationTarget) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *JustificationTarget) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *JustificationTarget) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *JustificationTarget) GetJustifiable() bool {
+	if x != nil {
+		return x.Justifiable
+	}
+	return false
+}
+
+func (x *JustificationTarget) GetJustified() bool {
+	if x != nil {
+		return x.Justified
+	}
+	return false
+}
+
+func (x *JustificationTarget) GetJustifiedAtSlot() uint64 {
+	if x != nil {
+		return x.JustifiedAtSlot
+	}
+	return 0
+}
+
+// GetJustificationProgressRequest - progress at the verified tip, or at the latest state
+// snapshot without state verification
+type GetJustificationProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJustificationProgressRequest) Reset() {
+	*x = GetJustificationProgressRequest{}
+	mi := &file_proto_api_v1_justification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJustificationProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJustificationProgressRequest) ProtoMessage() {}
+
+func (x *GetJustificationProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_justification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJustificationProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetJustificationProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_justification_proto_rawDescGZIP(), []int{1}
+}
+
+type GetJustificationProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateSlot     uint64                 `protobuf:"varint,1,opt,name=state_slot,json=stateSlot,proto3" json:"state_slot,omitempty"` // Slot of the block the progress is taken from
+	NumValidators uint64                 `protobuf:"varint,2,opt,name=num_validators,json=numValidators,proto3" json:"num_validators,omitempty"`
+	Threshold     uint64                 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"` // Votes that justify a target, two thirds of the validators
+	JustifiedSlot uint64                 `protobuf:"varint,4,opt,name=justified_slot,json=justifiedSlot,proto3" json:"justified_slot,omitempty"`
+	JustifiedRoot string                 `protobuf:"bytes,5,opt,name=justified_root,json=justifiedRoot,proto3" json:"justified_root,omitempty"` // Hex encoded with 0x prefix
+	FinalizedSlot uint64                 `protobuf:"varint,6,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	FinalizedRoot string                 `protobuf:"bytes,7,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"` // Hex encoded with 0x prefix
+	Targets       []*JustificationTarget `protobuf:"bytes,8,rep,name=targets,proto3" json:"targets,omitempty"`                                  // In slot order
+	StateClient   string                 `protobuf:"bytes,9,opt,name=state_client,json=stateClient,proto3" json:"state_client,omitempty"`       // Client the state snapshot was fetched from, empty for the verified tip
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJustificationProgressResponse) Reset() {
+	*x = GetJustificationProgressResponse{}
+	mi := &file_proto_api_v1_justification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJustificationProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJustificationProgressResponse) ProtoMessage() {}
+
+func (x *GetJustificationProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_justification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJustificationProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetJustificationProgressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_justification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetJustificationProgressResponse) GetStateSlot() uint64 {
+	if x != nil {
+		return x.StateSlot
+	}
+	return 0
+}
+
+func (x *GetJustificationProgressResponse) GetNumValidators() uint64 {
+	if x != nil {
+		return x.NumValidators
+	}
+	return 0
+}
+
+func (x *GetJustificationProgressResponse) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetJustificationProgressResponse) GetJustifiedSlot() uint64 {
+	if x != nil {
+		return x.JustifiedSlot
+	}
+	return 0
+}
+
+func (x *GetJustificationProgressResponse) GetJustifiedRoot() string {
+	if x != nil {
+		return x.JustifiedRoot
+	}
+	return ""
+}
+
+func (x *GetJustificationProgressResponse) GetFinalizedSlot() uint64 {
+	if x != nil {
+		return x.FinalizedSlot
+	}
+	return 0
+}
+
+func (x *GetJustificationProgressResponse) GetFinalizedRoot() string {
+	if x != nil {
+		return x.FinalizedRoot
+	}
+	return ""
+}
+
+func (x *GetJustificationProgressResponse) GetTargets() []*JustificationTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *GetJustificationProgressResponse) GetStateClient() string {
+	if x != nil {
+		return x.StateClient
+	}
+	return ""
+}
+
+var File_proto_api_v1_justification_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_justification_proto_rawDesc = "" +
+	"\n" +
+	" proto/api/v1/justification.proto\x12\x06api.v1\"\xbf\x01\n" +
+	"\x13JustificationTarget\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x04R\x04slot\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\x12\x14\n" +
+	"\x05votes\x18\x03 \x01(\x04R\x05votes\x12 \n" +
+	"\vjustifiable\x18\x04 \x01(\bR\vjustifiable\x12\x1c\n" +
+	"\tjustified\x18\x05 \x01(\bR\tjustified\x12*\n" +
+	"\x11justified_at_slot\x18\x06 \x01(\x04R\x0fjustifiedAtSlot\"!\n" +
+	"\x1fGetJustificationProgressRequest\"\xfc\x02\n" +
+	" GetJustificationProgressResponse\x12\x1d\n" +
+	"\n" +
+	"state_slot\x18\x01 \x01(\x04R\tstateSlot\x12%\n" +
+	"\x0enum_validators\x18\x02 \x01(\x04R\rnumValidators\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x04R\tthreshold\x12%\n" +
+	"\x0ejustified_slot\x18\x04 \x01(\x04R\rjustifiedSlot\x12%\n" +
+	"\x0ejustified_root\x18\x05 \x01(\tR\rjustifiedRoot\x12%\n" +
+	"\x0efinalized_slot\x18\x06 \x01(\x04R\rfinalizedSlot\x12%\n" +
+	"\x0efinalized_root\x18\a \x01(\tR\rfinalizedRoot\x125\n" +
+	"\atargets\x18\b \x03(\v2\x1b.api.v1.JustificationTargetR\atargets\x12!\n" +
+	"\fstate_client\x18\t \x01(\tR\vstateClient2\x85\x01\n" +
+	"\x14JustificationService\x12m\n" +
+	"\x18GetJustificationProgress\x12'.api.v1.GetJustificationProgressRequest\x1a(.api.v1.GetJustificationProgressResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_justification_proto_rawDescOnce sync.Once
+	file_proto_api_v1_justification_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_justification_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_justification_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_justification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_justification_proto_rawDesc), len(file_proto_api_v1_justification_proto_rawDesc)))
+	})
+	return file_proto_api_v1_justification_proto_rawDescData
+}
+
+var file_proto_api_v1_justification_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_api_v1_justification_proto_goTypes = []any{
+	(*JustificationTarget)(nil),              // 0: api.v1.JustificationTarget
+	(*GetJustificationProgressRequest)(nil),  // 1: api.v1.GetJustificationProgressRequest
+	(*GetJustificationProgressResponse)(nil), // 2: api.v1.GetJustificationProgressResponse
+}
+var file_proto_api_v1_justification_proto_depIdxs = []int32{
+	0, // 0: api.v1.GetJustificationProgressResponse.targets:type_name -> api.v1.JustificationTarget
+	1, // 1: api.v1.JustificationService.GetJustificationProgress:input_type -> api.v1.GetJustificationProgressRequest
+	2, // 2: api.v1.JustificationService.GetJustificationProgress:output_type -> api.v1.GetJustificationProgressResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_justification_proto_init() }
+func file_proto_api_v1_justification_proto_init() {
+	if File_proto_api_v1_justification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_justification_proto_rawDesc), len(file_proto_api_v1_justification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_justification_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_justification_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_justification_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_justification_proto = out.File
+	file_proto_api_v1_justification_proto_goTypes = nil
+	file_proto_api_v1_justification_proto_depIdxs = nil
+}
@@ -21,17 +21,138 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// State of the client's circuit breaker
+type ClientHead_BreakerState int32
+
+const (
+	ClientHead_CLOSED    ClientHead_BreakerState = 0 // Requests flow normally
+	ClientHead_OPEN      ClientHead_BreakerState = 1 // Requests are rejected until the next probe
+	ClientHead_HALF_OPEN ClientHead_BreakerState = 2 // A probe request is deciding whether to close
+)
+
+// Enum value maps for ClientHead_BreakerState.
+var (
+	ClientHead_BreakerState_name = map[int32]string{
+		0: "CLOSED",
+		1: "OPEN",
+		2: "HALF_OPEN",
+	}
+	ClientHead_BreakerState_value = map[string]int32{
+		"CLOSED":    0,
+		"OPEN":      1,
+		"HALF_OPEN": 2,
+	}
+)
+
+func (x ClientHead_BreakerState) Enum() *ClientHead_BreakerState {
+	p := new(ClientHead_BreakerState)
+	*p = x
+	return p
+}
+
+func (x ClientHead_BreakerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientHead_BreakerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_v1_monitoring_proto_enumTypes[0].Descriptor()
+}
+
+func (ClientHead_BreakerState) Type() protoreflect.EnumType {
+	return &file_proto_api_v1_monitoring_proto_enumTypes[0]
+}
+
+func (x ClientHead_BreakerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientHead_BreakerState.Descriptor instead.
+func (ClientHead_BreakerState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_v1_monitoring_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Client status derived from reachability and head progress
+type ClientHead_Status int32
+
+const (
+	ClientHead_UNKNOWN     ClientHead_Status = 0
+	ClientHead_HEALTHY     ClientHead_Status = 1 // Reachable and at the network head
+	ClientHead_SYNCING     ClientHead_Status = 2 // Reachable and advancing, but behind the network head
+	ClientHead_STALLED     ClientHead_Status = 3 // Head stopped advancing while the network moved on
+	ClientHead_FORKED      ClientHead_Status = 4 // Head conflicts with the indexed chain
+	ClientHead_UNREACHABLE ClientHead_Status = 5 // Failing health checks or rejected by the circuit breaker
+)
+
+// Enum value maps for ClientHead_Status.
+var (
+	ClientHead_Status_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "HEALTHY",
+		2: "SYNCING",
+		3: "STALLED",
+		4: "FORKED",
+		5: "UNREACHABLE",
+	}
+	ClientHead_Status_value = map[string]int32{
+		"UNKNOWN":     0,
+		"HEALTHY":     1,
+		"SYNCING":     2,
+		"STALLED":     3,
+		"FORKED":      4,
+		"UNREACHABLE": 5,
+	}
+)
+
+func (x ClientHead_Status) Enum() *ClientHead_Status {
+	p := new(ClientHead_Status)
+	*p = x
+	return p
+}
+
+func (x ClientHead_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientHead_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_v1_monitoring_proto_enumTypes[1].Descriptor()
+}
+
+func (ClientHead_Status) Type() protoreflect.EnumType {
+	return &file_proto_api_v1_monitoring_proto_enumTypes[1]
+}
+
+func (x ClientHead_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientHead_Status.Descriptor instead.
+func (ClientHead_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_v1_monitoring_proto_rawDescGZIP(), []int{0, 1}
+}
+
 // ClientHead represents a client's current head block
 type ClientHead struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientLabel   string                 `protobuf:"bytes,1,opt,name=client_label,json=clientLabel,proto3" json:"client_label,omitempty"`       // Client label/name from config
-	EndpointUrl   string                 `protobuf:"bytes,2,opt,name=endpoint_url,json=endpointUrl,proto3" json:"endpoint_url,omitempty"`       // Client endpoint URL
-	IsHealthy     bool                   `protobuf:"varint,3,opt,name=is_healthy,json=isHealthy,proto3" json:"is_healthy,omitempty"`            // Whether the client is currently healthy
-	BlockHeader   *BlockHeader           `protobuf:"bytes,4,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`       // The head block (may be null if unhealthy)
-	BlockRoot     string                 `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`             // Hex encoded block root
-	LastUpdateMs  int64                  `protobuf:"varint,6,opt,name=last_update_ms,json=lastUpdateMs,proto3" json:"last_update_ms,omitempty"` // Unix timestamp in milliseconds of last update
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	ClientLabel         string                  `protobuf:"bytes,1,opt,name=client_label,json=clientLabel,proto3" json:"client_label,omitempty"`                        // Client label/name from config
+	EndpointUrl         string                  `protobuf:"bytes,2,opt,name=endpoint_url,json=endpointUrl,proto3" json:"endpoint_url,omitempty"`                        // Client endpoint URL, credentials redacted
+	IsHealthy           bool                    `protobuf:"varint,3,opt,name=is_healthy,json=isHealthy,proto3" json:"is_healthy,omitempty"`                             // Whether the client status is HEALTHY
+	BlockHeader         *BlockHeader            `protobuf:"bytes,4,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`                        // The head block (may be null if unhealthy)
+	BlockRoot           string                  `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`                              // Hex encoded block root
+	LastUpdateMs        int64                   `protobuf:"varint,6,opt,name=last_update_ms,json=lastUpdateMs,proto3" json:"last_update_ms,omitempty"`                  // Unix timestamp in milliseconds of last update
+	HeadProposerClient  string                  `protobuf:"bytes,7,opt,name=head_proposer_client,json=headProposerClient,proto3" json:"head_proposer_client,omitempty"` // Client running the head block's proposer (empty if unknown)
+	LatencyMs           float64                 `protobuf:"fixed64,8,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`                            // Moving average of successful request times
+	RequestCount        uint64                  `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`                    // Requests made to the client since startup
+	RequestFailures     uint64                  `protobuf:"varint,10,opt,name=request_failures,json=requestFailures,proto3" json:"request_failures,omitempty"`          // Failed requests since startup
+	BreakerState        ClientHead_BreakerState `protobuf:"varint,11,opt,name=breaker_state,json=breakerState,proto3,enum=api.v1.ClientHead_BreakerState" json:"breaker_state,omitempty"`
+	ConsecutiveFailures uint32                  `protobuf:"varint,12,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"` // Consecutive failed requests
+	LastFailure         string                  `protobuf:"bytes,13,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`                          // Reason of the last failed request (empty if none)
+	LastFailureMs       int64                   `protobuf:"varint,14,opt,name=last_failure_ms,json=lastFailureMs,proto3" json:"last_failure_ms,omitempty"`                 // Unix timestamp in milliseconds of the last failed request (0 if none)
+	NextProbeMs         int64                   `protobuf:"varint,15,opt,name=next_probe_ms,json=nextProbeMs,proto3" json:"next_probe_ms,omitempty"`                       // Unix timestamp in milliseconds of the next probe while open (0 otherwise)
+	Status              ClientHead_Status       `protobuf:"varint,16,opt,name=status,proto3,enum=api.v1.ClientHead_Status" json:"status,omitempty"`
+	StatusReason        string                  `protobuf:"bytes,17,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"` // Why the client is not healthy (empty if healthy)
+	Encoding            string                  `protobuf:"bytes,18,opt,name=encoding,proto3" json:"encoding,omitempty"`                             // Detected header encoding: ssz or json (empty before the first response)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ClientHead) Reset() {
@@ -106,6 +227,90 @@ func (x *ClientHead) GetLastUpdateMs() int64 {
 	return 0
 }
 
+func (x *ClientHead) GetHeadProposerClient() string {
+	if x != nil {
+		return x.HeadProposerClient
+	}
+	return ""
+}
+
+func (x *ClientHead) GetLatencyMs() float64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ClientHead) GetRequestCount() uint64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *ClientHead) GetRequestFailures() uint64 {
+	if x != nil {
+		return x.RequestFailures
+	}
+	return 0
+}
+
+func (x *ClientHead) GetBreakerState() ClientHead_BreakerState {
+	if x != nil {
+		return x.BreakerState
+	}
+	return ClientHead_CLOSED
+}
+
+func (x *ClientHead) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *ClientHead) GetLastFailure() string {
+	if x != nil {
+		return x.LastFailure
+	}
+	return ""
+}
+
+func (x *ClientHead) GetLastFailureMs() int64 {
+	if x != nil {
+		return x.LastFailureMs
+	}
+	return 0
+}
+
+func (x *ClientHead) GetNextProbeMs() int64 {
+	if x != nil {
+		return x.NextProbeMs
+	}
+	return 0
+}
+
+func (x *ClientHead) GetStatus() ClientHead_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ClientHead_UNKNOWN
+}
+
+func (x *ClientHead) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *ClientHead) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
 // GetAllClientsHeadsRequest - fetch heads from all clients
 type GetAllClientsHeadsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -207,7 +412,7 @@ var File_proto_api_v1_monitoring_proto protoreflect.FileDescriptor
 
 const file_proto_api_v1_monitoring_proto_rawDesc = "" +
 	"\n" +
-	"\x1dproto/api/v1/monitoring.proto\x12\x06api.v1\x1a\x18proto/api/v1/block.proto\"\xee\x01\n" +
+	"\x1dproto/api/v1/monitoring.proto\x12\x06api.v1\x1a\x18proto/api/v1/block.proto\"\xfb\x06\n" +
 	"\n" +
 	"ClientHead\x12!\n" +
 	"\fclient_label\x18\x01 \x01(\tR\vclientLabel\x12!\n" +
@@ -217,7 +422,34 @@ const file_proto_api_v1_monitoring_proto_rawDesc = "" +
 	"\fblock_header\x18\x04 \x01(\v2\x13.api.v1.BlockHeaderR\vblockHeader\x12\x1d\n" +
 	"\n" +
 	"block_root\x18\x05 \x01(\tR\tblockRoot\x12$\n" +
-	"\x0elast_update_ms\x18\x06 \x01(\x03R\flastUpdateMs\"\x1b\n" +
+	"\x0elast_update_ms\x18\x06 \x01(\x03R\flastUpdateMs\x120\n" +
+	"\x14head_proposer_client\x18\a \x01(\tR\x12headProposerClient\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\b \x01(\x01R\tlatencyMs\x12#\n" +
+	"\rrequest_count\x18\t \x01(\x04R\frequestCount\x12)\n" +
+	"\x10request_failures\x18\n" +
+	" \x01(\x04R\x0frequestFailures\x12D\n" +
+	"\rbreaker_state\x18\v \x01(\x0e2\x1f.api.v1.ClientHead.BreakerStateR\fbreakerState\x121\n" +
+	"\x14consecutive_failures\x18\f \x01(\rR\x13consecutiveFailures\x12!\n" +
+	"\flast_failure\x18\r \x01(\tR\vlastFailure\x12&\n" +
+	"\x0flast_failure_ms\x18\x0e \x01(\x03R\rlastFailureMs\x12\"\n" +
+	"\rnext_probe_ms\x18\x0f \x01(\x03R\vnextProbeMs\x121\n" +
+	"\x06status\x18\x10 \x01(\x0e2\x19.api.v1.ClientHead.StatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\x11 \x01(\tR\fstatusReason\x12\x1a\n" +
+	"\bencoding\x18\x12 \x01(\tR\bencoding\"3\n" +
+	"\fBreakerState\x12\n" +
+	"\n" +
+	"\x06CLOSED\x10\x00\x12\b\n" +
+	"\x04OPEN\x10\x01\x12\r\n" +
+	"\tHALF_OPEN\x10\x02\"Y\n" +
+	"\x06Status\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\v\n" +
+	"\aHEALTHY\x10\x01\x12\v\n" +
+	"\aSYNCING\x10\x02\x12\v\n" +
+	"\aSTALLED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FORKED\x10\x04\x12\x0f\n" +
+	"\vUNREACHABLE\x10\x05\"\x1b\n" +
 	"\x19GetAllClientsHeadsRequest\"\xa1\x01\n" +
 	"\x1aGetAllClientsHeadsResponse\x125\n" +
 	"\fclient_heads\x18\x01 \x03(\v2\x12.api.v1.ClientHeadR\vclientHeads\x12#\n" +
@@ -238,23 +470,28 @@ func file_proto_api_v1_monitoring_proto_rawDescGZIP() []byte {
 	return file_proto_api_v1_monitoring_proto_rawDescData
 }
 
+var file_proto_api_v1_monitoring_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_proto_api_v1_monitoring_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_proto_api_v1_monitoring_proto_goTypes = []any{
-	(*ClientHead)(nil),                 // 0: api.v1.ClientHead
-	(*GetAllClientsHeadsRequest)(nil),  // 1: api.v1.GetAllClientsHeadsRequest
-	(*GetAllClientsHeadsResponse)(nil), // 2: api.v1.GetAllClientsHeadsResponse
-	(*BlockHeader)(nil),                // 3: api.v1.BlockHeader
+	(ClientHead_BreakerState)(0),       // 0: api.v1.ClientHead.BreakerState
+	(ClientHead_Status)(0),             // 1: api.v1.ClientHead.Status
+	(*ClientHead)(nil),                 // 2: api.v1.ClientHead
+	(*GetAllClientsHeadsRequest)(nil),  // 3: api.v1.GetAllClientsHeadsRequest
+	(*GetAllClientsHeadsResponse)(nil), // 4: api.v1.GetAllClientsHeadsResponse
+	(*BlockHeader)(nil),                // 5: api.v1.BlockHeader
 }
 var file_proto_api_v1_monitoring_proto_depIdxs = []int32{
-	3, // 0: api.v1.ClientHead.block_header:type_name -> api.v1.BlockHeader
-	0, // 1: api.v1.GetAllClientsHeadsResponse.client_heads:type_name -> api.v1.ClientHead
-	1, // 2: api.v1.MonitoringService.GetAllClientsHeads:input_type -> api.v1.GetAllClientsHeadsRequest
-	2, // 3: api.v1.MonitoringService.GetAllClientsHeads:output_type -> api.v1.GetAllClientsHeadsResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 0: api.v1.ClientHead.block_header:type_name -> api.v1.BlockHeader
+	0, // 1: api.v1.ClientHead.breaker_state:type_name -> api.v1.ClientHead.BreakerState
+	1, // 2: api.v1.ClientHead.status:type_name -> api.v1.ClientHead.Status
+	2, // 3: api.v1.GetAllClientsHeadsResponse.client_heads:type_name -> api.v1.ClientHead
+	3, // 4: api.v1.MonitoringService.GetAllClientsHeads:input_type -> api.v1.GetAllClientsHeadsRequest
+	4, // 5: api.v1.MonitoringService.GetAllClientsHeads:output_type -> api.v1.GetAllClientsHeadsResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_v1_monitoring_proto_init() }
@@ -268,13 +505,14 @@ func file_proto_api_v1_monitoring_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_monitoring_proto_rawDesc), len(file_proto_api_v1_monitoring_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      2,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_api_v1_monitoring_proto_goTypes,
 		DependencyIndexes: file_proto_api_v1_monitoring_proto_depIdxs,
+		EnumInfos:         file_proto_api_v1_monitoring_proto_enumTypes,
 		MessageInfos:      file_proto_api_v1_monitoring_proto_msgTypes,
 	}.Build()
 	File_proto_api_v1_monitoring_proto = out.File
@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/network.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NetworkSummary describes the state of the whole devnet at a point in time
+type NetworkSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	HeadSlot            uint64                 `protobuf:"varint,1,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`                           // Head slot from the head cache
+	WallClockSlot       uint64                 `protobuf:"varint,2,opt,name=wall_clock_slot,json=wallClockSlot,proto3" json:"wall_clock_slot,omitempty"`          // Current slot by wall clock (0 if genesis time is unknown)
+	HeadLagSlots        int64                  `protobuf:"varint,3,opt,name=head_lag_slots,json=headLagSlots,proto3" json:"head_lag_slots,omitempty"`             // wall_clock_slot - head_slot
+	JustifiedSlot       uint64                 `protobuf:"varint,4,opt,name=justified_slot,json=justifiedSlot,proto3" json:"justified_slot,omitempty"`            // Latest justified checkpoint slot
+	FinalizedSlot       uint64                 `protobuf:"varint,5,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`            // Latest finalized checkpoint slot
+	FinalityLagSlots    uint64                 `protobuf:"varint,6,opt,name=finality_lag_slots,json=finalityLagSlots,proto3" json:"finality_lag_slots,omitempty"` // head_slot - finalized_slot
+	MissedSlotWindow    uint64                 `protobuf:"varint,7,opt,name=missed_slot_window,json=missedSlotWindow,proto3" json:"missed_slot_window,omitempty"` // Slots considered for the missed slot rate
+	MissedSlots         uint64                 `protobuf:"varint,8,opt,name=missed_slots,json=missedSlots,proto3" json:"missed_slots,omitempty"`                  // Slots without a stored block within the window
+	MissedSlotRate      float64                `protobuf:"fixed64,9,opt,name=missed_slot_rate,json=missedSlotRate,proto3" json:"missed_slot_rate,omitempty"`      // missed_slots / missed_slot_window
+	ReorgCount          uint64                 `protobuf:"varint,10,opt,name=reorg_count,json=reorgCount,proto3" json:"reorg_count,omitempty"`                    // Head reorgs observed since startup
+	HealthyClients      int32                  `protobuf:"varint,11,opt,name=healthy_clients,json=healthyClients,proto3" json:"healthy_clients,omitempty"`
+	TotalClients        int32                  `protobuf:"varint,12,opt,name=total_clients,json=totalClients,proto3" json:"total_clients,omitempty"`
+	ClientHeadSpread    uint64                 `protobuf:"varint,13,opt,name=client_head_spread,json=clientHeadSpread,proto3" json:"client_head_spread,omitempty"`          // Highest minus lowest head slot across responding clients
+	LastDbWriteMs       int64                  `protobuf:"varint,14,opt,name=last_db_write_ms,json=lastDbWriteMs,proto3" json:"last_db_write_ms,omitempty"`                 // Unix timestamp in milliseconds of the last DB write (0 if none)
+	GeneratedAtMs       int64                  `protobuf:"varint,15,opt,name=generated_at_ms,json=generatedAtMs,proto3" json:"generated_at_ms,omitempty"`                   // Unix timestamp in milliseconds when the summary was computed
+	HeadClient          string                 `protobuf:"bytes,16,opt,name=head_client,json=headClient,proto3" json:"head_client,omitempty"`                               // Client that served the last head poll (empty if none yet)
+	OpenCircuits        int32                  `protobuf:"varint,17,opt,name=open_circuits,json=openCircuits,proto3" json:"open_circuits,omitempty"`                        // Clients whose circuit breaker is not closed
+	Missed              []*MissedSlot          `protobuf:"bytes,18,rep,name=missed,proto3" json:"missed,omitempty"`                                                         // Slots without a stored block within the window, latest first
+	UnlinkedHeadChanges uint64                 `protobuf:"varint,19,opt,name=unlinked_head_changes,json=unlinkedHeadChanges,proto3" json:"unlinked_head_changes,omitempty"` // Head changes whose ancestry to the previous head was unknown, e.g. across a catchup gap, so reorgs among them are not in reorg_count
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NetworkSummary) Reset() {
+	*x = NetworkSummary{}
+	mi := &file_proto_api_v1_network_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkSummary) ProtoMessage() {}
+
+func (x *NetworkSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_network_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkSummary.ProtoReflect.Descriptor instead.
+func (*NetworkSummary) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_network_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NetworkSummary) GetHeadSlot() uint64 {
+	if x != nil {
+		return x.HeadSlot
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetWallClockSlot() uint64 {
+	if x != nil {
+		return x.WallClockSlot
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetHeadLagSlots() int64 {
+	if x != nil {
+		return x.HeadLagSlots
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetJustifiedSlot() uint64 {
+	if x != nil {
+		return x.JustifiedSlot
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetFinalizedSlot() uint64 {
+	if x != nil {
+		return x.FinalizedSlot
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetFinalityLagSlots() uint64 {
+	if x != nil {
+		return x.FinalityLagSlots
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetMissedSlotWindow() uint64 {
+	if x != nil {
+		return x.MissedSlotWindow
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetMissedSlots() uint64 {
+	if x != nil {
+		return x.MissedSlots
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetMissedSlotRate() float64 {
+	if x != nil {
+		return x.MissedSlotRate
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetReorgCount() uint64 {
+	if x != nil {
+		return x.ReorgCount
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetHealthyClients() int32 {
+	if x != nil {
+		return x.HealthyClients
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetTotalClients() int32 {
+	if x != nil {
+		return x.TotalClients
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetClientHeadSpread() uint64 {
+	if x != nil {
+		return x.ClientHeadSpread
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetLastDbWriteMs() int64 {
+	if x != nil {
+		return x.LastDbWriteMs
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetGeneratedAtMs() int64 {
+	if x != nil {
+		return x.GeneratedAtMs
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetHeadClient() string {
+	if x != nil {
+		return x.HeadClient
+	}
+	return ""
+}
+
+func (x *NetworkSummary) GetOpenCircuits() int32 {
+	if x != nil {
+		return x.OpenCircuits
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetMissed() []*MissedSlot {
+	if x != nil {
+		return x.Missed
+	}
+	return nil
+}
+
+func (x *NetworkSummary) GetUnlinkedHeadChanges() uint64 {
+	if x != nil {
+		return x.UnlinkedHeadChanges
+	}
+	return 0
+}
+
+// MissedSlot is a slot without a stored block and the proposer expected to fill it
+type MissedSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          uint64                 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex uint64                 `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"` // Round robin proposer of the slot (0 if the validator count is unknown)
+	ProposerKnown bool                   `protobuf:"varint,3,opt,name=proposer_known,json=proposerKnown,proto3" json:"proposer_known,omitempty"` // Whether the validator count is known, so proposer_index is meaningful
+	Client        string                 `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`                                     // Client running the proposer per the validator config (empty if unassigned)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissedSlot) Reset() {
+	*x = MissedSlot{}
+	mi := &file_proto_api_v1_network_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissedSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedSlot) ProtoMessage() {}
+
+func (x *MissedSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_network_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedSlot.ProtoReflect.Descriptor instead.
+func (*MissedSlot) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_network_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MissedSlot) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *MissedSlot) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *MissedSlot) GetProposerKnown() bool {
+	if x != nil {
+		return x.ProposerKnown
+	}
+	return false
+}
+
+func (x *MissedSlot) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+// GetNetworkSummaryRequest - summarize the devnet
+type GetNetworkSummaryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MissedSlotWindow uint64                 `protobuf:"varint,1,opt,name=missed_slot_window,json=missedSlotWindow,proto3" json:"missed_slot_window,omitempty"` // Slots ending at the head (default: 64, max: 1024)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNetworkSummaryRequest) Reset() {
+	*x = GetNetworkSummaryRequest{}
+	mi := &file_proto_api_v1_network_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkSummaryRequest) ProtoMessage() {}
+
+func (x *GetNetworkSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_network_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworkSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_network_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNetworkSummaryRequest) GetMissedSlotWindow() uint64 {
+	if x != nil {
+		return x.MissedSlotWindow
+	}
+	return 0
+}
+
+type GetNetworkSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *NetworkSummary        `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkSummaryResponse) Reset() {
+	*x = GetNetworkSummaryResponse{}
+	mi := &file_proto_api_v1_network_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkSummaryResponse) ProtoMessage() {}
+
+func (x *GetNetworkSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_network_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetNetworkSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_network_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetNetworkSummaryResponse) GetSummary() *NetworkSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+var File_proto_api_v1_network_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_network_proto_rawDesc = "" +
+	"\n" +
+	"\x1aproto/api/v1/network.proto\x12\x06api.v1\"\x86\x06\n" +
+	"\x0eNetworkSummary\x12\x1b\n" +
+	"\thead_slot\x18\x01 \x01(\x04R\bheadSlot\x12&\n" +
+	"\x0fwall_clock_slot\x18\x02 \x01(\x04R\rwallClockSlot\x12$\n" +
+	"\x0ehead_lag_slots\x18\x03 \x01(\x03R\fheadLagSlots\x12%\n" +
+	"\x0ejustified_slot\x18\x04 \x01(\x04R\rjustifiedSlot\x12%\n" +
+	"\x0efinalized_slot\x18\x05 \x01(\x04R\rfinalizedSlot\x12,\n" +
+	"\x12finality_lag_slots\x18\x06 \x01(\x04R\x10finalityLagSlots\x12,\n" +
+	"\x12missed_slot_window\x18\a \x01(\x04R\x10missedSlotWindow\x12!\n" +
+	"\fmissed_slots\x18\b \x01(\x04R\vmissedSlots\x12(\n" +
+	"\x10missed_slot_rate\x18\t \x01(\x01R\x0emissedSlotRate\x12\x1f\n" +
+	"\vreorg_count\x18\n" +
+	" \x01(\x04R\n" +
+	"reorgCount\x12'\n" +
+	"\x0fhealthy_clients\x18\v \x01(\x05R\x0ehealthyClients\x12#\n" +
+	"\rtotal_clients\x18\f \x01(\x05R\ftotalClients\x12,\n" +
+	"\x12client_head_spread\x18\r \x01(\x04R\x10clientHeadSpread\x12'\n" +
+	"\x10last_db_write_ms\x18\x0e \x01(\x03R\rlastDbWriteMs\x12&\n" +
+	"\x0fgenerated_at_ms\x18\x0f \x01(\x03R\rgeneratedAtMs\x12\x1f\n" +
+	"\vhead_client\x18\x10 \x01(\tR\n" +
+	"headClient\x12#\n" +
+	"\ropen_circuits\x18\x11 \x01(\x05R\fopenCircuits\x12*\n" +
+	"\x06missed\x18\x12 \x03(\v2\x12.api.v1.MissedSlotR\x06missed\x122\n" +
+	"\x15unlinked_head_changes\x18\x13 \x01(\x04R\x13unlinkedHeadChanges\"\x86\x01\n" +
+	"\n" +
+	"MissedSlot\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x04R\x04slot\x12%\n" +
+	"\x0eproposer_index\x18\x02 \x01(\x04R\rproposerIndex\x12%\n" +
+	"\x0eproposer_known\x18\x03 \x01(\bR\rproposerKnown\x12\x16\n" +
+	"\x06client\x18\x04 \x01(\tR\x06client\"H\n" +
+	"\x18GetNetworkSummaryRequest\x12,\n" +
+	"\x12missed_slot_window\x18\x01 \x01(\x04R\x10missedSlotWindow\"M\n" +
+	"\x19GetNetworkSummaryResponse\x120\n" +
+	"\asummary\x18\x01 \x01(\v2\x16.api.v1.NetworkSummaryR\asummary2j\n" +
+	"\x0eNetworkService\x12X\n" +
+	"\x11GetNetworkSummary\x12 .api.v1.GetNetworkSummaryRequest\x1a!.api.v1.GetNetworkSummaryResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_network_proto_rawDescOnce sync.Once
+	file_proto_api_v1_network_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_network_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_network_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_network_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_network_proto_rawDesc), len(file_proto_api_v1_network_proto_rawDesc)))
+	})
+	return file_proto_api_v1_network_proto_rawDescData
+}
+
+var file_proto_api_v1_network_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_api_v1_network_proto_goTypes = []any{
+	(*NetworkSummary)(nil),            // 0: api.v1.NetworkSummary
+	(*MissedSlot)(nil),                // 1: api.v1.MissedSlot
+	(*GetNetworkSummaryRequest)(nil),  // 2: api.v1.GetNetworkSummaryRequest
+	(*GetNetworkSummaryResponse)(nil), // 3: api.v1.GetNetworkSummaryResponse
+}
+var file_proto_api_v1_network_proto_depIdxs = []int32{
+	1, // 0: api.v1.NetworkSummary.missed:type_name -> api.v1.MissedSlot
+	0, // 1: api.v1.GetNetworkSummaryResponse.summary:type_name -> api.v1.NetworkSummary
+	2, // 2: api.v1.NetworkService.GetNetworkSummary:input_type -> api.v1.GetNetworkSummaryRequest
+	3, // 3: api.v1.NetworkService.GetNetworkSummary:output_type -> api.v1.GetNetworkSummaryResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_network_proto_init() }
+func file_proto_api_v1_network_proto_init() {
+	if File_proto_api_v1_network_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_network_proto_rawDesc), len(file_proto_api_v1_network_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_network_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_network_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_network_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_network_proto = out.File
+	file_proto_api_v1_network_proto_goTypes = nil
+	file_proto_api_v1_network_proto_depIdxs = nil
+}
@@ -0,0 +1,565 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/proof.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HeaderSource selects a block header from the indexed chain or from a client
+type HeaderSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"` // Client to fetch the header from, empty for the indexed chain
+	Slot          uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root          string                 `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"` // Block root hex encoded with 0x prefix, takes precedence over slot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeaderSource) Reset() {
+	*x = HeaderSource{}
+	mi := &file_proto_api_v1_proof_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeaderSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderSource) ProtoMessage() {}
+
+func (x *HeaderSource) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proof_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderSource.ProtoReflect.Descriptor instead.
+func (*HeaderSource) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HeaderSource) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *HeaderSource) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *HeaderSource) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+// FieldProof proves the hash tree root of a field against the block root
+type FieldProof struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Field            string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // Field name as in JSON, e.g. state_root
+	GeneralizedIndex uint64                 `protobuf:"varint,2,opt,name=generalized_index,json=generalizedIndex,proto3" json:"generalized_index,omitempty"`
+	Leaf             string                 `protobuf:"bytes,3,opt,name=leaf,proto3" json:"leaf,omitempty"`     // Hash tree root of the field, hex encoded with 0x prefix
+	Branch           []string               `protobuf:"bytes,4,rep,name=branch,proto3" json:"branch,omitempty"` // Sibling hashes from the leaf up to the root, hex encoded with 0x prefix
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FieldProof) Reset() {
+	*x = FieldProof{}
+	mi := &file_proto_api_v1_proof_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldProof) ProtoMessage() {}
+
+func (x *FieldProof) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proof_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldProof.ProtoReflect.Descriptor instead.
+func (*FieldProof) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proof_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldProof) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldProof) GetGeneralizedIndex() uint64 {
+	if x != nil {
+		return x.GeneralizedIndex
+	}
+	return 0
+}
+
+func (x *FieldProof) GetLeaf() string {
+	if x != nil {
+		return x.Leaf
+	}
+	return ""
+}
+
+func (x *FieldProof) GetBranch() []string {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+// FieldDiff is a field that differs between two headers
+type FieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	ValueA        string                 `protobuf:"bytes,2,opt,name=value_a,json=valueA,proto3" json:"value_a,omitempty"` // Decimal for numbers, hex encoded with 0x prefix for roots
+	ValueB        string                 `protobuf:"bytes,3,opt,name=value_b,json=valueB,proto3" json:"value_b,omitempty"`
+	ProofA        *FieldProof            `protobuf:"bytes,4,opt,name=proof_a,json=proofA,proto3" json:"proof_a,omitempty"`
+	ProofB        *FieldProof            `protobuf:"bytes,5,opt,name=proof_b,json=proofB,proto3" json:"proof_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	mi := &file_proto_api_v1_proof_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proof_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proof_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetValueA() string {
+	if x != nil {
+		return x.ValueA
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetValueB() string {
+	if x != nil {
+		return x.ValueB
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetProofA() *FieldProof {
+	if x != nil {
+		return x.ProofA
+	}
+	return nil
+}
+
+func (x *FieldDiff) GetProofB() *FieldProof {
+	if x != nil {
+		return x.ProofB
+	}
+	return nil
+}
+
+// GetHeaderProofRequest - prove one field or all of them
+type GetHeaderProofRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *HeaderSource          `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"` // Empty proves every field
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeaderProofRequest) Reset() {
+	*x = GetHeaderProofRequest{}
+	mi := &file_proto_api_v1_proof_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeaderProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeaderProofRequest) ProtoMessage() {}
+
+func (x *GetHeaderProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proof_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeaderProofRequest.ProtoReflect.Descriptor instead.
+func (*GetHeaderProofRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proof_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetHeaderProofRequest) GetSource() *HeaderSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *GetHeaderProofRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type GetHeaderProofResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *BlockHeaderWithRoot   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Proofs        []*FieldProof          `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"` // In SSZ field order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeaderProofResponse) Reset() {
+	*x = GetHeaderProofResponse{}
+	mi := &file_proto_api_v1_proof_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeaderProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeaderProofResponse) ProtoMessage() {}
+
+func (x *GetHeaderProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proof_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeaderProofResponse.ProtoReflect.Descriptor instead.
+func (*GetHeaderProofResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proof_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetHeaderProofResponse) GetBlock() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *GetHeaderProofResponse) GetProofs() []*FieldProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+// DiffHeadersRequest - compare e.g. the headers two clients have at a slot
+type DiffHeadersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	A             *HeaderSource          `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B             *HeaderSource          `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffHeadersRequest) Reset() {
+	*x = DiffHeadersRequest{}
+	mi := &file_proto_api_v1_proof_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffHeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffHeadersRequest) ProtoMessage() {}
+
+func (x *DiffHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proof_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffHeadersRequest.ProtoReflect.Descriptor instead.
+func (*DiffHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proof_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DiffHeadersRequest) GetA() *HeaderSource {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *DiffHeadersRequest) GetB() *HeaderSource {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+type DiffHeadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockA        *BlockHeaderWithRoot   `protobuf:"bytes,1,opt,name=block_a,json=blockA,proto3" json:"block_a,omitempty"`
+	BlockB        *BlockHeaderWithRoot   `protobuf:"bytes,2,opt,name=block_b,json=blockB,proto3" json:"block_b,omitempty"`
+	RootsMatch    bool                   `protobuf:"varint,3,opt,name=roots_match,json=rootsMatch,proto3" json:"roots_match,omitempty"`
+	Differences   []*FieldDiff           `protobuf:"bytes,4,rep,name=differences,proto3" json:"differences,omitempty"` // In SSZ field order, empty if the roots match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffHeadersResponse) Reset() {
+	*x = DiffHeadersResponse{}
+	mi := &file_proto_api_v1_proof_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffHeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffHeadersResponse) ProtoMessage() {}
+
+func (x *DiffHeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proof_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffHeadersResponse.ProtoReflect.Descriptor instead.
+func (*DiffHeadersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proof_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiffHeadersResponse) GetBlockA() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.BlockA
+	}
+	return nil
+}
+
+func (x *DiffHeadersResponse) GetBlockB() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.BlockB
+	}
+	return nil
+}
+
+func (x *DiffHeadersResponse) GetRootsMatch() bool {
+	if x != nil {
+		return x.RootsMatch
+	}
+	return false
+}
+
+func (x *DiffHeadersResponse) GetDifferences() []*FieldDiff {
+	if x != nil {
+		return x.Differences
+	}
+	return nil
+}
+
+var File_proto_api_v1_proof_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_proof_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/api/v1/proof.proto\x12\x06api.v1\x1a\x18proto/api/v1/block.proto\"N\n" +
+	"\fHeaderSource\x12\x16\n" +
+	"\x06client\x18\x01 \x01(\tR\x06client\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x04R\x04slot\x12\x12\n" +
+	"\x04root\x18\x03 \x01(\tR\x04root\"{\n" +
+	"\n" +
+	"FieldProof\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12+\n" +
+	"\x11generalized_index\x18\x02 \x01(\x04R\x10generalizedIndex\x12\x12\n" +
+	"\x04leaf\x18\x03 \x01(\tR\x04leaf\x12\x16\n" +
+	"\x06branch\x18\x04 \x03(\tR\x06branch\"\xad\x01\n" +
+	"\tFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x17\n" +
+	"\avalue_a\x18\x02 \x01(\tR\x06valueA\x12\x17\n" +
+	"\avalue_b\x18\x03 \x01(\tR\x06valueB\x12+\n" +
+	"\aproof_a\x18\x04 \x01(\v2\x12.api.v1.FieldProofR\x06proofA\x12+\n" +
+	"\aproof_b\x18\x05 \x01(\v2\x12.api.v1.FieldProofR\x06proofB\"[\n" +
+	"\x15GetHeaderProofRequest\x12,\n" +
+	"\x06source\x18\x01 \x01(\v2\x14.api.v1.HeaderSourceR\x06source\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\"w\n" +
+	"\x16GetHeaderProofResponse\x121\n" +
+	"\x05block\x18\x01 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x05block\x12*\n" +
+	"\x06proofs\x18\x02 \x03(\v2\x12.api.v1.FieldProofR\x06proofs\"\\\n" +
+	"\x12DiffHeadersRequest\x12\"\n" +
+	"\x01a\x18\x01 \x01(\v2\x14.api.v1.HeaderSourceR\x01a\x12\"\n" +
+	"\x01b\x18\x02 \x01(\v2\x14.api.v1.HeaderSourceR\x01b\"\xd7\x01\n" +
+	"\x13DiffHeadersResponse\x124\n" +
+	"\ablock_a\x18\x01 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x06blockA\x124\n" +
+	"\ablock_b\x18\x02 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x06blockB\x12\x1f\n" +
+	"\vroots_match\x18\x03 \x01(\bR\n" +
+	"rootsMatch\x123\n" +
+	"\vdifferences\x18\x04 \x03(\v2\x11.api.v1.FieldDiffR\vdifferences2\xa7\x01\n" +
+	"\fProofService\x12O\n" +
+	"\x0eGetHeaderProof\x12\x1d.api.v1.GetHeaderProofRequest\x1a\x1e.api.v1.GetHeaderProofResponse\x12F\n" +
+	"\vDiffHeaders\x12\x1a.api.v1.DiffHeadersRequest\x1a\x1b.api.v1.DiffHeadersResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_proof_proto_rawDescOnce sync.Once
+	file_proto_api_v1_proof_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_proof_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_proof_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_proof_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_proof_proto_rawDesc), len(file_proto_api_v1_proof_proto_rawDesc)))
+	})
+	return file_proto_api_v1_proof_proto_rawDescData
+}
+
+var file_proto_api_v1_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_api_v1_proof_proto_goTypes = []any{
+	(*HeaderSource)(nil),           // 0: api.v1.HeaderSource
+	(*FieldProof)(nil),             // 1: api.v1.FieldProof
+	(*FieldDiff)(nil),              // 2: api.v1.FieldDiff
+	(*GetHeaderProofRequest)(nil),  // 3: api.v1.GetHeaderProofRequest
+	(*GetHeaderProofResponse)(nil), // 4: api.v1.GetHeaderProofResponse
+	(*DiffHeadersRequest)(nil),     // 5: api.v1.DiffHeadersRequest
+	(*DiffHeadersResponse)(nil),    // 6: api.v1.DiffHeadersResponse
+	(*BlockHeaderWithRoot)(nil),    // 7: api.v1.BlockHeaderWithRoot
+}
+var file_proto_api_v1_proof_proto_depIdxs = []int32{
+	1,  // 0: api.v1.FieldDiff.proof_a:type_name -> api.v1.FieldProof
+	1,  // 1: api.v1.FieldDiff.proof_b:type_name -> api.v1.FieldProof
+	0,  // 2: api.v1.GetHeaderProofRequest.source:type_name -> api.v1.HeaderSource
+	7,  // 3: api.v1.GetHeaderProofResponse.block:type_name -> api.v1.BlockHeaderWithRoot
+	1,  // 4: api.v1.GetHeaderProofResponse.proofs:type_name -> api.v1.FieldProof
+	0,  // 5: api.v1.DiffHeadersRequest.a:type_name -> api.v1.HeaderSource
+	0,  // 6: api.v1.DiffHeadersRequest.b:type_name -> api.v1.HeaderSource
+	7,  // 7: api.v1.DiffHeadersResponse.block_a:type_name -> api.v1.BlockHeaderWithRoot
+	7,  // 8: api.v1.DiffHeadersResponse.block_b:type_name -> api.v1.BlockHeaderWithRoot
+	2,  // 9: api.v1.DiffHeadersResponse.differences:type_name -> api.v1.FieldDiff
+	3,  // 10: api.v1.ProofService.GetHeaderProof:input_type -> api.v1.GetHeaderProofRequest
+	5,  // 11: api.v1.ProofService.DiffHeaders:input_type -> api.v1.DiffHeadersRequest
+	4,  // 12: api.v1.ProofService.GetHeaderProof:output_type -> api.v1.GetHeaderProofResponse
+	6,  // 13: api.v1.ProofService.DiffHeaders:output_type -> api.v1.DiffHeadersResponse
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_proof_proto_init() }
+func file_proto_api_v1_proof_proto_init() {
+	if File_proto_api_v1_proof_proto != nil {
+		return
+	}
+	file_proto_api_v1_block_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_proof_proto_rawDesc), len(file_proto_api_v1_proof_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_proof_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_proof_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_proof_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_proof_proto = out.File
+	file_proto_api_v1_proof_proto_goTypes = nil
+	file_proto_api_v1_proof_proto_depIdxs = nil
+}
@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/proposer.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProposerStats summarizes the proposals of one validator over a slot window
+type ProposerStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProposerIndex     uint64                 `protobuf:"varint,1,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	BlocksProposed    uint64                 `protobuf:"varint,2,opt,name=blocks_proposed,json=blocksProposed,proto3" json:"blocks_proposed,omitempty"`          // Blocks stored for this proposer within the window
+	ExpectedProposals uint64                 `protobuf:"varint,3,opt,name=expected_proposals,json=expectedProposals,proto3" json:"expected_proposals,omitempty"` // Slots assigned under round robin within the window
+	MissedProposals   uint64                 `protobuf:"varint,4,opt,name=missed_proposals,json=missedProposals,proto3" json:"missed_proposals,omitempty"`       // Assigned slots without a stored block
+	OrphanedProposals uint64                 `protobuf:"varint,5,opt,name=orphaned_proposals,json=orphanedProposals,proto3" json:"orphaned_proposals,omitempty"` // Blocks the latest stored block does not descend from
+	LastProposedSlot  uint64                 `protobuf:"varint,6,opt,name=last_proposed_slot,json=lastProposedSlot,proto3" json:"last_proposed_slot,omitempty"`  // Latest slot proposed by this index (any time)
+	MissRate          float64                `protobuf:"fixed64,7,opt,name=miss_rate,json=missRate,proto3" json:"miss_rate,omitempty"`                           // missed_proposals / expected_proposals
+	MissedSlots       []uint64               `protobuf:"varint,8,rep,packed,name=missed_slots,json=missedSlots,proto3" json:"missed_slots,omitempty"`            // Assigned slots without a stored block
+	Client            string                 `protobuf:"bytes,9,opt,name=client,proto3" json:"client,omitempty"`                                                 // Client running this validator (empty if unknown)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProposerStats) Reset() {
+	*x = ProposerStats{}
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerStats) ProtoMessage() {}
+
+func (x *ProposerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerStats.ProtoReflect.Descriptor instead.
+func (*ProposerStats) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proposer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProposerStats) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetBlocksProposed() uint64 {
+	if x != nil {
+		return x.BlocksProposed
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetExpectedProposals() uint64 {
+	if x != nil {
+		return x.ExpectedProposals
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetMissedProposals() uint64 {
+	if x != nil {
+		return x.MissedProposals
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetOrphanedProposals() uint64 {
+	if x != nil {
+		return x.OrphanedProposals
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetLastProposedSlot() uint64 {
+	if x != nil {
+		return x.LastProposedSlot
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetMissRate() float64 {
+	if x != nil {
+		return x.MissRate
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetMissedSlots() []uint64 {
+	if x != nil {
+		return x.MissedSlots
+	}
+	return nil
+}
+
+func (x *ProposerStats) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+// GetProposerStatsRequest - statistics for one proposer
+type GetProposerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposerIndex uint64                 `protobuf:"varint,1,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	Window        uint64                 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"` // Slots ending at the head (default: 256, max: 8192)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProposerStatsRequest) Reset() {
+	*x = GetProposerStatsRequest{}
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProposerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposerStatsRequest) ProtoMessage() {}
+
+func (x *GetProposerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetProposerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proposer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProposerStatsRequest) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *GetProposerStatsRequest) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+type GetProposerStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Stats          *ProposerStats         `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	StartSlot      uint64                 `protobuf:"varint,2,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`                // First slot of the window (inclusive)
+	EndSlot        uint64                 `protobuf:"varint,3,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`                      // Last slot of the window (inclusive)
+	ValidatorCount uint64                 `protobuf:"varint,4,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"` // Validator count used for round robin
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProposerStatsResponse) Reset() {
+	*x = GetProposerStatsResponse{}
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProposerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposerStatsResponse) ProtoMessage() {}
+
+func (x *GetProposerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetProposerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proposer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProposerStatsResponse) GetStats() *ProposerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetProposerStatsResponse) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *GetProposerStatsResponse) GetEndSlot() uint64 {
+	if x != nil {
+		return x.EndSlot
+	}
+	return 0
+}
+
+func (x *GetProposerStatsResponse) GetValidatorCount() uint64 {
+	if x != nil {
+		return x.ValidatorCount
+	}
+	return 0
+}
+
+// GetProposerLeaderboardRequest - proposers ranked by miss rate
+type GetProposerLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        uint64                 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"` // Slots ending at the head (default: 256, max: 8192)
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // Max entries to return (default: all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProposerLeaderboardRequest) Reset() {
+	*x = GetProposerLeaderboardRequest{}
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProposerLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposerLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetProposerLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposerLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetProposerLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proposer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProposerLeaderboardRequest) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *GetProposerLeaderboardRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetProposerLeaderboardResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Proposers      []*ProposerStats       `protobuf:"bytes,1,rep,name=proposers,proto3" json:"proposers,omitempty"` // Highest miss rate first
+	StartSlot      uint64                 `protobuf:"varint,2,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot        uint64                 `protobuf:"varint,3,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	ValidatorCount uint64                 `protobuf:"varint,4,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProposerLeaderboardResponse) Reset() {
+	*x = GetProposerLeaderboardResponse{}
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProposerLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposerLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetProposerLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_proposer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposerLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetProposerLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_proposer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProposerLeaderboardResponse) GetProposers() []*ProposerStats {
+	if x != nil {
+		return x.Proposers
+	}
+	return nil
+}
+
+func (x *GetProposerLeaderboardResponse) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *GetProposerLeaderboardResponse) GetEndSlot() uint64 {
+	if x != nil {
+		return x.EndSlot
+	}
+	return 0
+}
+
+func (x *GetProposerLeaderboardResponse) GetValidatorCount() uint64 {
+	if x != nil {
+		return x.ValidatorCount
+	}
+	return 0
+}
+
+var File_proto_api_v1_proposer_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_proposer_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/api/v1/proposer.proto\x12\x06api.v1\"\xee\x02\n" +
+	"\rProposerStats\x12%\n" +
+	"\x0eproposer_index\x18\x01 \x01(\x04R\rproposerIndex\x12'\n" +
+	"\x0fblocks_proposed\x18\x02 \x01(\x04R\x0eblocksProposed\x12-\n" +
+	"\x12expected_proposals\x18\x03 \x01(\x04R\x11expectedProposals\x12)\n" +
+	"\x10missed_proposals\x18\x04 \x01(\x04R\x0fmissedProposals\x12-\n" +
+	"\x12orphaned_proposals\x18\x05 \x01(\x04R\x11orphanedProposals\x12,\n" +
+	"\x12last_proposed_slot\x18\x06 \x01(\x04R\x10lastProposedSlot\x12\x1b\n" +
+	"\tmiss_rate\x18\a \x01(\x01R\bmissRate\x12!\n" +
+	"\fmissed_slots\x18\b \x03(\x04R\vmissedSlots\x12\x16\n" +
+	"\x06client\x18\t \x01(\tR\x06client\"X\n" +
+	"\x17GetProposerStatsRequest\x12%\n" +
+	"\x0eproposer_index\x18\x01 \x01(\x04R\rproposerIndex\x12\x16\n" +
+	"\x06window\x18\x02 \x01(\x04R\x06window\"\xaa\x01\n" +
+	"\x18GetProposerStatsResponse\x12+\n" +
+	"\x05stats\x18\x01 \x01(\v2\x15.api.v1.ProposerStatsR\x05stats\x12\x1d\n" +
+	"\n" +
+	"start_slot\x18\x02 \x01(\x04R\tstartSlot\x12\x19\n" +
+	"\bend_slot\x18\x03 \x01(\x04R\aendSlot\x12'\n" +
+	"\x0fvalidator_count\x18\x04 \x01(\x04R\x0evalidatorCount\"M\n" +
+	"\x1dGetProposerLeaderboardRequest\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\x04R\x06window\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\xb8\x01\n" +
+	"\x1eGetProposerLeaderboardResponse\x123\n" +
+	"\tproposers\x18\x01 \x03(\v2\x15.api.v1.ProposerStatsR\tproposers\x12\x1d\n" +
+	"\n" +
+	"start_slot\x18\x02 \x01(\x04R\tstartSlot\x12\x19\n" +
+	"\bend_slot\x18\x03 \x01(\x04R\aendSlot\x12'\n" +
+	"\x0fvalidator_count\x18\x04 \x01(\x04R\x0evalidatorCount2\xd1\x01\n" +
+	"\x0fProposerService\x12U\n" +
+	"\x10GetProposerStats\x12\x1f.api.v1.GetProposerStatsRequest\x1a .api.v1.GetProposerStatsResponse\x12g\n" +
+	"\x16GetProposerLeaderboard\x12%.api.v1.GetProposerLeaderboardRequest\x1a&.api.v1.GetProposerLeaderboardResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_proposer_proto_rawDescOnce sync.Once
+	file_proto_api_v1_proposer_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_proposer_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_proposer_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_proposer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_proposer_proto_rawDesc), len(file_proto_api_v1_proposer_proto_rawDesc)))
+	})
+	return file_proto_api_v1_proposer_proto_rawDescData
+}
+
+var file_proto_api_v1_proposer_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_api_v1_proposer_proto_goTypes = []any{
+	(*ProposerStats)(nil),                  // 0: api.v1.ProposerStats
+	(*GetProposerStatsRequest)(nil),        // 1: api.v1.GetProposerStatsRequest
+	(*GetProposerStatsResponse)(nil),       // 2: api.v1.GetProposerStatsResponse
+	(*GetProposerLeaderboardRequest)(nil),  // 3: api.v1.GetProposerLeaderboardRequest
+	(*GetProposerLeaderboardResponse)(nil), // 4: api.v1.GetProposerLeaderboardResponse
+}
+var file_proto_api_v1_proposer_proto_depIdxs = []int32{
+	0, // 0: api.v1.GetProposerStatsResponse.stats:type_name -> api.v1.ProposerStats
+	0, // 1: api.v1.GetProposerLeaderboardResponse.proposers:type_name -> api.v1.ProposerStats
+	1, // 2: api.v1.ProposerService.GetProposerStats:input_type -> api.v1.GetProposerStatsRequest
+	3, // 3: api.v1.ProposerService.GetProposerLeaderboard:input_type -> api.v1.GetProposerLeaderboardRequest
+	2, // 4: api.v1.ProposerService.GetProposerStats:output_type -> api.v1.GetProposerStatsResponse
+	4, // 5: api.v1.ProposerService.GetProposerLeaderboard:output_type -> api.v1.GetProposerLeaderboardResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_proposer_proto_init() }
+func file_proto_api_v1_proposer_proto_init() {
+	if File_proto_api_v1_proposer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_proposer_proto_rawDesc), len(file_proto_api_v1_proposer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_proposer_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_proposer_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_proposer_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_proposer_proto = out.File
+	file_proto_api_v1_proposer_proto_goTypes = nil
+	file_proto_api_v1_proposer_proto_depIdxs = nil
+}
@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/search.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchResult_ResultType int32
+
+const (
+	SearchResult_UNKNOWN     SearchResult_ResultType = 0
+	SearchResult_SLOT        SearchResult_ResultType = 1 // Block at the queried slot
+	SearchResult_PROPOSER    SearchResult_ResultType = 2 // Blocks proposed by the queried index
+	SearchResult_BLOCK_ROOT  SearchResult_ResultType = 3 // Block whose hash tree root matches
+	SearchResult_PARENT_ROOT SearchResult_ResultType = 4 // Block whose parent_root matches
+	SearchResult_STATE_ROOT  SearchResult_ResultType = 5 // Block whose state_root matches
+	SearchResult_BODY_ROOT   SearchResult_ResultType = 6 // Block whose body_root matches
+	SearchResult_CLIENT      SearchResult_ResultType = 7 // Configured lean node endpoint
+)
+
+// Enum value maps for SearchResult_ResultType.
+var (
+	SearchResult_ResultType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SLOT",
+		2: "PROPOSER",
+		3: "BLOCK_ROOT",
+		4: "PARENT_ROOT",
+		5: "STATE_ROOT",
+		6: "BODY_ROOT",
+		7: "CLIENT",
+	}
+	SearchResult_ResultType_value = map[string]int32{
+		"UNKNOWN":     0,
+		"SLOT":        1,
+		"PROPOSER":    2,
+		"BLOCK_ROOT":  3,
+		"PARENT_ROOT": 4,
+		"STATE_ROOT":  5,
+		"BODY_ROOT":   6,
+		"CLIENT":      7,
+	}
+)
+
+func (x SearchResult_ResultType) Enum() *SearchResult_ResultType {
+	p := new(SearchResult_ResultType)
+	*p = x
+	return p
+}
+
+func (x SearchResult_ResultType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResult_ResultType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_v1_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchResult_ResultType) Type() protoreflect.EnumType {
+	return &file_proto_api_v1_search_proto_enumTypes[0]
+}
+
+func (x SearchResult_ResultType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResult_ResultType.Descriptor instead.
+func (SearchResult_ResultType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_v1_search_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// SearchRequest - free text query
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Decimal slot or proposer index, 0x-prefixed 32-byte root, or client label
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_proto_api_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`     // Normalized query
+	Results       []*SearchResult        `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // Matches, most specific first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_proto_api_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchResponse) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SearchResult is a single typed match with a link to its detail view
+type SearchResult struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Type          SearchResult_ResultType `protobuf:"varint,1,opt,name=type,proto3,enum=api.v1.SearchResult_ResultType" json:"type,omitempty"`
+	Label         string                  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                                       // Short human readable description
+	Link          string                  `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`                                         // Relative UI path of the result
+	Block         *BlockHeaderWithRoot    `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`                                       // Matched block (slot, proposer and root results)
+	ProposerIndex uint64                  `protobuf:"varint,5,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"` // Proposer results only
+	BlockCount    uint32                  `protobuf:"varint,6,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`          // Number of blocks by the proposer
+	ClientLabel   string                  `protobuf:"bytes,7,opt,name=client_label,json=clientLabel,proto3" json:"client_label,omitempty"`        // Client results, or the client running a proposer
+	EndpointUrl   string                  `protobuf:"bytes,8,opt,name=endpoint_url,json=endpointUrl,proto3" json:"endpoint_url,omitempty"`        // Client results only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_proto_api_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResult) GetType() SearchResult_ResultType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchResult_UNKNOWN
+}
+
+func (x *SearchResult) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SearchResult) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *SearchResult) GetBlock() *BlockHeaderWithRoot {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *SearchResult) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *SearchResult) GetBlockCount() uint32 {
+	if x != nil {
+		return x.BlockCount
+	}
+	return 0
+}
+
+func (x *SearchResult) GetClientLabel() string {
+	if x != nil {
+		return x.ClientLabel
+	}
+	return ""
+}
+
+func (x *SearchResult) GetEndpointUrl() string {
+	if x != nil {
+		return x.EndpointUrl
+	}
+	return ""
+}
+
+var File_proto_api_v1_search_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/api/v1/search.proto\x12\x06api.v1\x1a\x18proto/api/v1/block.proto\"%\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"V\n" +
+	"\x0eSearchResponse\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.api.v1.SearchResultR\aresults\"\xad\x03\n" +
+	"\fSearchResult\x123\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1f.api.v1.SearchResult.ResultTypeR\x04type\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x121\n" +
+	"\x05block\x18\x04 \x01(\v2\x1b.api.v1.BlockHeaderWithRootR\x05block\x12%\n" +
+	"\x0eproposer_index\x18\x05 \x01(\x04R\rproposerIndex\x12\x1f\n" +
+	"\vblock_count\x18\x06 \x01(\rR\n" +
+	"blockCount\x12!\n" +
+	"\fclient_label\x18\a \x01(\tR\vclientLabel\x12!\n" +
+	"\fendpoint_url\x18\b \x01(\tR\vendpointUrl\"}\n" +
+	"\n" +
+	"ResultType\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\b\n" +
+	"\x04SLOT\x10\x01\x12\f\n" +
+	"\bPROPOSER\x10\x02\x12\x0e\n" +
+	"\n" +
+	"BLOCK_ROOT\x10\x03\x12\x0f\n" +
+	"\vPARENT_ROOT\x10\x04\x12\x0e\n" +
+	"\n" +
+	"STATE_ROOT\x10\x05\x12\r\n" +
+	"\tBODY_ROOT\x10\x06\x12\n" +
+	"\n" +
+	"\x06CLIENT\x10\a2H\n" +
+	"\rSearchService\x127\n" +
+	"\x06Search\x12\x15.api.v1.SearchRequest\x1a\x16.api.v1.SearchResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_search_proto_rawDescOnce sync.Once
+	file_proto_api_v1_search_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_search_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_search_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_search_proto_rawDesc), len(file_proto_api_v1_search_proto_rawDesc)))
+	})
+	return file_proto_api_v1_search_proto_rawDescData
+}
+
+var file_proto_api_v1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_api_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_api_v1_search_proto_goTypes = []any{
+	(SearchResult_ResultType)(0), // 0: api.v1.SearchResult.ResultType
+	(*SearchRequest)(nil),        // 1: api.v1.SearchRequest
+	(*SearchResponse)(nil),       // 2: api.v1.SearchResponse
+	(*SearchResult)(nil),         // 3: api.v1.SearchResult
+	(*BlockHeaderWithRoot)(nil),  // 4: api.v1.BlockHeaderWithRoot
+}
+var file_proto_api_v1_search_proto_depIdxs = []int32{
+	3, // 0: api.v1.SearchResponse.results:type_name -> api.v1.SearchResult
+	0, // 1: api.v1.SearchResult.type:type_name -> api.v1.SearchResult.ResultType
+	4, // 2: api.v1.SearchResult.block:type_name -> api.v1.BlockHeaderWithRoot
+	1, // 3: api.v1.SearchService.Search:input_type -> api.v1.SearchRequest
+	2, // 4: api.v1.SearchService.Search:output_type -> api.v1.SearchResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_search_proto_init() }
+func file_proto_api_v1_search_proto_init() {
+	if File_proto_api_v1_search_proto != nil {
+		return
+	}
+	file_proto_api_v1_block_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_search_proto_rawDesc), len(file_proto_api_v1_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_search_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_search_proto_depIdxs,
+		EnumInfos:         file_proto_api_v1_search_proto_enumTypes,
+		MessageInfos:      file_proto_api_v1_search_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_search_proto = out.File
+	file_proto_api_v1_search_proto_goTypes = nil
+	file_proto_api_v1_search_proto_depIdxs = nil
+}
@@ -0,0 +1,744 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/signature.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BlockSignature is the proposer signature of a fetched block
+type BlockSignature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockRoot     string                 `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"` // Hex encoded with 0x prefix
+	Slot          uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex uint64                 `protobuf:"varint,3,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	Fork          string                 `protobuf:"bytes,4,opt,name=fork,proto3" json:"fork,omitempty"`                                         // Fork whose container layout the block was decoded in, e.g. devnet0
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`                               // Hex encoded with 0x prefix
+	SignatureSize uint64                 `protobuf:"varint,6,opt,name=signature_size,json=signatureSize,proto3" json:"signature_size,omitempty"` // Bytes
+	BlockSize     uint64                 `protobuf:"varint,7,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`             // SSZ encoded signed block size in the fork's layout
+	Votes         uint64                 `protobuf:"varint,8,opt,name=votes,proto3" json:"votes,omitempty"`
+	Client        string                 `protobuf:"bytes,9,opt,name=client,proto3" json:"client,omitempty"`                             // Client the block was first fetched from
+	ObservedAt    int64                  `protobuf:"varint,10,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"` // Unix timestamp in milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockSignature) Reset() {
+	*x = BlockSignature{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSignature) ProtoMessage() {}
+
+func (x *BlockSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSignature.ProtoReflect.Descriptor instead.
+func (*BlockSignature) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockSignature) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *BlockSignature) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockSignature) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *BlockSignature) GetFork() string {
+	if x != nil {
+		return x.Fork
+	}
+	return ""
+}
+
+func (x *BlockSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *BlockSignature) GetSignatureSize() uint64 {
+	if x != nil {
+		return x.SignatureSize
+	}
+	return 0
+}
+
+func (x *BlockSignature) GetBlockSize() uint64 {
+	if x != nil {
+		return x.BlockSize
+	}
+	return 0
+}
+
+func (x *BlockSignature) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *BlockSignature) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *BlockSignature) GetObservedAt() int64 {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return 0
+}
+
+// SizeStats summarizes the signature and block sizes of a set of blocks
+type SizeStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Blocks           uint64                 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	FirstSlot        uint64                 `protobuf:"varint,2,opt,name=first_slot,json=firstSlot,proto3" json:"first_slot,omitempty"`
+	LastSlot         uint64                 `protobuf:"varint,3,opt,name=last_slot,json=lastSlot,proto3" json:"last_slot,omitempty"`
+	MinSignatureSize uint64                 `protobuf:"varint,4,opt,name=min_signature_size,json=minSignatureSize,proto3" json:"min_signature_size,omitempty"`
+	MaxSignatureSize uint64                 `protobuf:"varint,5,opt,name=max_signature_size,json=maxSignatureSize,proto3" json:"max_signature_size,omitempty"`
+	AvgSignatureSize float64                `protobuf:"fixed64,6,opt,name=avg_signature_size,json=avgSignatureSize,proto3" json:"avg_signature_size,omitempty"`
+	MinBlockSize     uint64                 `protobuf:"varint,7,opt,name=min_block_size,json=minBlockSize,proto3" json:"min_block_size,omitempty"`
+	MaxBlockSize     uint64                 `protobuf:"varint,8,opt,name=max_block_size,json=maxBlockSize,proto3" json:"max_block_size,omitempty"`
+	AvgBlockSize     float64                `protobuf:"fixed64,9,opt,name=avg_block_size,json=avgBlockSize,proto3" json:"avg_block_size,omitempty"`
+	TotalBytes       uint64                 `protobuf:"varint,10,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"` // Sum of the block sizes
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SizeStats) Reset() {
+	*x = SizeStats{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SizeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeStats) ProtoMessage() {}
+
+func (x *SizeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeStats.ProtoReflect.Descriptor instead.
+func (*SizeStats) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SizeStats) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *SizeStats) GetFirstSlot() uint64 {
+	if x != nil {
+		return x.FirstSlot
+	}
+	return 0
+}
+
+func (x *SizeStats) GetLastSlot() uint64 {
+	if x != nil {
+		return x.LastSlot
+	}
+	return 0
+}
+
+func (x *SizeStats) GetMinSignatureSize() uint64 {
+	if x != nil {
+		return x.MinSignatureSize
+	}
+	return 0
+}
+
+func (x *SizeStats) GetMaxSignatureSize() uint64 {
+	if x != nil {
+		return x.MaxSignatureSize
+	}
+	return 0
+}
+
+func (x *SizeStats) GetAvgSignatureSize() float64 {
+	if x != nil {
+		return x.AvgSignatureSize
+	}
+	return 0
+}
+
+func (x *SizeStats) GetMinBlockSize() uint64 {
+	if x != nil {
+		return x.MinBlockSize
+	}
+	return 0
+}
+
+func (x *SizeStats) GetMaxBlockSize() uint64 {
+	if x != nil {
+		return x.MaxBlockSize
+	}
+	return 0
+}
+
+func (x *SizeStats) GetAvgBlockSize() float64 {
+	if x != nil {
+		return x.AvgBlockSize
+	}
+	return 0
+}
+
+func (x *SizeStats) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+// SizeBucket is the size statistics of the blocks of one fork in a slot range
+type SizeBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartSlot     uint64                 `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot       uint64                 `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`       // Inclusive
+	StartTime     int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Unix timestamp in milliseconds of start_slot, 0 without chain.genesisTime
+	Fork          string                 `protobuf:"bytes,4,opt,name=fork,proto3" json:"fork,omitempty"`
+	Stats         *SizeStats             `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SizeBucket) Reset() {
+	*x = SizeBucket{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SizeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeBucket) ProtoMessage() {}
+
+func (x *SizeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeBucket.ProtoReflect.Descriptor instead.
+func (*SizeBucket) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SizeBucket) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *SizeBucket) GetEndSlot() uint64 {
+	if x != nil {
+		return x.EndSlot
+	}
+	return 0
+}
+
+func (x *SizeBucket) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SizeBucket) GetFork() string {
+	if x != nil {
+		return x.Fork
+	}
+	return ""
+}
+
+func (x *SizeBucket) GetStats() *SizeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// ForkSizeStats is the size statistics of all stored blocks of a fork
+type ForkSizeStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Fork           string                 `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+	ActivationSlot uint64                 `protobuf:"varint,2,opt,name=activation_slot,json=activationSlot,proto3" json:"activation_slot,omitempty"` // Slot the fork activates at in the configured schedule
+	Scheduled      bool                   `protobuf:"varint,3,opt,name=scheduled,proto3" json:"scheduled,omitempty"`                                 // Whether the fork is in the configured schedule
+	Stats          *SizeStats             `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ForkSizeStats) Reset() {
+	*x = ForkSizeStats{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkSizeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkSizeStats) ProtoMessage() {}
+
+func (x *ForkSizeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkSizeStats.ProtoReflect.Descriptor instead.
+func (*ForkSizeStats) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForkSizeStats) GetFork() string {
+	if x != nil {
+		return x.Fork
+	}
+	return ""
+}
+
+func (x *ForkSizeStats) GetActivationSlot() uint64 {
+	if x != nil {
+		return x.ActivationSlot
+	}
+	return 0
+}
+
+func (x *ForkSizeStats) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+func (x *ForkSizeStats) GetStats() *SizeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// GetBlockSignaturesRequest - the blocks at a slot, or a single block by root
+type GetBlockSignaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          uint64                 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root          string                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"` // Block root hex encoded with 0x prefix, takes precedence over slot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockSignaturesRequest) Reset() {
+	*x = GetBlockSignaturesRequest{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockSignaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockSignaturesRequest) ProtoMessage() {}
+
+func (x *GetBlockSignaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockSignaturesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBlockSignaturesRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *GetBlockSignaturesRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+// GetBlockSignaturesResponse - the stored signatures, empty if none of the blocks was fetched
+type GetBlockSignaturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signatures    []*BlockSignature      `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockSignaturesResponse) Reset() {
+	*x = GetBlockSignaturesResponse{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockSignaturesResponse) ProtoMessage() {}
+
+func (x *GetBlockSignaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBlockSignaturesResponse) GetSignatures() []*BlockSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// GetSizeTrendRequest - buckets of bucket_slots slots, latest first
+type GetSizeTrendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketSlots   uint64                 `protobuf:"varint,1,opt,name=bucket_slots,json=bucketSlots,proto3" json:"bucket_slots,omitempty"` // Slots per bucket, default 32
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                // Buckets, default 50, max 500
+	Fork          string                 `protobuf:"bytes,3,opt,name=fork,proto3" json:"fork,omitempty"`                                   // Only blocks of this fork, empty for all forks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSizeTrendRequest) Reset() {
+	*x = GetSizeTrendRequest{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSizeTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSizeTrendRequest) ProtoMessage() {}
+
+func (x *GetSizeTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSizeTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetSizeTrendRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSizeTrendRequest) GetBucketSlots() uint64 {
+	if x != nil {
+		return x.BucketSlots
+	}
+	return 0
+}
+
+func (x *GetSizeTrendRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSizeTrendRequest) GetFork() string {
+	if x != nil {
+		return x.Fork
+	}
+	return ""
+}
+
+// GetSizeTrendResponse - size buckets and the totals of every fork
+type GetSizeTrendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buckets       []*SizeBucket          `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"` // Latest first, a range spanning a fork activation has a bucket per fork
+	Forks         []*ForkSizeStats       `protobuf:"bytes,2,rep,name=forks,proto3" json:"forks,omitempty"`     // Stored and scheduled forks in activation order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSizeTrendResponse) Reset() {
+	*x = GetSizeTrendResponse{}
+	mi := &file_proto_api_v1_signature_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSizeTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSizeTrendResponse) ProtoMessage() {}
+
+func (x *GetSizeTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_signature_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSizeTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetSizeTrendResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_signature_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSizeTrendResponse) GetBuckets() []*SizeBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetSizeTrendResponse) GetForks() []*ForkSizeStats {
+	if x != nil {
+		return x.Forks
+	}
+	return nil
+}
+
+var File_proto_api_v1_signature_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_signature_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/api/v1/signature.proto\x12\x06api.v1\"\xb1\x02\n" +
+	"\x0eBlockSignature\x12\x1d\n" +
+	"\n" +
+	"block_root\x18\x01 \x01(\tR\tblockRoot\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x04R\x04slot\x12%\n" +
+	"\x0eproposer_index\x18\x03 \x01(\x04R\rproposerIndex\x12\x12\n" +
+	"\x04fork\x18\x04 \x01(\tR\x04fork\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\x12%\n" +
+	"\x0esignature_size\x18\x06 \x01(\x04R\rsignatureSize\x12\x1d\n" +
+	"\n" +
+	"block_size\x18\a \x01(\x04R\tblockSize\x12\x14\n" +
+	"\x05votes\x18\b \x01(\x04R\x05votes\x12\x16\n" +
+	"\x06client\x18\t \x01(\tR\x06client\x12\x1f\n" +
+	"\vobserved_at\x18\n" +
+	" \x01(\x03R\n" +
+	"observedAt\"\xfc\x02\n" +
+	"\tSizeStats\x12\x16\n" +
+	"\x06blocks\x18\x01 \x01(\x04R\x06blocks\x12\x1d\n" +
+	"\n" +
+	"first_slot\x18\x02 \x01(\x04R\tfirstSlot\x12\x1b\n" +
+	"\tlast_slot\x18\x03 \x01(\x04R\blastSlot\x12,\n" +
+	"\x12min_signature_size\x18\x04 \x01(\x04R\x10minSignatureSize\x12,\n" +
+	"\x12max_signature_size\x18\x05 \x01(\x04R\x10maxSignatureSize\x12,\n" +
+	"\x12avg_signature_size\x18\x06 \x01(\x01R\x10avgSignatureSize\x12$\n" +
+	"\x0emin_block_size\x18\a \x01(\x04R\fminBlockSize\x12$\n" +
+	"\x0emax_block_size\x18\b \x01(\x04R\fmaxBlockSize\x12$\n" +
+	"\x0eavg_block_size\x18\t \x01(\x01R\favgBlockSize\x12\x1f\n" +
+	"\vtotal_bytes\x18\n" +
+	" \x01(\x04R\n" +
+	"totalBytes\"\xa2\x01\n" +
+	"\n" +
+	"SizeBucket\x12\x1d\n" +
+	"\n" +
+	"start_slot\x18\x01 \x01(\x04R\tstartSlot\x12\x19\n" +
+	"\bend_slot\x18\x02 \x01(\x04R\aendSlot\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03R\tstartTime\x12\x12\n" +
+	"\x04fork\x18\x04 \x01(\tR\x04fork\x12'\n" +
+	"\x05stats\x18\x05 \x01(\v2\x11.api.v1.SizeStatsR\x05stats\"\x93\x01\n" +
+	"\rForkSizeStats\x12\x12\n" +
+	"\x04fork\x18\x01 \x01(\tR\x04fork\x12'\n" +
+	"\x0factivation_slot\x18\x02 \x01(\x04R\x0eactivationSlot\x12\x1c\n" +
+	"\tscheduled\x18\x03 \x01(\bR\tscheduled\x12'\n" +
+	"\x05stats\x18\x04 \x01(\v2\x11.api.v1.SizeStatsR\x05stats\"C\n" +
+	"\x19GetBlockSignaturesRequest\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x04R\x04slot\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\"T\n" +
+	"\x1aGetBlockSignaturesResponse\x126\n" +
+	"\n" +
+	"signatures\x18\x01 \x03(\v2\x16.api.v1.BlockSignatureR\n" +
+	"signatures\"b\n" +
+	"\x13GetSizeTrendRequest\x12!\n" +
+	"\fbucket_slots\x18\x01 \x01(\x04R\vbucketSlots\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04fork\x18\x03 \x01(\tR\x04fork\"q\n" +
+	"\x14GetSizeTrendResponse\x12,\n" +
+	"\abuckets\x18\x01 \x03(\v2\x12.api.v1.SizeBucketR\abuckets\x12+\n" +
+	"\x05forks\x18\x02 \x03(\v2\x15.api.v1.ForkSizeStatsR\x05forks2\xba\x01\n" +
+	"\x10SignatureService\x12[\n" +
+	"\x12GetBlockSignatures\x12!.api.v1.GetBlockSignaturesRequest\x1a\".api.v1.GetBlockSignaturesResponse\x12I\n" +
+	"\fGetSizeTrend\x12\x1b.api.v1.GetSizeTrendRequest\x1a\x1c.api.v1.GetSizeTrendResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_signature_proto_rawDescOnce sync.Once
+	file_proto_api_v1_signature_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_signature_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_signature_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_signature_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_signature_proto_rawDesc), len(file_proto_api_v1_signature_proto_rawDesc)))
+	})
+	return file_proto_api_v1_signature_proto_rawDescData
+}
+
+var file_proto_api_v1_signature_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_api_v1_signature_proto_goTypes = []any{
+	(*BlockSignature)(nil),             // 0: api.v1.BlockSignature
+	(*SizeStats)(nil),                  // 1: api.v1.SizeStats
+	(*SizeBucket)(nil),                 // 2: api.v1.SizeBucket
+	(*ForkSizeStats)(nil),              // 3: api.v1.ForkSizeStats
+	(*GetBlockSignaturesRequest)(nil),  // 4: api.v1.GetBlockSignaturesRequest
+	(*GetBlockSignaturesResponse)(nil), // 5: api.v1.GetBlockSignaturesResponse
+	(*GetSizeTrendRequest)(nil),        // 6: api.v1.GetSizeTrendRequest
+	(*GetSizeTrendResponse)(nil),       // 7: api.v1.GetSizeTrendResponse
+}
+var file_proto_api_v1_signature_proto_depIdxs = []int32{
+	1, // 0: api.v1.SizeBucket.stats:type_name -> api.v1.SizeStats
+	1, // 1: api.v1.ForkSizeStats.stats:type_name -> api.v1.SizeStats
+	0, // 2: api.v1.GetBlockSignaturesResponse.signatures:type_name -> api.v1.BlockSignature
+	2, // 3: api.v1.GetSizeTrendResponse.buckets:type_name -> api.v1.SizeBucket
+	3, // 4: api.v1.GetSizeTrendResponse.forks:type_name -> api.v1.ForkSizeStats
+	4, // 5: api.v1.SignatureService.GetBlockSignatures:input_type -> api.v1.GetBlockSignaturesRequest
+	6, // 6: api.v1.SignatureService.GetSizeTrend:input_type -> api.v1.GetSizeTrendRequest
+	5, // 7: api.v1.SignatureService.GetBlockSignatures:output_type -> api.v1.GetBlockSignaturesResponse
+	7, // 8: api.v1.SignatureService.GetSizeTrend:output_type -> api.v1.GetSizeTrendResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_signature_proto_init() }
+func file_proto_api_v1_signature_proto_init() {
+	if File_proto_api_v1_signature_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_signature_proto_rawDesc), len(file_proto_api_v1_signature_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_signature_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_signature_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_signature_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_signature_proto = out.File
+	file_proto_api_v1_signature_proto_goTypes = nil
+	file_proto_api_v1_signature_proto_depIdxs = nil
+}
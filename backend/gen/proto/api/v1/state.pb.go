@@ -0,0 +1,695 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/api/v1/state.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StateSnapshot is a state fetched from a client
+type StateSnapshot struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StateRoot        string                 `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"` // Hex encoded with 0x prefix
+	Slot             uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot        string                 `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"` // Root of the state's latest block, hex encoded with 0x prefix
+	Client           string                 `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`                        // Client the state was fetched from
+	JustifiedSlot    uint64                 `protobuf:"varint,5,opt,name=justified_slot,json=justifiedSlot,proto3" json:"justified_slot,omitempty"`
+	JustifiedRoot    string                 `protobuf:"bytes,6,opt,name=justified_root,json=justifiedRoot,proto3" json:"justified_root,omitempty"` // Hex encoded with 0x prefix
+	FinalizedSlot    uint64                 `protobuf:"varint,7,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	FinalizedRoot    string                 `protobuf:"bytes,8,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`            // Hex encoded with 0x prefix
+	SizeBytes        uint64                 `protobuf:"varint,9,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                       // SSZ encoded size
+	CheckedHashes    uint64                 `protobuf:"varint,10,opt,name=checked_hashes,json=checkedHashes,proto3" json:"checked_hashes,omitempty"`          // Block hashes compared with the indexed chain when fetched
+	MismatchedHashes uint64                 `protobuf:"varint,11,opt,name=mismatched_hashes,json=mismatchedHashes,proto3" json:"mismatched_hashes,omitempty"` // Compared block hashes that differ from the indexed chain
+	UnindexedHashes  uint64                 `protobuf:"varint,12,opt,name=unindexed_hashes,json=unindexedHashes,proto3" json:"unindexed_hashes,omitempty"`    // Block hashes of slots without an indexed header
+	FetchedAt        int64                  `protobuf:"varint,13,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`                      // Unix timestamp in milliseconds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StateSnapshot) Reset() {
+	*x = StateSnapshot{}
+	mi := &file_proto_api_v1_state_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateSnapshot) ProtoMessage() {}
+
+func (x *StateSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_state_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateSnapshot.ProtoReflect.Descriptor instead.
+func (*StateSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StateSnapshot) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *StateSnapshot) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *StateSnapshot) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *StateSnapshot) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *StateSnapshot) GetJustifiedSlot() uint64 {
+	if x != nil {
+		return x.JustifiedSlot
+	}
+	return 0
+}
+
+func (x *StateSnapshot) GetJustifiedRoot() string {
+	if x != nil {
+		return x.JustifiedRoot
+	}
+	return ""
+}
+
+func (x *StateSnapshot) GetFinalizedSlot() uint64 {
+	if x != nil {
+		return x.FinalizedSlot
+	}
+	return 0
+}
+
+func (x *StateSnapshot) GetFinalizedRoot() string {
+	if x != nil {
+		return x.FinalizedRoot
+	}
+	return ""
+}
+
+func (x *StateSnapshot) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *StateSnapshot) GetCheckedHashes() uint64 {
+	if x != nil {
+		return x.CheckedHashes
+	}
+	return 0
+}
+
+func (x *StateSnapshot) GetMismatchedHashes() uint64 {
+	if x != nil {
+		return x.MismatchedHashes
+	}
+	return 0
+}
+
+func (x *StateSnapshot) GetUnindexedHashes() uint64 {
+	if x != nil {
+		return x.UnindexedHashes
+	}
+	return 0
+}
+
+func (x *StateSnapshot) GetFetchedAt() int64 {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return 0
+}
+
+// PendingJustification is a target root with votes that did not justify it yet
+type PendingJustification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`                             // Block root hex encoded with 0x prefix
+	Slot          uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`                            // Slot of the root in historical_block_hashes
+	SlotKnown     bool                   `protobuf:"varint,3,opt,name=slot_known,json=slotKnown,proto3" json:"slot_known,omitempty"` // Whether the root is in historical_block_hashes
+	Votes         uint64                 `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty"`
+	Validators    string                 `protobuf:"bytes,5,opt,name=validators,proto3" json:"validators,omitempty"` // Bitfield of the voting validators, bit i of byte i/8 for validator i, hex encoded with 0x prefix
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingJustification) Reset() {
+	*x = PendingJustification{}
+	mi := &file_proto_api_v1_state_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingJustification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingJustification) ProtoMessage() {}
+
+func (x *PendingJustification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_state_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingJustification.ProtoReflect.Descriptor instead.
+func (*PendingJustification) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PendingJustification) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *PendingJustification) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *PendingJustification) GetSlotKnown() bool {
+	if x != nil {
+		return x.SlotKnown
+	}
+	return false
+}
+
+func (x *PendingJustification) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *PendingJustification) GetValidators() string {
+	if x != nil {
+		return x.Validators
+	}
+	return ""
+}
+
+// HashMismatch is a slot whose block hash in the state differs from the indexed chain
+type HashMismatch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Slot             uint64                 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	StateBlockRoot   string                 `protobuf:"bytes,2,opt,name=state_block_root,json=stateBlockRoot,proto3" json:"state_block_root,omitempty"`       // Block root in the state, zero for a missed slot, hex encoded with 0x prefix
+	IndexedBlockRoot string                 `protobuf:"bytes,3,opt,name=indexed_block_root,json=indexedBlockRoot,proto3" json:"indexed_block_root,omitempty"` // Root of the indexed header, hex encoded with 0x prefix
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HashMismatch) Reset() {
+	*x = HashMismatch{}
+	mi := &file_proto_api_v1_state_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashMismatch) ProtoMessage() {}
+
+func (x *HashMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_state_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashMismatch.ProtoReflect.Descriptor instead.
+func (*HashMismatch) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HashMismatch) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *HashMismatch) GetStateBlockRoot() string {
+	if x != nil {
+		return x.StateBlockRoot
+	}
+	return ""
+}
+
+func (x *HashMismatch) GetIndexedBlockRoot() string {
+	if x != nil {
+		return x.IndexedBlockRoot
+	}
+	return ""
+}
+
+// GetStateRequest - a stored snapshot, or the state a client serves, by slot or state root
+type GetStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          uint64                 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root          string                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`     // State root hex encoded with 0x prefix, takes precedence over slot
+	Client        string                 `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"` // Fetch the state from this client instead of the stored snapshots
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateRequest) Reset() {
+	*x = GetStateRequest{}
+	mi := &file_proto_api_v1_state_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateRequest) ProtoMessage() {}
+
+func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_state_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateRequest.ProtoReflect.Descriptor instead.
+func (*GetStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStateRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *GetStateRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *GetStateRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+type GetStateResponse struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Snapshot              *StateSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // Hash counts as of the fetch, now for a state fetched from a client
+	NumValidators         uint64                  `protobuf:"varint,2,opt,name=num_validators,json=numValidators,proto3" json:"num_validators,omitempty"`
+	GenesisTime           uint64                  `protobuf:"varint,3,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	LatestBlockHeader     *BlockHeader            `protobuf:"bytes,4,opt,name=latest_block_header,json=latestBlockHeader,proto3" json:"latest_block_header,omitempty"`              // As in the state, the state root is zero after a block
+	HistoricalBlockHashes uint64                  `protobuf:"varint,5,opt,name=historical_block_hashes,json=historicalBlockHashes,proto3" json:"historical_block_hashes,omitempty"` // Number of block hashes
+	JustifiedSlots        string                  `protobuf:"bytes,6,opt,name=justified_slots,json=justifiedSlots,proto3" json:"justified_slots,omitempty"`                         // Bitfield of justified slots, bit i of byte i/8 for slot i, hex encoded with 0x prefix
+	JustifiedSlotsLength  uint64                  `protobuf:"varint,7,opt,name=justified_slots_length,json=justifiedSlotsLength,proto3" json:"justified_slots_length,omitempty"`    // Slots covered by the bitfield
+	Justifications        []*PendingJustification `protobuf:"bytes,8,rep,name=justifications,proto3" json:"justifications,omitempty"`                                               // In state order
+	Mismatches            []*HashMismatch         `protobuf:"bytes,9,rep,name=mismatches,proto3" json:"mismatches,omitempty"`                                                       // Compared with the indexed chain now, in slot order
+	UnindexedSlots        []uint64                `protobuf:"varint,10,rep,packed,name=unindexed_slots,json=unindexedSlots,proto3" json:"unindexed_slots,omitempty"`                // Slots with a block in the state but no indexed header, in slot order
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetStateResponse) Reset() {
+	*x = GetStateResponse{}
+	mi := &file_proto_api_v1_state_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateResponse) ProtoMessage() {}
+
+func (x *GetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_state_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateResponse.ProtoReflect.Descriptor instead.
+func (*GetStateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetStateResponse) GetSnapshot() *StateSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetNumValidators() uint64 {
+	if x != nil {
+		return x.NumValidators
+	}
+	return 0
+}
+
+func (x *GetStateResponse) GetGenesisTime() uint64 {
+	if x != nil {
+		return x.GenesisTime
+	}
+	return 0
+}
+
+func (x *GetStateResponse) GetLatestBlockHeader() *BlockHeader {
+	if x != nil {
+		return x.LatestBlockHeader
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetHistoricalBlockHashes() uint64 {
+	if x != nil {
+		return x.HistoricalBlockHashes
+	}
+	return 0
+}
+
+func (x *GetStateResponse) GetJustifiedSlots() string {
+	if x != nil {
+		return x.JustifiedSlots
+	}
+	return ""
+}
+
+func (x *GetStateResponse) GetJustifiedSlotsLength() uint64 {
+	if x != nil {
+		return x.JustifiedSlotsLength
+	}
+	return 0
+}
+
+func (x *GetStateResponse) GetJustifications() []*PendingJustification {
+	if x != nil {
+		return x.Justifications
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetMismatches() []*HashMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetUnindexedSlots() []uint64 {
+	if x != nil {
+		return x.UnindexedSlots
+	}
+	return nil
+}
+
+// GetStateSnapshotsRequest - highest slot first
+type GetStateSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Max snapshots to return (default: 50, max: 500)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotsRequest) Reset() {
+	*x = GetStateSnapshotsRequest{}
+	mi := &file_proto_api_v1_state_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotsRequest) ProtoMessage() {}
+
+func (x *GetStateSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_state_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetStateSnapshotsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetStateSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*StateSnapshot       `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotsResponse) Reset() {
+	*x = GetStateSnapshotsResponse{}
+	mi := &file_proto_api_v1_state_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotsResponse) ProtoMessage() {}
+
+func (x *GetStateSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_v1_state_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_v1_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetStateSnapshotsResponse) GetSnapshots() []*StateSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+var File_proto_api_v1_state_proto protoreflect.FileDescriptor
+
+const file_proto_api_v1_state_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/api/v1/state.proto\x12\x06api.v1\x1a\x18proto/api/v1/block.proto\"\xd2\x03\n" +
+	"\rStateSnapshot\x12\x1d\n" +
+	"\n" +
+	"state_root\x18\x01 \x01(\tR\tstateRoot\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x04R\x04slot\x12\x1d\n" +
+	"\n" +
+	"block_root\x18\x03 \x01(\tR\tblockRoot\x12\x16\n" +
+	"\x06client\x18\x04 \x01(\tR\x06client\x12%\n" +
+	"\x0ejustified_slot\x18\x05 \x01(\x04R\rjustifiedSlot\x12%\n" +
+	"\x0ejustified_root\x18\x06 \x01(\tR\rjustifiedRoot\x12%\n" +
+	"\x0efinalized_slot\x18\a \x01(\x04R\rfinalizedSlot\x12%\n" +
+	"\x0efinalized_root\x18\b \x01(\tR\rfinalizedRoot\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\t \x01(\x04R\tsizeBytes\x12%\n" +
+	"\x0echecked_hashes\x18\n" +
+	" \x01(\x04R\rcheckedHashes\x12+\n" +
+	"\x11mismatched_hashes\x18\v \x01(\x04R\x10mismatchedHashes\x12)\n" +
+	"\x10unindexed_hashes\x18\f \x01(\x04R\x0funindexedHashes\x12\x1d\n" +
+	"\n" +
+	"fetched_at\x18\r \x01(\x03R\tfetchedAt\"\x93\x01\n" +
+	"\x14PendingJustification\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x04R\x04slot\x12\x1d\n" +
+	"\n" +
+	"slot_known\x18\x03 \x01(\bR\tslotKnown\x12\x14\n" +
+	"\x05votes\x18\x04 \x01(\x04R\x05votes\x12\x1e\n" +
+	"\n" +
+	"validators\x18\x05 \x01(\tR\n" +
+	"validators\"z\n" +
+	"\fHashMismatch\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x04R\x04slot\x12(\n" +
+	"\x10state_block_root\x18\x02 \x01(\tR\x0estateBlockRoot\x12,\n" +
+	"\x12indexed_block_root\x18\x03 \x01(\tR\x10indexedBlockRoot\"Q\n" +
+	"\x0fGetStateRequest\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x04R\x04slot\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\x12\x16\n" +
+	"\x06client\x18\x03 \x01(\tR\x06client\"\x90\x04\n" +
+	"\x10GetStateResponse\x121\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x15.api.v1.StateSnapshotR\bsnapshot\x12%\n" +
+	"\x0enum_validators\x18\x02 \x01(\x04R\rnumValidators\x12!\n" +
+	"\fgenesis_time\x18\x03 \x01(\x04R\vgenesisTime\x12C\n" +
+	"\x13latest_block_header\x18\x04 \x01(\v2\x13.api.v1.BlockHeaderR\x11latestBlockHeader\x126\n" +
+	"\x17historical_block_hashes\x18\x05 \x01(\x04R\x15historicalBlockHashes\x12'\n" +
+	"\x0fjustified_slots\x18\x06 \x01(\tR\x0ejustifiedSlots\x124\n" +
+	"\x16justified_slots_length\x18\a \x01(\x04R\x14justifiedSlotsLength\x12D\n" +
+	"\x0ejustifications\x18\b \x03(\v2\x1c.api.v1.PendingJustificationR\x0ejustifications\x124\n" +
+	"\n" +
+	"mismatches\x18\t \x03(\v2\x14.api.v1.HashMismatchR\n" +
+	"mismatches\x12'\n" +
+	"\x0funindexed_slots\x18\n" +
+	" \x03(\x04R\x0eunindexedSlots\"0\n" +
+	"\x18GetStateSnapshotsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\"P\n" +
+	"\x19GetStateSnapshotsResponse\x123\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x15.api.v1.StateSnapshotR\tsnapshots2\xa7\x01\n" +
+	"\fStateService\x12=\n" +
+	"\bGetState\x12\x17.api.v1.GetStateRequest\x1a\x18.api.v1.GetStateResponse\x12X\n" +
+	"\x11GetStateSnapshots\x12 .api.v1.GetStateSnapshotsRequest\x1a!.api.v1.GetStateSnapshotsResponseB;Z9github.com/syjn99/leanView/backend/gen/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_proto_api_v1_state_proto_rawDescOnce sync.Once
+	file_proto_api_v1_state_proto_rawDescData []byte
+)
+
+func file_proto_api_v1_state_proto_rawDescGZIP() []byte {
+	file_proto_api_v1_state_proto_rawDescOnce.Do(func() {
+		file_proto_api_v1_state_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_v1_state_proto_rawDesc), len(file_proto_api_v1_state_proto_rawDesc)))
+	})
+	return file_proto_api_v1_state_proto_rawDescData
+}
+
+var file_proto_api_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_api_v1_state_proto_goTypes = []any{
+	(*StateSnapshot)(nil),             // 0: api.v1.StateSnapshot
+	(*PendingJustification)(nil),      // 1: api.v1.PendingJustification
+	(*HashMismatch)(nil),              // 2: api.v1.HashMismatch
+	(*GetStateRequest)(nil),           // 3: api.v1.GetStateRequest
+	(*GetStateResponse)(nil),          // 4: api.v1.GetStateResponse
+	(*GetStateSnapshotsRequest)(nil),  // 5: api.v1.GetStateSnapshotsRequest
+	(*GetStateSnapshotsResponse)(nil), // 6: api.v1.GetStateSnapshotsResponse
+	(*BlockHeader)(nil),               // 7: api.v1.BlockHeader
+}
+var file_proto_api_v1_state_proto_depIdxs = []int32{
+	0, // 0: api.v1.GetStateResponse.snapshot:type_name -> api.v1.StateSnapshot
+	7, // 1: api.v1.GetStateResponse.latest_block_header:type_name -> api.v1.BlockHeader
+	1, // 2: api.v1.GetStateResponse.justifications:type_name -> api.v1.PendingJustification
+	2, // 3: api.v1.GetStateResponse.mismatches:type_name -> api.v1.HashMismatch
+	0, // 4: api.v1.GetStateSnapshotsResponse.snapshots:type_name -> api.v1.StateSnapshot
+	3, // 5: api.v1.StateService.GetState:input_type -> api.v1.GetStateRequest
+	5, // 6: api.v1.StateService.GetStateSnapshots:input_type -> api.v1.GetStateSnapshotsRequest
+	4, // 7: api.v1.StateService.GetState:output_type -> api.v1.GetStateResponse
+	6, // 8: api.v1.StateService.GetStateSnapshots:output_type -> api.v1.GetStateSnapshotsResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_v1_state_proto_init() }
+func file_proto_api_v1_state_proto_init() {
+	if File_proto_api_v1_state_proto != nil {
+		return
+	}
+	file_proto_api_v1_block_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_v1_state_proto_rawDesc), len(file_proto_api_v1_state_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_v1_state_proto_goTypes,
+		DependencyIndexes: file_proto_api_v1_state_proto_depIdxs,
+		MessageInfos:      file_proto_api_v1_state_proto_msgTypes,
+	}.Build()
+	File_proto_api_v1_state_proto = out.File
+	file_proto_api_v1_state_proto_goTypes = nil
+	file_proto_api_v1_state_proto_depIdxs = nil
+}
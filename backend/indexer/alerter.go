@@ -0,0 +1,100 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Alert is a finding that needs an operator's attention, posted as JSON to the webhook
+type Alert struct {
+	Kind    string         `json:"kind"`
+	Message string         `json:"message"`
+	Time    time.Time      `json:"time"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// Alerter logs alerts as errors and posts them to a webhook if one is configured
+type Alerter struct {
+	webhook    string // Guarded by mutex, replaced on config reload
+	mutex      sync.RWMutex
+	httpClient *http.Client
+	clock      Clock
+	logger     logrus.FieldLogger
+}
+
+// NewAlerter creates an alerter, alerts are only logged if the webhook is empty
+func NewAlerter(webhook string, timeout time.Duration, clock Clock, logger logrus.FieldLogger) *Alerter {
+	return &Alerter{
+		webhook:    webhook,
+		httpClient: &http.Client{Timeout: timeout},
+		clock:      clock,
+		logger:     logger.WithField("component", "alerter"),
+	}
+}
+
+// SetWebhook replaces the webhook alerts are posted to, an empty webhook only logs them
+func (a *Alerter) SetWebhook(webhook string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if webhook == a.webhook {
+		return
+	}
+	a.webhook = webhook
+	a.logger.WithField("enabled", webhook != "").Info("Alert webhook changed")
+}
+
+// Send logs the alert and posts it to the webhook in the background
+func (a *Alerter) Send(kind, message string, details map[string]any) {
+	alert := &Alert{
+		Kind:    kind,
+		Message: message,
+		Time:    a.clock.Now(),
+		Details: details,
+	}
+	a.logger.WithFields(logrus.Fields(details)).WithField("kind", kind).Error(message)
+
+	a.mutex.RLock()
+	webhook := a.webhook
+	a.mutex.RUnlock()
+	if webhook == "" {
+		return
+	}
+	go func() {
+		if err := a.post(webhook, alert); err != nil {
+			a.logger.WithError(err).WithField("kind", kind).Warn("Failed to post alert to webhook")
+		}
+	}()
+}
+
+// post delivers an alert to the webhook
+func (a *Alerter) post(webhook string, alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := a.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", response.StatusCode)
+	}
+	return nil
+}
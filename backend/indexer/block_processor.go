@@ -2,7 +2,9 @@ package indexer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
@@ -10,28 +12,44 @@ import (
 	"github.com/syjn99/leanView/backend/types"
 )
 
+// voteIngestSlots is how many slots at the end of a catchup range have their votes ingested
+const voteIngestSlots = 64
+
+// errNoHealthyClients is returned when no client can serve a catchup range
+var errNoHealthyClients = errors.New("no healthy clients available for block range processing")
+
 // BlockProcessor handles the core block processing logic
 type BlockProcessor struct {
 	// Configuration
-	maxRetries int
+	maxRetries          int
+	quorumSize          int  // Clients each catchup header is fetched from, below 2 trusts one client
+	quorumFinalizedOnly bool // Only apply the quorum to finalized ranges
 
 	// Head cache for chain state tracking
 	headCache *HeadCache
 
+	// Checks ingested votes for double and surround votes
+	equivocations *EquivocationDetector
+
+	clock  Clock
 	logger logrus.FieldLogger
 }
 
 // NewBlockProcessor creates a new block processor
-func NewBlockProcessor(headCache *HeadCache, logger logrus.FieldLogger) *BlockProcessor {
+func NewBlockProcessor(headCache *HeadCache, equivocations *EquivocationDetector, config *types.IndexerConfig, clock Clock, logger logrus.FieldLogger) *BlockProcessor {
 	return &BlockProcessor{
-		maxRetries: defaultMaxRetries,
-		headCache:  headCache,
-		logger:     logger.WithField("component", "block_processor"),
+		maxRetries:          config.MaxRetries,
+		quorumSize:          config.QuorumSize,
+		quorumFinalizedOnly: config.QuorumFinalizedOnly,
+		headCache:           headCache,
+		equivocations:       equivocations,
+		clock:               clock,
+		logger:              logger.WithField("component", "block_processor"),
 	}
 }
 
-// ProcessBlock handles processing a single detected block
-func (bp *BlockProcessor) ProcessBlock(ctx context.Context, block *types.BlockHeader) error {
+// ProcessBlock handles processing a single detected block served by the client
+func (bp *BlockProcessor) ProcessBlock(ctx context.Context, client *Client, block *types.BlockHeader) error {
 	bp.logger.WithFields(logrus.Fields{
 		"slot":           block.Slot,
 		"proposer_index": block.ProposerIndex,
@@ -44,7 +62,7 @@ func (bp *BlockProcessor) ProcessBlock(ctx context.Context, block *types.BlockHe
 
 	// Store the block in the database
 	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
-		return db.InsertBlockHeader(block, tx)
+		return db.InsertBlockHeader(block, client.GetConfig().Name, tx)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to store block for slot %d: %w", block.Slot, err)
@@ -57,7 +75,7 @@ func (bp *BlockProcessor) ProcessBlock(ctx context.Context, block *types.BlockHe
 	cacheStats := bp.headCache.GetCacheStats()
 	bp.logger.WithFields(logrus.Fields{
 		"slot":                block.Slot,
-		"cache_recent_blocks": cacheStats.RecentBlocksCount,
+		"cache_tree_blocks":   cacheStats.TreeBlocksCount,
 		"cache_has_head":      cacheStats.HasCurrentHead,
 		"cache_head_slot":     cacheStats.CurrentHeadSlot,
 		"cache_has_justified": cacheStats.HasJustified,
@@ -68,6 +86,30 @@ func (bp *BlockProcessor) ProcessBlock(ctx context.Context, block *types.BlockHe
 	return nil
 }
 
+// IngestVotes fetches the full blocks of the given headers from the client, records their
+// votes for fork choice and checks them for equivocations. Fetching stops at the first failure, e.g. an endpoint without the
+// blocks API.
+func (bp *BlockProcessor) IngestVotes(ctx context.Context, client *Client, blocks ...*types.BlockHeader) {
+	for _, block := range blocks {
+		root, err := block.HashTreeRoot()
+		if err != nil {
+			bp.logger.WithError(err).WithField("slot", block.Slot).Warn("Failed to calculate block root for votes")
+			continue
+		}
+
+		signedBlock, err := client.GetSignedBlock(ctx, root[:])
+		if err != nil {
+			bp.logger.WithError(err).WithFields(logrus.Fields{
+				"slot":   block.Slot,
+				"client": client.GetConfig().Name,
+			}).Debug("Failed to fetch block votes")
+			return
+		}
+		bp.headCache.AddVotes(signedBlock.Message.Body.Votes)
+		bp.equivocations.ObserveVotes(client.GetConfig().Name, signedBlock.Message.Body.Votes)
+	}
+}
+
 // validateBlockHeader performs basic validation on block header
 func (bp *BlockProcessor) validateBlockHeader(block *types.BlockHeader) error {
 	// Check that slot is reasonable (not zero, not too far in future)
@@ -126,16 +168,17 @@ func (bp *BlockProcessor) ProcessBlockRange(ctx context.Context, clientPool *Cli
 		"gap_size":   endSlot - startSlot + 1,
 	}).Info("Processing block range for catchup")
 
-	// Get a healthy client from the pool
-	client := clientPool.GetHealthyClient()
-	if client == nil {
-		return fmt.Errorf("no healthy clients available for block range processing")
+	// Make sure some client can serve the range before fetching batches
+	if clientPool.GetHealthyClientCount() == 0 {
+		return errNoHealthyClients
 	}
 
 	// Process in batches to avoid memory issues and provide better progress tracking
 	const batchSize = 20
 	totalProcessed := 0
 	var allBlocks []*types.BlockHeader
+	var allClients []string // Client each block was fetched from
+	var stopped *catchupStoppedError
 
 	for currentSlot := startSlot; currentSlot <= endSlot; {
 		batchEnd := currentSlot + batchSize - 1
@@ -148,23 +191,33 @@ func (bp *BlockProcessor) ProcessBlockRange(ctx context.Context, clientPool *Cli
 			"batch_end":   batchEnd,
 		}).Debug("Fetching batch of blocks")
 
-		// Fetch blocks for this batch
-		blocks, err := client.GetBlockRange(ctx, currentSlot, batchEnd)
-		if err != nil {
-			// Try with a different client if available
-			if newClient := clientPool.GetHealthyClient(); newClient != nil && newClient != client {
-				client = newClient
-				blocks, err = client.GetBlockRange(ctx, currentSlot, batchEnd)
-			}
-			if err != nil {
-				bp.logger.WithError(err).WithFields(logrus.Fields{
-					"batch_start": currentSlot,
-					"batch_end":   batchEnd,
-				}).Error("Failed to fetch block range batch")
-				// Continue with next batch even if this one fails
-				currentSlot = batchEnd + 1
-				continue
+		// Fetch blocks for this batch from a quorum of clients or a single one
+		var blocks []*types.BlockHeader
+		var client *Client
+		var err error
+		if bp.quorumApplies(batchEnd) {
+			blocks, client, err = bp.fetchQuorumRange(ctx, clientPool, currentSlot, batchEnd)
+		} else {
+			blocks, client, err = bp.fetchRange(ctx, clientPool, currentSlot, batchEnd)
+		}
+		if errors.Is(err, errNoHealthyClients) {
+			return err
+		}
+
+		// Without a quorum the blocks before the slot are kept and catchup stops there, the
+		// caller retries from it instead of leaving a gap
+		if errors.As(err, &stopped) {
+			if len(blocks) == 0 {
+				break
 			}
+		} else if err != nil {
+			bp.logger.WithError(err).WithFields(logrus.Fields{
+				"batch_start": currentSlot,
+				"batch_end":   batchEnd,
+			}).Error("Failed to fetch block range batch")
+			// Continue with next batch even if this one fails
+			currentSlot = batchEnd + 1
+			continue
 		}
 
 		// Validate blocks
@@ -179,33 +232,43 @@ func (bp *BlockProcessor) ProcessBlockRange(ctx context.Context, clientPool *Cli
 
 		if len(validBlocks) > 0 {
 			allBlocks = append(allBlocks, validBlocks...)
+			allClients = append(allClients, slices.Repeat([]string{client.GetConfig().Name}, len(validBlocks))...)
 			totalProcessed += len(validBlocks)
+
+			// Only the latest votes count for fork choice, so older batches are skipped
+			if batchEnd+voteIngestSlots > endSlot {
+				bp.IngestVotes(ctx, client, validBlocks...)
+			}
 		}
 
 		bp.logger.WithFields(logrus.Fields{
 			"batch_start":    currentSlot,
 			"batch_end":      batchEnd,
+			"client":         client.GetConfig().Name,
 			"blocks_fetched": len(blocks),
 			"valid_blocks":   len(validBlocks),
 		}).Debug("Processed batch of blocks")
 
+		if stopped != nil {
+			break
+		}
 		currentSlot = batchEnd + 1
 	}
 
 	// Store all blocks in a single transaction for efficiency
 	if len(allBlocks) > 0 {
 		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
-			return db.InsertBlockHeaderBatch(allBlocks, tx)
+			return db.InsertBlockHeaderBatch(allBlocks, allClients, tx)
 		})
 		if err != nil {
 			return fmt.Errorf("failed to store catchup blocks: %w", err)
 		}
 
-		// Update head cache with the latest block from the range
-		if len(allBlocks) > 0 {
-			latestBlock := allBlocks[len(allBlocks)-1]
-			bp.headCache.UpdateHead(latestBlock)
+		// Add the range to the block tree and update the head with the latest block
+		for _, block := range allBlocks[:len(allBlocks)-1] {
+			bp.headCache.AddBlock(block)
 		}
+		bp.headCache.UpdateHead(allBlocks[len(allBlocks)-1])
 	}
 
 	bp.logger.WithFields(logrus.Fields{
@@ -215,5 +278,29 @@ func (bp *BlockProcessor) ProcessBlockRange(ctx context.Context, clientPool *Cli
 		"blocks_stored":   len(allBlocks),
 	}).Info("Completed block range processing")
 
+	if stopped != nil {
+		return stopped
+	}
 	return nil
 }
+
+// fetchRange fetches a range of blocks from a single client, spreading ranges over the
+// pool and retrying once with another client. The client that served the range is returned.
+func (bp *BlockProcessor) fetchRange(ctx context.Context, clientPool *ClientPool, startSlot, endSlot uint64) ([]*types.BlockHeader, *Client, error) {
+	client := clientPool.GetBulkClient()
+	if client == nil {
+		return nil, nil, errNoHealthyClients
+	}
+	blocks, err := client.GetBlockRange(ctx, startSlot, endSlot)
+	if err != nil {
+		// Try with a different client if available
+		if newClient := clientPool.GetBulkClient(); newClient != nil && newClient != client {
+			client = newClient
+			blocks, err = client.GetBlockRange(ctx, startSlot, endSlot)
+		}
+		if err != nil {
+			return nil, client, fmt.Errorf("failed to fetch blocks from %s: %w", client.GetConfig().Name, err)
+		}
+	}
+	return blocks, client, nil
+}
@@ -0,0 +1,311 @@
+package indexer
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+const (
+	// MaxTreeBlocks bounds the block tree while finality stalls, the lowest slots are pruned first
+	MaxTreeBlocks = 4096
+)
+
+// blockTreeNode is a block in the tree with links to its parent and children
+type blockTreeNode struct {
+	root     [32]byte
+	header   *types.BlockHeader
+	parent   *blockTreeNode // Nil if the parent is not in the tree
+	children []*blockTreeNode
+}
+
+// blockTree holds the blocks since the latest finalized checkpoint indexed by root, and
+// the latest vote of every validator for LMD-GHOST. It is not safe for concurrent use,
+// the head cache serializes access.
+type blockTree struct {
+	nodes map[[32]byte]*blockTreeNode
+
+	// Blocks whose parent is not in the tree by parent root, adopted when the parent arrives
+	orphans map[[32]byte][]*blockTreeNode
+
+	// Finalized checkpoint every block in the tree descends from. The anchor block is nil
+	// until the finalized block itself is added.
+	anchorRoot [32]byte
+	anchorSlot uint64
+	anchor     *blockTreeNode
+
+	// Latest vote of each validator by vote slot
+	latestVotes map[uint64]*types.Vote
+}
+
+// newBlockTree creates an empty block tree
+func newBlockTree() *blockTree {
+	return &blockTree{
+		nodes:       make(map[[32]byte]*blockTreeNode),
+		orphans:     make(map[[32]byte][]*blockTreeNode),
+		latestVotes: make(map[uint64]*types.Vote),
+	}
+}
+
+// add inserts a block, linking it to its parent and to children added before it. Blocks
+// at or below the finalized slot other than the finalized block are not added, add returns
+// nil for them.
+func (bt *blockTree) add(root [32]byte, header *types.BlockHeader) *blockTreeNode {
+	if node, ok := bt.nodes[root]; ok {
+		return node
+	}
+	isAnchor := root == bt.anchorRoot && bt.anchorSlot == header.Slot
+	if header.Slot <= bt.anchorSlot && !isAnchor {
+		return nil
+	}
+
+	node := &blockTreeNode{root: root, header: header}
+	if isAnchor {
+		bt.anchor = node
+	} else if parent, ok := bt.nodes[[32]byte(header.ParentRoot)]; ok {
+		node.parent = parent
+		parent.children = append(parent.children, node)
+	} else {
+		bt.addOrphan(node)
+	}
+
+	// Adopt blocks that arrived before their parent, e.g. a head polled before catchup
+	for _, orphan := range bt.orphans[root] {
+		orphan.parent = node
+		node.children = append(node.children, orphan)
+	}
+	delete(bt.orphans, root)
+
+	bt.nodes[root] = node
+	if len(bt.nodes) > MaxTreeBlocks {
+		bt.pruneLowest()
+	}
+	return node
+}
+
+// addOrphan records a block whose parent is not in the tree
+func (bt *blockTree) addOrphan(node *blockTreeNode) {
+	parentRoot := [32]byte(node.header.ParentRoot)
+	bt.orphans[parentRoot] = append(bt.orphans[parentRoot], node)
+}
+
+// removeOrphan forgets a block recorded by addOrphan
+func (bt *blockTree) removeOrphan(node *blockTreeNode) {
+	parentRoot := [32]byte(node.header.ParentRoot)
+	if orphans := removeNode(bt.orphans[parentRoot], node); len(orphans) > 0 {
+		bt.orphans[parentRoot] = orphans
+	} else {
+		delete(bt.orphans, parentRoot)
+	}
+}
+
+// get returns the block with the root, nil if it is not in the tree
+func (bt *blockTree) get(root [32]byte) *blockTreeNode {
+	return bt.nodes[root]
+}
+
+// isAncestor reports whether ancestor is descendant or one of its ancestors in the tree
+func (bt *blockTree) isAncestor(ancestor, descendant *blockTreeNode) bool {
+	for current := descendant; current != nil; current = current.parent {
+		if current == ancestor {
+			return true
+		}
+		if current.header.Slot < ancestor.header.Slot {
+			return false
+		}
+	}
+	return false
+}
+
+// setAnchor anchors the tree at a new finalized checkpoint. Blocks that do not descend from
+// the finalized block are pruned, blocks above it whose ancestry is unknown are kept since
+// their parents may still arrive.
+func (bt *blockTree) setAnchor(checkpoint *types.Checkpoint) {
+	if len(checkpoint.Root) != 32 {
+		return
+	}
+	root := [32]byte(checkpoint.Root)
+	if root == bt.anchorRoot && checkpoint.Slot == bt.anchorSlot {
+		return
+	}
+	bt.anchorRoot = root
+	bt.anchorSlot = checkpoint.Slot
+	bt.anchor = bt.nodes[root]
+
+	keep := make(map[[32]byte]*blockTreeNode, len(bt.nodes))
+	var visit func(node *blockTreeNode)
+	visit = func(node *blockTreeNode) {
+		keep[node.root] = node
+		for _, child := range node.children {
+			visit(child)
+		}
+	}
+	if bt.anchor != nil {
+		bt.anchor.parent = nil
+		visit(bt.anchor)
+	}
+	for _, node := range bt.nodes {
+		if node.parent == nil && node != bt.anchor && node.header.Slot > checkpoint.Slot {
+			visit(node)
+		}
+	}
+
+	// Drop links to pruned blocks
+	for _, node := range keep {
+		if node.parent != nil && keep[node.parent.root] == nil {
+			node.parent = nil
+		}
+		children := node.children[:0]
+		for _, child := range node.children {
+			if keep[child.root] != nil {
+				children = append(children, child)
+			}
+		}
+		node.children = children
+	}
+	bt.nodes = keep
+
+	// The anchor is never adopted, other kept blocks without a parent wait for it
+	bt.orphans = make(map[[32]byte][]*blockTreeNode)
+	for _, node := range keep {
+		if node.parent == nil && node != bt.anchor {
+			bt.addOrphan(node)
+		}
+	}
+}
+
+// pruneLowest removes the block with the lowest slot, its children lose their parent link
+func (bt *blockTree) pruneLowest() {
+	var lowest *blockTreeNode
+	for _, node := range bt.nodes {
+		if node != bt.anchor && (lowest == nil || node.header.Slot < lowest.header.Slot) {
+			lowest = node
+		}
+	}
+	if lowest == nil {
+		return
+	}
+
+	for _, child := range lowest.children {
+		child.parent = nil
+		bt.addOrphan(child)
+	}
+	if parent := lowest.parent; parent != nil {
+		parent.children = removeNode(parent.children, lowest)
+	} else {
+		bt.removeOrphan(lowest)
+	}
+	delete(bt.nodes, lowest.root)
+}
+
+// addVote records a vote if it is the validator's latest, reporting whether it was recorded
+func (bt *blockTree) addVote(vote *types.Vote) bool {
+	if vote == nil || vote.Head == nil || len(vote.Head.Root) != 32 {
+		return false
+	}
+	if latest, ok := bt.latestVotes[vote.ValidatorId]; ok && latest.Slot >= vote.Slot {
+		return false
+	}
+	bt.latestVotes[vote.ValidatorId] = vote
+	return true
+}
+
+// weights returns the number of latest votes whose head is each block or one of its descendants
+func (bt *blockTree) weights() map[*blockTreeNode]uint64 {
+	weights := make(map[*blockTreeNode]uint64, len(bt.nodes))
+	for _, vote := range bt.latestVotes {
+		for node := bt.nodes[[32]byte(vote.Head.Root)]; node != nil; node = node.parent {
+			weights[node]++
+		}
+	}
+	return weights
+}
+
+// head runs LMD-GHOST from the start block: it descends into the child with the most weight,
+// ties going to the higher root as in 3SF-mini
+func (bt *blockTree) head(start *blockTreeNode, weights map[*blockTreeNode]uint64) *blockTreeNode {
+	current := start
+	for len(current.children) > 0 {
+		best := current.children[0]
+		for _, child := range current.children[1:] {
+			if weights[child] > weights[best] ||
+				(weights[child] == weights[best] && bytes.Compare(child.root[:], best.root[:]) > 0) {
+				best = child
+			}
+		}
+		current = best
+	}
+	return current
+}
+
+// longestPath returns the descendants of the node leading to the block with the highest slot,
+// ordered from the node's child to the tip. Ties go to the child with the higher root.
+func (bt *blockTree) longestPath(node *blockTreeNode) []*blockTreeNode {
+	tip := bt.highestTip(node)
+
+	var path []*blockTreeNode
+	for current := tip; current != node; current = current.parent {
+		path = append(path, current)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// highestTip returns the highest slot block descending from the node, or the node itself if
+// it has no children. Ties go to the child with the higher root.
+func (bt *blockTree) highestTip(node *blockTreeNode) *blockTreeNode {
+	tip := node
+	var bestChild *blockTreeNode
+	for _, child := range node.children {
+		childTip := bt.highestTip(child)
+		if bestChild == nil || childTip.header.Slot > tip.header.Slot ||
+			(childTip.header.Slot == tip.header.Slot && bytes.Compare(child.root[:], bestChild.root[:]) > 0) {
+			tip, bestChild = childTip, child
+		}
+	}
+	return tip
+}
+
+// root returns the block LMD-GHOST starts from when the justified block is not in the tree:
+// the anchor, or else the lowest block in the tree
+func (bt *blockTree) root() *blockTreeNode {
+	if bt.anchor != nil {
+		return bt.anchor
+	}
+
+	var lowest *blockTreeNode
+	for _, node := range bt.nodes {
+		if lowest == nil || node.header.Slot < lowest.header.Slot ||
+			(node.header.Slot == lowest.header.Slot && bytes.Compare(node.root[:], lowest.root[:]) > 0) {
+			lowest = node
+		}
+	}
+	return lowest
+}
+
+// sortedNodes returns the blocks ordered by slot, so parents come before their children
+func (bt *blockTree) sortedNodes() []*blockTreeNode {
+	nodes := make([]*blockTreeNode, 0, len(bt.nodes))
+	for _, node := range bt.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].header.Slot != nodes[j].header.Slot {
+			return nodes[i].header.Slot < nodes[j].header.Slot
+		}
+		return bytes.Compare(nodes[i].root[:], nodes[j].root[:]) < 0
+	})
+	return nodes
+}
+
+// removeNode returns nodes without the given node
+func removeNode(nodes []*blockTreeNode, removed *blockTreeNode) []*blockTreeNode {
+	for i, node := range nodes {
+		if node == removed {
+			return append(nodes[:i], nodes[i+1:]...)
+		}
+	}
+	return nodes
+}
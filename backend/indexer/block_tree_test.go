@@ -0,0 +1,225 @@
+package indexer
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// childHeader is a block at the slot building on the parent, or on an unknown block if the
+// parent is nil. The proposer tells apart blocks of a slot.
+func childHeader(t *testing.T, slot, proposer uint64, parent *types.BlockHeader) *types.BlockHeader {
+	t.Helper()
+
+	header := &types.BlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposer,
+		ParentRoot:    bytes.Repeat([]byte{0xee}, 32),
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      make([]byte, 32),
+	}
+	if parent != nil {
+		root, err := parent.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("hashing block at slot %d: %v", parent.Slot, err)
+		}
+		header.ParentRoot = root[:]
+	}
+	return header
+}
+
+// addTestBlock adds a childHeader building on the parent node and returns what the tree added
+func addTestBlock(t *testing.T, tree *blockTree, slot, proposer uint64, parent *blockTreeNode) *blockTreeNode {
+	t.Helper()
+
+	var parentHeader *types.BlockHeader
+	if parent != nil {
+		parentHeader = parent.header
+	}
+	header := childHeader(t, slot, proposer, parentHeader)
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("hashing block at slot %d: %v", slot, err)
+	}
+	return tree.add(root, header)
+}
+
+// voteFor is a vote of the validator with the block as head
+func voteFor(validator, slot uint64, head *blockTreeNode) *types.Vote {
+	return &types.Vote{ValidatorId: validator, Slot: slot, Head: &types.Checkpoint{Root: head.root[:], Slot: head.header.Slot}}
+}
+
+func TestBlockTreeHead(t *testing.T) {
+	tree := newBlockTree()
+	first := addTestBlock(t, tree, 1, 0, nil)
+	a := addTestBlock(t, tree, 2, 1, first)
+	b := addTestBlock(t, tree, 3, 2, a)
+	c := addTestBlock(t, tree, 3, 3, a)
+	d := addTestBlock(t, tree, 4, 3, c)
+
+	// Without votes ties go to the higher root at every level
+	higher := b
+	if bytes.Compare(c.root[:], b.root[:]) > 0 {
+		higher = d
+	}
+	if head := tree.head(first, tree.weights()); head != higher {
+		t.Errorf("head without votes is at slot %d, want the branch with the higher root", head.header.Slot)
+	}
+
+	// The branch with more latest votes wins even if it is shorter
+	tree.addVote(voteFor(0, 4, b))
+	tree.addVote(voteFor(1, 4, b))
+	tree.addVote(voteFor(2, 4, d))
+	weights := tree.weights()
+	if weights[a] != 3 || weights[b] != 2 || weights[c] != 1 || weights[d] != 1 {
+		t.Errorf("weights a=%d b=%d c=%d d=%d, want 3, 2, 1, 1", weights[a], weights[b], weights[c], weights[d])
+	}
+	if head := tree.head(first, weights); head != b {
+		t.Errorf("head is at slot %d, want the block with two votes", head.header.Slot)
+	}
+
+	// Only the latest vote of a validator counts
+	if tree.addVote(voteFor(0, 3, d)) {
+		t.Errorf("an older vote replaced the latest vote")
+	}
+	tree.addVote(voteFor(0, 5, d))
+	tree.addVote(voteFor(1, 5, d))
+	if head := tree.head(first, tree.weights()); head != d {
+		t.Errorf("head is at slot %d after the votes moved, want the block at slot 4", head.header.Slot)
+	}
+
+	// LMD-GHOST only descends from the start block
+	if head := tree.head(b, tree.weights()); head != b {
+		t.Errorf("head from a leaf is at slot %d, want the leaf", head.header.Slot)
+	}
+}
+
+func TestBlockTreeSetAnchor(t *testing.T) {
+	tree := newBlockTree()
+	first := addTestBlock(t, tree, 1, 0, nil)
+	a := addTestBlock(t, tree, 2, 1, first)
+	sibling := addTestBlock(t, tree, 2, 2, first)
+	siblingChild := addTestBlock(t, tree, 3, 2, sibling)
+	b := addTestBlock(t, tree, 3, 1, a)
+	orphan := addTestBlock(t, tree, 6, 1, nil)
+	staleOrphan := addTestBlock(t, tree, 2, 3, nil)
+
+	tree.setAnchor(&types.Checkpoint{Root: a.root[:], Slot: a.header.Slot})
+
+	if tree.anchor != a || a.parent != nil {
+		t.Fatalf("anchor is not the finalized block or keeps its parent")
+	}
+	for name, node := range map[string]*blockTreeNode{"first": first, "sibling": sibling, "sibling child": siblingChild, "stale orphan": staleOrphan} {
+		if tree.get(node.root) != nil {
+			t.Errorf("%s is kept after finalization", name)
+		}
+	}
+	for name, node := range map[string]*blockTreeNode{"anchor": a, "child": b, "orphan above the anchor": orphan} {
+		if tree.get(node.root) == nil {
+			t.Errorf("%s is pruned after finalization", name)
+		}
+	}
+	if len(a.children) != 1 || a.children[0] != b {
+		t.Errorf("anchor has %d children, want its descendant", len(a.children))
+	}
+
+	// Blocks at or below the finalized slot are not added, their descendants are
+	if addTestBlock(t, tree, 2, 4, nil) != nil {
+		t.Errorf("a block at the finalized slot was added")
+	}
+	if child := addTestBlock(t, tree, 4, 1, b); child == nil || child.parent != b {
+		t.Errorf("a descendant of the anchor was not linked to its parent")
+	}
+
+	// A finalized block that arrives later becomes the anchor
+	tree = newBlockTree()
+	tree.setAnchor(&types.Checkpoint{Root: a.root[:], Slot: a.header.Slot})
+	child := addTestBlock(t, tree, 3, 1, a)
+	anchor := tree.add(a.root, a.header)
+	if tree.anchor != anchor || child.parent != anchor || tree.root() != anchor {
+		t.Errorf("finalized block added after its child is not the anchor of the tree")
+	}
+}
+
+func TestBlockTreeLongestPath(t *testing.T) {
+	tree := newBlockTree()
+	first := addTestBlock(t, tree, 1, 0, nil)
+	a := addTestBlock(t, tree, 2, 1, first)
+	b := addTestBlock(t, tree, 5, 1, a)
+	c := addTestBlock(t, tree, 2, 2, first)
+	d := addTestBlock(t, tree, 3, 2, c)
+	e := addTestBlock(t, tree, 6, 2, d)
+
+	// A child arriving before its parent is adopted once the parent is added
+	header := childHeader(t, 7, 2, e.header)
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("hashing block at slot 7: %v", err)
+	}
+	tree = newBlockTree()
+	for _, node := range []*blockTreeNode{first, a, b, c, d} {
+		tree.add(node.root, node.header)
+	}
+	f := tree.add(root, header)
+	e = tree.add(e.root, e.header)
+	if f.parent != e || len(e.children) != 1 || len(tree.orphans) != 1 {
+		t.Fatalf("block at slot 7 was not adopted by its parent, %d blocks wait for a parent", len(tree.orphans))
+	}
+
+	path := tree.longestPath(tree.get(first.root))
+	var slots []uint64
+	for _, node := range path {
+		slots = append(slots, node.header.Slot)
+	}
+	if !slices.Equal(slots, []uint64{2, 3, 6, 7}) || path[0].root != c.root {
+		t.Errorf("longest path is at slots %v, want the branch at slots 2, 3, 6 and 7", slots)
+	}
+	if path := tree.longestPath(f); len(path) != 0 {
+		t.Errorf("longest path of a leaf has %d blocks", len(path))
+	}
+
+	// Tips at the same slot go to the child with the higher root
+	tree = newBlockTree()
+	first = addTestBlock(t, tree, 1, 0, nil)
+	a = addTestBlock(t, tree, 2, 1, first)
+	c = addTestBlock(t, tree, 2, 2, first)
+	higher := a
+	if bytes.Compare(c.root[:], a.root[:]) > 0 {
+		higher = c
+	}
+	if path := tree.longestPath(first); len(path) != 1 || path[0] != higher {
+		t.Errorf("tied tips did not go to the higher root")
+	}
+}
+
+func TestHeadCacheCountsReorgs(t *testing.T) {
+	headCache := NewHeadCache(logrus.New())
+	b1 := childHeader(t, 1, 1, nil)
+	b2 := childHeader(t, 2, 2, b1)
+	c3 := childHeader(t, 3, 3, b1)
+	c4 := childHeader(t, 4, 0, c3)
+	gap := childHeader(t, 9, 1, nil)
+
+	tests := []struct {
+		name             string
+		head             *types.BlockHeader
+		reorgs, unlinked uint64
+	}{
+		{"first head", b1, 0, 0},
+		{"child", b2, 0, 0},
+		{"sibling branch", c3, 1, 0},
+		{"branch extended", c4, 1, 0},
+		{"unknown ancestry", gap, 1, 1},
+	}
+	for _, test := range tests {
+		headCache.UpdateHead(test.head)
+		if reorgs, unlinked := headCache.GetReorgCount(), headCache.GetUnlinkedHeadChanges(); reorgs != test.reorgs || unlinked != test.unlinked {
+			t.Errorf("%s: counted %d reorgs and %d unlinked head changes, want %d and %d",
+				test.name, reorgs, unlinked, test.reorgs, test.unlinked)
+		}
+	}
+}
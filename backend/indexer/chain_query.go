@@ -0,0 +1,279 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Errors returned by chain queries
+var (
+	ErrUnknownBlock    = errors.New("block is neither in the block tree nor indexed")
+	ErrUnknownAncestry = errors.New("ancestry of block is unknown")
+)
+
+// Branch is the path from a block to the tip of its longest descendant chain, split at the
+// last block it shares with the indexed chain
+type Branch struct {
+	Base      *types.BlockHeader   // Last block on the indexed chain, nil if the ancestry is unknown
+	Blocks    []*types.BlockHeader // Blocks after the base up to the tip, ordered by slot
+	Canonical bool                 // The block itself is on the indexed chain
+}
+
+// Tip returns the highest block of the branch
+func (b *Branch) Tip() *types.BlockHeader {
+	if len(b.Blocks) > 0 {
+		return b.Blocks[len(b.Blocks)-1]
+	}
+	return b.Base
+}
+
+// chainBlock is a block resolved from the block tree or the database
+type chainBlock struct {
+	root    [32]byte
+	header  *types.BlockHeader
+	indexed bool // On the chain stored in the database
+}
+
+// ChainQuery answers ancestry questions about blocks. The block tree covers the blocks since
+// the finalized checkpoint including competing branches, the indexed chain in the database
+// covers everything older. Since the indexed chain is linear, ancestry within it is answered
+// by slot without walking block by block.
+type ChainQuery struct {
+	headCache *HeadCache
+}
+
+// NewChainQuery creates a chain query over the block tree of the head cache and the database
+func NewChainQuery(headCache *HeadCache) *ChainQuery {
+	return &ChainQuery{headCache: headCache}
+}
+
+// Block returns the block with the root from the block tree or the indexed chain
+func (cq *ChainQuery) Block(root []byte) (*types.BlockHeader, error) {
+	block, err := cq.resolve(root)
+	if err != nil {
+		return nil, err
+	}
+	return block.header, nil
+}
+
+// IsAncestor reports whether ancestor is descendant or one of its ancestors
+func (cq *ChainQuery) IsAncestor(ancestorRoot, descendantRoot []byte) (bool, error) {
+	ancestor, err := cq.resolve(ancestorRoot)
+	if err != nil {
+		return false, err
+	}
+	current, err := cq.resolve(descendantRoot)
+	if err != nil {
+		return false, err
+	}
+
+	for current.header.Slot > ancestor.header.Slot {
+		if current.indexed {
+			// Every ancestor of an indexed block is indexed
+			return ancestor.indexed, nil
+		}
+		if current, err = cq.parent(current); err != nil {
+			return false, err
+		}
+	}
+	return current.root == ancestor.root, nil
+}
+
+// CommonAncestor returns the latest block both blocks descend from, which is one of the
+// blocks itself if it is an ancestor of the other
+func (cq *ChainQuery) CommonAncestor(rootA, rootB []byte) (*types.BlockHeader, error) {
+	a, err := cq.resolve(rootA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := cq.resolve(rootB)
+	if err != nil {
+		return nil, err
+	}
+
+	for a.root != b.root {
+		if a.indexed && b.indexed {
+			if a.header.Slot < b.header.Slot {
+				return a.header, nil
+			}
+			return b.header, nil
+		}
+
+		// Step back the higher block, or both if they are on the same slot
+		slotA, slotB := a.header.Slot, b.header.Slot
+		switch {
+		case slotA == slotB && slotA == 0:
+			return nil, fmt.Errorf("%w: blocks descend from different genesis blocks", ErrUnknownAncestry)
+		case slotA == slotB:
+			if a, err = cq.ancestorAtSlot(a, slotA-1); err != nil {
+				return nil, err
+			}
+			if b, err = cq.ancestorAtSlot(b, slotB-1); err != nil {
+				return nil, err
+			}
+		case slotA > slotB:
+			if a, err = cq.ancestorAtSlot(a, slotB); err != nil {
+				return nil, err
+			}
+		default:
+			if b, err = cq.ancestorAtSlot(b, slotA); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return a.header, nil
+}
+
+// AncestorAtSlot returns the block at the slot on the chain of the head block. If the slot
+// is empty on that chain, the latest block before it is returned.
+func (cq *ChainQuery) AncestorAtSlot(headRoot []byte, slot uint64) (*types.BlockHeader, error) {
+	head, err := cq.resolve(headRoot)
+	if err != nil {
+		return nil, err
+	}
+	if head.header.Slot <= slot {
+		return head.header, nil
+	}
+
+	ancestor, err := cq.ancestorAtSlot(head, slot)
+	if err != nil {
+		return nil, err
+	}
+	return ancestor.header, nil
+}
+
+// BranchOf returns the branch of a block: its ancestors back to the indexed chain and its
+// descendants in the block tree up to the highest slot
+func (cq *ChainQuery) BranchOf(root []byte) (*Branch, error) {
+	block, err := cq.resolve(root)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := &Branch{Canonical: block.indexed}
+	current := block
+	for !current.indexed {
+		branch.Blocks = append([]*types.BlockHeader{current.header}, branch.Blocks...)
+		if current, err = cq.parent(current); errors.Is(err, ErrUnknownAncestry) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	if current != nil && current.indexed {
+		branch.Base = current.header
+	}
+
+	// Indexed descendants move the base forward, the branch only holds the blocks after it
+	for _, header := range cq.headCache.GetDescendantPath(block.root[:]) {
+		descendant, err := cq.newChainBlock(header)
+		if err != nil {
+			return nil, err
+		}
+		if descendant.indexed {
+			branch.Base = header
+			branch.Blocks = nil
+			continue
+		}
+		branch.Blocks = append(branch.Blocks, header)
+	}
+	return branch, nil
+}
+
+// ancestorAtSlot walks back from the block to the latest ancestor at or before the slot
+func (cq *ChainQuery) ancestorAtSlot(block *chainBlock, slot uint64) (*chainBlock, error) {
+	current := block
+	for current.header.Slot > slot {
+		if current.indexed {
+			// Every ancestor of an indexed block is indexed, look it up by slot
+			header, err := db.GetBlockHeaderBeforeSlot(slot + 1)
+			if err != nil {
+				return nil, err
+			}
+			if header == nil {
+				return nil, fmt.Errorf("%w: no block indexed at or before slot %d", ErrUnknownAncestry, slot)
+			}
+			return cq.newChainBlock(header)
+		}
+
+		var err error
+		if current, err = cq.parent(current); err != nil {
+			return nil, err
+		}
+	}
+	return current, nil
+}
+
+// parent returns the parent of the block from the block tree or the database
+func (cq *ChainQuery) parent(block *chainBlock) (*chainBlock, error) {
+	parent, err := cq.resolve(block.header.ParentRoot)
+	if errors.Is(err, ErrUnknownBlock) {
+		return nil, fmt.Errorf("%w: parent of block at slot %d not found", ErrUnknownAncestry, block.header.Slot)
+	}
+	return parent, err
+}
+
+// resolve looks up a block by root in the block tree, then in the database
+func (cq *ChainQuery) resolve(root []byte) (*chainBlock, error) {
+	if len(root) != 32 {
+		return nil, fmt.Errorf("invalid block root length %d", len(root))
+	}
+
+	if header := cq.headCache.GetBlock(root); header != nil {
+		return cq.newChainBlock(header)
+	}
+
+	// The database is keyed by slot, the block is found through its child or as the latest block
+	header, err := db.GetBlockHeaderByParentRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	if header != nil {
+		header, err = db.GetBlockHeaderBeforeSlot(header.Slot)
+	} else {
+		var latest []*types.BlockHeader
+		latest, err = db.GetLatestBlockHeaders(1)
+		if len(latest) > 0 {
+			header = latest[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if header != nil {
+		block, err := cq.newChainBlock(header)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(block.root[:], root) {
+			return block, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %#x", ErrUnknownBlock, root)
+}
+
+// newChainBlock computes the root of the block and whether it is on the indexed chain
+func (cq *ChainQuery) newChainBlock(header *types.BlockHeader) (*chainBlock, error) {
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate block root for slot %d: %w", header.Slot, err)
+	}
+
+	indexed, err := db.GetBlockHeaderBySlot(header.Slot)
+	if err != nil {
+		return nil, err
+	}
+	block := &chainBlock{root: root, header: header}
+	if indexed != nil {
+		indexedRoot, err := indexed.HashTreeRoot()
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate block root for slot %d: %w", indexed.Slot, err)
+		}
+		block.indexed = indexedRoot == root
+	}
+	return block, nil
+}
@@ -0,0 +1,106 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestChainQueryAcrossForks(t *testing.T) {
+	initTestDB(t)
+
+	// The indexed chain 1-2-3 continues on branch a at slots 4 and 5. Branch c skips slot 4
+	// and branch d forks off below the tip of the indexed chain.
+	b1 := childHeader(t, 1, 1, nil)
+	b2 := childHeader(t, 2, 2, b1)
+	b3 := childHeader(t, 3, 3, b2)
+	a4 := childHeader(t, 4, 4, b3)
+	a5 := childHeader(t, 5, 5, a4)
+	c5 := childHeader(t, 5, 6, b3)
+	c6 := childHeader(t, 6, 6, c5)
+	d3 := childHeader(t, 3, 7, b2)
+	d4 := childHeader(t, 4, 7, d3)
+	orphan := childHeader(t, 7, 7, nil)
+
+	if err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlockHeaderBatch([]*types.BlockHeader{b1, b2, b3}, make([]string, 3), tx)
+	}); err != nil {
+		t.Fatalf("storing indexed chain: %v", err)
+	}
+	headCache := NewHeadCache(logrus.New())
+	for _, header := range []*types.BlockHeader{a4, a5, c5, c6, d3, d4, orphan} {
+		headCache.AddBlock(header)
+	}
+	chainQuery := NewChainQuery(headCache)
+
+	root := func(header *types.BlockHeader) []byte {
+		root, err := header.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("hashing block at slot %d: %v", header.Slot, err)
+		}
+		return root[:]
+	}
+
+	commonAncestors := []struct {
+		name     string
+		a, b     *types.BlockHeader
+		expected *types.BlockHeader
+	}{
+		{"branches off the indexed tip", a5, c6, b3},
+		{"branch off below the indexed tip", d4, a5, b2},
+		{"indexed block and branch", b1, c6, b1},
+		{"block and its descendant", a4, a5, a4},
+		{"indexed blocks", b3, b2, b2},
+		{"same block", c5, c5, c5},
+	}
+	for _, test := range commonAncestors {
+		common, err := chainQuery.CommonAncestor(root(test.a), root(test.b))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(root(common), root(test.expected)) {
+			t.Errorf("%s: common ancestor is at slot %d, want slot %d", test.name, common.Slot, test.expected.Slot)
+		}
+	}
+
+	if _, err := chainQuery.CommonAncestor(root(orphan), root(a5)); !errors.Is(err, ErrUnknownAncestry) {
+		t.Errorf("common ancestor of a block with an unknown parent returned %v, want ErrUnknownAncestry", err)
+	}
+	if _, err := chainQuery.CommonAncestor(bytes.Repeat([]byte{0xaa}, 32), root(a5)); !errors.Is(err, ErrUnknownBlock) {
+		t.Errorf("common ancestor of an unknown block returned %v, want ErrUnknownBlock", err)
+	}
+
+	ancestries := []struct {
+		name                 string
+		ancestor, descendant *types.BlockHeader
+		expected             bool
+	}{
+		{"indexed block of a branch", b2, c6, true},
+		{"branch block of its descendant", c5, c6, true},
+		{"block of another branch", a4, c6, false},
+		{"indexed block off the branch", b3, d4, false},
+		{"descendant of its ancestor", a5, b3, false},
+	}
+	for _, test := range ancestries {
+		isAncestor, err := chainQuery.IsAncestor(root(test.ancestor), root(test.descendant))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if isAncestor != test.expected {
+			t.Errorf("%s: IsAncestor is %v, want %v", test.name, isAncestor, test.expected)
+		}
+	}
+
+	// A slot missed on a branch resolves to the latest block before it
+	if ancestor, err := chainQuery.AncestorAtSlot(root(c6), 4); err != nil || !bytes.Equal(root(ancestor), root(b3)) {
+		t.Errorf("ancestor of branch c at its missed slot is %v: %v, want the indexed tip", ancestor, err)
+	}
+}
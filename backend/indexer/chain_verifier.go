@@ -0,0 +1,360 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+const (
+	// chainVerifyBatchSize is how many stored headers are read from the database at a time
+	chainVerifyBatchSize = 512
+)
+
+// ChainVerifier checks that the stored block headers form a consistent chain: slots
+// increase, roots are 32 bytes, every header's parent root is the root of the previous
+// stored header, no two headers share a parent or state root and proposers follow the
+// round robin schedule. Issues are stored in the integrity_issues table. Ranges with
+// issues can be replaced with the headers a healthy client serves.
+type ChainVerifier struct {
+	clientPool     *ClientPool
+	validatorCount uint64 // 0 skips the proposer check
+	interval       time.Duration
+	refetch        bool
+	clock          Clock
+
+	lastReport *types.IntegrityReport
+
+	// Synchronization
+	isRunning   bool
+	ticker      Ticker
+	stopChannel chan bool
+	verifyMutex sync.Mutex // Serializes verification runs
+	mutex       sync.RWMutex
+
+	logger logrus.FieldLogger
+}
+
+// NewChainVerifier creates a verifier that runs every interval once started, refetching
+// ranges with issues if refetch is set
+func NewChainVerifier(clientPool *ClientPool, validatorCount uint64, interval time.Duration, refetch bool, clock Clock, logger logrus.FieldLogger) *ChainVerifier {
+	return &ChainVerifier{
+		clientPool:     clientPool,
+		validatorCount: validatorCount,
+		interval:       interval,
+		refetch:        refetch,
+		clock:          clock,
+		stopChannel:    make(chan bool, 1),
+		logger:         logger.WithField("component", "chain_verifier"),
+	}
+}
+
+// Start begins verifying the whole stored chain every interval
+func (cv *ChainVerifier) Start(ctx context.Context) error {
+	cv.mutex.Lock()
+	defer cv.mutex.Unlock()
+
+	if cv.isRunning {
+		return fmt.Errorf("chain verifier is already running")
+	}
+	cv.isRunning = true
+	cv.ticker = cv.clock.NewTicker(cv.interval)
+
+	go cv.verifyLoop(ctx, cv.ticker)
+
+	cv.logger.WithFields(logrus.Fields{
+		"interval": cv.interval,
+		"refetch":  cv.refetch,
+	}).Info("Chain verifier started")
+	return nil
+}
+
+// Stop stops verification, a running verification is finished first
+func (cv *ChainVerifier) Stop() error {
+	cv.mutex.Lock()
+	defer cv.mutex.Unlock()
+
+	if !cv.isRunning {
+		return nil
+	}
+	cv.isRunning = false
+	cv.ticker.Stop()
+
+	select {
+	case cv.stopChannel <- true:
+	default:
+	}
+
+	cv.logger.Info("Chain verifier stopped")
+	return nil
+}
+
+// GetLastReport returns the report of the last verification, nil if none finished yet
+func (cv *ChainVerifier) GetLastReport() *types.IntegrityReport {
+	cv.mutex.RLock()
+	defer cv.mutex.RUnlock()
+	return cv.lastReport
+}
+
+// verifyLoop verifies the stored chain on every tick until stopped
+func (cv *ChainVerifier) verifyLoop(ctx context.Context, ticker Ticker) {
+	for {
+		select {
+		case <-ticker.C():
+			if _, err := cv.Verify(ctx, 0, cv.refetch); err != nil {
+				cv.logger.WithError(err).Warn("Failed to verify stored chain")
+			}
+		case <-cv.stopChannel:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Verify checks the stored headers from the slot on and replaces the stored issues from
+// that slot on with the findings. With refetch set, ranges with issues are replaced with
+// the headers of a healthy client and checked again.
+func (cv *ChainVerifier) Verify(ctx context.Context, fromSlot uint64, refetch bool) (*types.IntegrityReport, error) {
+	cv.verifyMutex.Lock()
+	defer cv.verifyMutex.Unlock()
+
+	report := &types.IntegrityReport{
+		StartedAt: cv.clock.Now(),
+		FromSlot:  fromSlot,
+	}
+	if err := cv.check(ctx, report); err != nil {
+		return nil, err
+	}
+
+	if refetch && len(report.Issues) > 0 {
+		for _, slotRange := range issueRanges(report.Issues) {
+			client, err := cv.refetchRange(ctx, slotRange)
+			if err != nil {
+				cv.logger.WithError(err).WithFields(logrus.Fields{
+					"start_slot": slotRange.Start,
+					"end_slot":   slotRange.End,
+				}).Warn("Failed to refetch range with integrity issues")
+				continue
+			}
+			report.Refetched = append(report.Refetched, slotRange)
+			cv.logger.WithFields(logrus.Fields{
+				"start_slot": slotRange.Start,
+				"end_slot":   slotRange.End,
+				"client":     client,
+			}).Info("Refetched range with integrity issues")
+		}
+
+		if len(report.Refetched) > 0 {
+			if err := cv.check(ctx, report); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		if err := db.DeleteIntegrityIssuesFromSlot(fromSlot, tx); err != nil {
+			return err
+		}
+		for _, issue := range report.Issues {
+			if err := db.InsertIntegrityIssue(issue, tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.FinishedAt = cv.clock.Now()
+
+	cv.mutex.Lock()
+	cv.lastReport = report
+	cv.mutex.Unlock()
+
+	entry := cv.logger.WithFields(logrus.Fields{
+		"from_slot":       report.FromSlot,
+		"last_slot":       report.LastSlot,
+		"headers_checked": report.HeadersChecked,
+		"issues":          len(report.Issues),
+		"refetched":       len(report.Refetched),
+		"duration":        report.FinishedAt.Sub(report.StartedAt),
+	})
+	if len(report.Issues) > 0 {
+		entry.Warn("Stored chain has integrity issues")
+	} else {
+		entry.Info("Stored chain verified")
+	}
+	return report, nil
+}
+
+// check walks the stored headers from the report's slot on in slot order and sets the
+// report's issues and counts
+func (cv *ChainVerifier) check(ctx context.Context, report *types.IntegrityReport) error {
+	report.Issues = nil
+	report.HeadersChecked = 0
+	report.LastSlot = 0
+
+	detectedAt := cv.clock.Now().UnixMilli()
+	addIssue := func(slot uint64, kind string, relatedSlot uint64, format string, args ...any) {
+		report.Issues = append(report.Issues, &types.IntegrityIssue{
+			Slot:        slot,
+			Kind:        kind,
+			RelatedSlot: relatedSlot,
+			Detail:      fmt.Sprintf(format, args...),
+			DetectedAt:  detectedAt,
+		})
+	}
+
+	// The header before the first checked slot is the parent of the first checked header
+	var previous *types.BlockHeader
+	var previousRoot [32]byte
+	previousHashed := false
+	if report.FromSlot > 0 {
+		header, err := db.GetBlockHeaderBeforeSlot(report.FromSlot)
+		if err != nil {
+			return err
+		}
+		if header != nil {
+			previous = header
+			previousRoot, err = header.HashTreeRoot()
+			previousHashed = err == nil
+		}
+	}
+
+	// Earliest slot each parent and state root was seen at
+	parents := make(map[string]uint64)
+	stateRoots := make(map[string]uint64)
+
+	cursor := report.FromSlot
+	for ctx.Err() == nil {
+		headers, err := db.GetBlockHeadersFromSlot(cursor, chainVerifyBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, header := range headers {
+			report.HeadersChecked++
+			report.LastSlot = header.Slot
+
+			if previous != nil && header.Slot <= previous.Slot {
+				addIssue(header.Slot, types.IntegrityIssueSlotOrder, previous.Slot,
+					"slot %d does not follow slot %d", header.Slot, previous.Slot)
+			}
+
+			var invalidRoots []string
+			for i, root := range [][]byte{header.ParentRoot, header.StateRoot, header.BodyRoot} {
+				if len(root) != 32 {
+					invalidRoots = append(invalidRoots, fmt.Sprintf("%s is %d bytes", types.BlockHeaderFields[2+i], len(root)))
+				}
+			}
+			validRoots := len(invalidRoots) == 0
+			if !validRoots {
+				addIssue(header.Slot, types.IntegrityIssueInvalidRootLength, 0, "%s", strings.Join(invalidRoots, ", "))
+			}
+
+			if validRoots {
+				if previousHashed && !bytes.Equal(header.ParentRoot, previousRoot[:]) {
+					addIssue(header.Slot, types.IntegrityIssueParentMismatch, previous.Slot,
+						"parent_root 0x%x is not the root 0x%x of the header at slot %d", header.ParentRoot, previousRoot, previous.Slot)
+				}
+				if slot, ok := parents[string(header.ParentRoot)]; ok {
+					addIssue(header.Slot, types.IntegrityIssueDuplicateParent, slot,
+						"parent_root 0x%x is also the parent of the header at slot %d", header.ParentRoot, slot)
+				} else {
+					parents[string(header.ParentRoot)] = header.Slot
+				}
+				if slot, ok := stateRoots[string(header.StateRoot)]; ok {
+					addIssue(header.Slot, types.IntegrityIssueDuplicateStateRoot, slot,
+						"state_root 0x%x is also the state root of the header at slot %d", header.StateRoot, slot)
+				} else {
+					stateRoots[string(header.StateRoot)] = header.Slot
+				}
+			}
+
+			if cv.validatorCount > 0 && header.ProposerIndex != header.Slot%cv.validatorCount {
+				addIssue(header.Slot, types.IntegrityIssueProposerMismatch, 0,
+					"proposer %d, expected %d of %d validators", header.ProposerIndex, header.Slot%cv.validatorCount, cv.validatorCount)
+			}
+
+			previous = header
+			previousHashed = false
+			if validRoots {
+				previousRoot, err = header.HashTreeRoot()
+				previousHashed = err == nil
+			}
+		}
+
+		if len(headers) < chainVerifyBatchSize {
+			break
+		}
+		cursor = headers[len(headers)-1].Slot + 1
+	}
+	return ctx.Err()
+}
+
+// refetchRange replaces the stored headers of a range with the headers of the first
+// healthy client that serves it, returning the name of the client
+func (cv *ChainVerifier) refetchRange(ctx context.Context, slotRange types.SlotRange) (string, error) {
+	err := errors.New("no healthy clients available")
+	for _, client := range cv.clientPool.GetAllClients() {
+		if !client.IsHealthy() {
+			continue
+		}
+
+		var headers []*types.BlockHeader
+		headers, err = client.GetBlockRange(ctx, slotRange.Start, slotRange.End)
+		if err != nil {
+			continue
+		}
+
+		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			if err := db.DeleteBlockHeadersInRange(slotRange.Start, slotRange.End, tx); err != nil {
+				return err
+			}
+			return db.InsertBlockHeaderBatch(headers, slices.Repeat([]string{client.GetConfig().Name}, len(headers)), tx)
+		})
+		if err != nil {
+			return "", err
+		}
+		return client.GetConfig().Name, nil
+	}
+	return "", err
+}
+
+// issueRanges returns the merged slot ranges spanned by the issues and the headers they involve
+func issueRanges(issues []*types.IntegrityIssue) []types.SlotRange {
+	ranges := make([]types.SlotRange, 0, len(issues))
+	for _, issue := range issues {
+		start := issue.Slot
+		if issue.RelatedSlot != 0 {
+			start = min(start, issue.RelatedSlot)
+		}
+		ranges = append(ranges, types.SlotRange{Start: start, End: issue.Slot})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start < ranges[j].Start
+	})
+
+	var merged []types.SlotRange
+	for _, slotRange := range ranges {
+		if last := len(merged) - 1; last >= 0 && slotRange.Start <= merged[last].End+1 {
+			merged[last].End = max(merged[last].End, slotRange.End)
+			continue
+		}
+		merged = append(merged, slotRange)
+	}
+	return merged
+}
@@ -0,0 +1,120 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// initTestDB opens a migrated database in a temporary directory for the test
+func initTestDB(t *testing.T) {
+	t.Helper()
+	db.InitDB(&types.DatabaseConfig{File: filepath.Join(t.TempDir(), "indexer.db")})
+	t.Cleanup(func() { db.ReaderDb.Close() })
+}
+
+func TestChainVerifierFindsIssues(t *testing.T) {
+	initTestDB(t)
+
+	// Every header gets a state root of its own, so only the intended duplicates are found
+	header := func(slot, proposer uint64, parent *types.BlockHeader) *types.BlockHeader {
+		header := childHeader(t, slot, proposer, parent)
+		header.StateRoot = bytes.Repeat([]byte{byte(slot)}, 32)
+		return header
+	}
+	h1 := header(1, 1, nil)
+	h2 := header(2, 2, h1)
+	h3 := header(3, 0, h2) // Proposer of slot 3 is 3
+	h5 := header(5, 1, nil)
+	h6 := header(6, 2, h5)
+	h6.StateRoot = h2.StateRoot
+	h7 := header(7, 3, h6)
+	h7.BodyRoot = h7.BodyRoot[:31]
+	if err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlockHeaderBatch([]*types.BlockHeader{h1, h2, h3, h5, h6, h7}, make([]string, 6), tx)
+	}); err != nil {
+		t.Fatalf("storing headers: %v", err)
+	}
+
+	verifier := NewChainVerifier(nil, 4, 0, false, NewVirtualClock(time.Unix(1_700_000_000, 0), 1), logrus.New())
+	tests := []struct {
+		fromSlot uint64
+		expected []types.IntegrityIssue
+	}{
+		{
+			fromSlot: 0,
+			expected: []types.IntegrityIssue{
+				{Slot: 3, Kind: types.IntegrityIssueProposerMismatch},
+				{Slot: 5, Kind: types.IntegrityIssueParentMismatch, RelatedSlot: 3},
+				{Slot: 5, Kind: types.IntegrityIssueDuplicateParent, RelatedSlot: 1},
+				{Slot: 6, Kind: types.IntegrityIssueDuplicateStateRoot, RelatedSlot: 2},
+				{Slot: 7, Kind: types.IntegrityIssueInvalidRootLength},
+			},
+		},
+		{
+			// The header before the first slot is the parent, earlier roots are not compared
+			fromSlot: 5,
+			expected: []types.IntegrityIssue{
+				{Slot: 5, Kind: types.IntegrityIssueParentMismatch, RelatedSlot: 3},
+				{Slot: 7, Kind: types.IntegrityIssueInvalidRootLength},
+			},
+		},
+	}
+	for _, test := range tests {
+		report, err := verifier.Verify(context.Background(), test.fromSlot, false)
+		if err != nil {
+			t.Fatalf("verifying from slot %d: %v", test.fromSlot, err)
+		}
+		var found []types.IntegrityIssue
+		for _, issue := range report.Issues {
+			found = append(found, types.IntegrityIssue{Slot: issue.Slot, Kind: issue.Kind, RelatedSlot: issue.RelatedSlot})
+		}
+		if !slices.Equal(found, test.expected) {
+			t.Errorf("verifying from slot %d found %+v, want %+v", test.fromSlot, found, test.expected)
+		}
+		if report.LastSlot != 7 {
+			t.Errorf("verifying from slot %d ended at slot %d, want 7", test.fromSlot, report.LastSlot)
+		}
+	}
+
+	// Issues from the verified slot on replace the stored ones
+	stored, err := db.GetIntegrityIssues(10)
+	if err != nil {
+		t.Fatalf("reading integrity issues: %v", err)
+	}
+	if len(stored) != 3 {
+		t.Errorf("stored %d integrity issues, want the one before slot 5 and the 2 found from it", len(stored))
+	}
+}
+
+func TestIssueRanges(t *testing.T) {
+	issue := func(slot, relatedSlot uint64) *types.IntegrityIssue {
+		return &types.IntegrityIssue{Slot: slot, RelatedSlot: relatedSlot}
+	}
+	tests := []struct {
+		name     string
+		issues   []*types.IntegrityIssue
+		expected []types.SlotRange
+	}{
+		{"none", nil, nil},
+		{"single slot", []*types.IntegrityIssue{issue(4, 0)}, []types.SlotRange{{Start: 4, End: 4}}},
+		{"related slot", []*types.IntegrityIssue{issue(9, 6)}, []types.SlotRange{{Start: 6, End: 9}}},
+		{"adjacent", []*types.IntegrityIssue{issue(5, 0), issue(4, 0)}, []types.SlotRange{{Start: 4, End: 5}}},
+		{"overlapping", []*types.IntegrityIssue{issue(9, 3), issue(5, 0)}, []types.SlotRange{{Start: 3, End: 9}}},
+		{"separate", []*types.IntegrityIssue{issue(10, 8), issue(3, 0)}, []types.SlotRange{{Start: 3, End: 3}, {Start: 8, End: 10}}},
+	}
+	for _, test := range tests {
+		if ranges := issueRanges(test.issues); !slices.Equal(ranges, test.expected) {
+			t.Errorf("%s: got ranges %v, want %v", test.name, ranges, test.expected)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// Circuit breaker states
+const (
+	BreakerClosed   = "closed"    // Requests flow normally
+	BreakerOpen     = "open"      // Requests are rejected until the next probe is due
+	BreakerHalfOpen = "half-open" // A single probe request decides whether to close or reopen
+)
+
+// ErrCircuitOpen is returned for requests rejected by an open circuit breaker
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreaker tracks consecutive request failures of a client. It opens after
+// failureThreshold consecutive failures and lets a probe through after a backoff
+// that doubles every time a probe fails, up to maxBackoff.
+type CircuitBreaker struct {
+	failureThreshold int
+	baseBackoff      time.Duration
+	maxBackoff       time.Duration
+
+	state               string
+	consecutiveFailures int
+	openCount           int       // Consecutive openings without a success, drives the backoff
+	nextProbe           time.Time // When an open breaker lets the next probe through
+	probeInFlight       bool
+	lastFailure         string
+	lastFailureTime     time.Time
+
+	clock Clock
+	mutex sync.Mutex
+}
+
+// BreakerStatus is a snapshot of a circuit breaker
+type BreakerStatus struct {
+	State               string
+	ConsecutiveFailures int
+	LastFailure         string
+	LastFailureTime     time.Time
+	NextProbe           time.Time // Zero unless open
+}
+
+// NewCircuitBreaker creates a closed circuit breaker
+func NewCircuitBreaker(failureThreshold int, baseBackoff, maxBackoff time.Duration, clock Clock) *CircuitBreaker {
+	return &CircuitBreaker{
+		failureThreshold: failureThreshold,
+		baseBackoff:      baseBackoff,
+		maxBackoff:       maxBackoff,
+		state:            BreakerClosed,
+		clock:            clock,
+	}
+}
+
+// Allow reports whether a request may be made, moving a due open breaker to half-open.
+// Probe reports whether the caller holds the single half-open probe, whose outcome must be
+// recorded with probe set.
+func (cb *CircuitBreaker) Allow() (allowed, probe bool) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	switch cb.state {
+	case BreakerOpen:
+		if cb.clock.Now().Before(cb.nextProbe) {
+			return false, false
+		}
+		cb.state = BreakerHalfOpen
+		cb.probeInFlight = true
+		return true, true
+	case BreakerHalfOpen:
+		if cb.probeInFlight {
+			return false, false
+		}
+		cb.probeInFlight = true
+		return true, true
+	}
+	return true, false
+}
+
+// Ready reports whether Allow would let a request through, without reserving a probe
+func (cb *CircuitBreaker) Ready() bool {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	switch cb.state {
+	case BreakerOpen:
+		return !cb.clock.Now().Before(cb.nextProbe)
+	case BreakerHalfOpen:
+		return !cb.probeInFlight
+	}
+	return true
+}
+
+// Record updates the breaker with the outcome of an allowed request and returns the
+// previous and new state. Only the probe decides whether a half-open breaker closes or
+// reopens, requests that were allowed before the breaker opened only count while it is
+// closed. Cancelled requests release a probe without counting.
+func (cb *CircuitBreaker) Record(err error, probe bool) (from, to string) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	from = cb.state
+	if probe {
+		cb.probeInFlight = false
+	}
+	decides := probe || cb.state == BreakerClosed
+
+	switch {
+	case err == nil, errors.Is(err, ErrBlockNotFound), errors.Is(err, ErrStateNotFound):
+		// A missing block, e.g. at a missed slot, or a pruned state is still an answer from the endpoint
+		if decides {
+			cb.state = BreakerClosed
+			cb.consecutiveFailures = 0
+			cb.openCount = 0
+			cb.nextProbe = time.Time{}
+		}
+
+	case errors.Is(err, context.Canceled):
+		// Our own shutdown or a caller giving up says nothing about the endpoint
+
+	default:
+		cb.consecutiveFailures++
+		cb.lastFailure = failureReason(err)
+		cb.lastFailureTime = cb.clock.Now()
+
+		if probe || (cb.state == BreakerClosed && cb.consecutiveFailures >= cb.failureThreshold) {
+			cb.open()
+		}
+	}
+
+	return from, cb.state
+}
+
+// open moves the breaker to open with the next exponential backoff, callers must hold the mutex
+func (cb *CircuitBreaker) open() {
+	backoff := cb.baseBackoff << min(cb.openCount, 30)
+	if backoff <= 0 || backoff > cb.maxBackoff {
+		backoff = cb.maxBackoff
+	}
+
+	cb.state = BreakerOpen
+	cb.openCount++
+	cb.nextProbe = cb.clock.Now().Add(backoff)
+}
+
+// Status returns a snapshot of the breaker
+func (cb *CircuitBreaker) Status() BreakerStatus {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	status := BreakerStatus{
+		State:               cb.state,
+		ConsecutiveFailures: cb.consecutiveFailures,
+		LastFailure:         cb.lastFailure,
+		LastFailureTime:     cb.lastFailureTime,
+	}
+	if cb.state == BreakerOpen {
+		status.NextProbe = cb.nextProbe
+	}
+	return status
+}
+
+// failureReason describes a request failure, calling out timeouts
+func failureReason(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return "timeout: " + err.Error()
+	}
+	return err.Error()
+}
@@ -0,0 +1,116 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// manualClock is a clock that only moves when the test advances it
+type manualClock struct {
+	Clock
+	now time.Time
+}
+
+func (mc *manualClock) Now() time.Time {
+	return mc.now
+}
+
+// breakerStep is one request of a circuit breaker test
+type breakerStep struct {
+	wait time.Duration // Advances the clock before the request
+	kind int
+	err  error
+}
+
+const (
+	stepRequest     = iota // Allowed and recorded
+	stepRejected           // Rejected by the breaker
+	stepStraggler          // Allowed before the breaker opened, recorded now
+	stepStartProbe         // Allowed as the probe, recorded by stepFinishProbe
+	stepFinishProbe        // Records the started probe
+)
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	failure := errors.New("connection refused")
+	request := func(err error) breakerStep { return breakerStep{kind: stepRequest, err: err} }
+	after := func(wait time.Duration, err error) breakerStep {
+		return breakerStep{wait: wait, kind: stepRequest, err: err}
+	}
+	open := []breakerStep{request(failure), request(failure), request(failure)}
+
+	// Three failures open the breaker for 1s, doubling up to 4s
+	tests := []struct {
+		name      string
+		steps     []breakerStep
+		state     string
+		nextProbe time.Duration // Since the start, zero unless open
+	}{
+		{"closed below the threshold", open[:2], BreakerClosed, 0},
+		{"opens at the threshold", open, BreakerOpen, time.Second},
+		{"rejects until the probe is due", append(open, breakerStep{wait: 999 * time.Millisecond, kind: stepRejected}), BreakerOpen, time.Second},
+		{"straggling failures keep the backoff", append(open, breakerStep{kind: stepStraggler, err: failure}, breakerStep{kind: stepStraggler, err: failure}), BreakerOpen, time.Second},
+		{"straggling success keeps it open", append(open, breakerStep{kind: stepStraggler}), BreakerOpen, time.Second},
+		{"probe closes", append(open, after(time.Second, nil)), BreakerClosed, 0},
+		{"failed probe doubles the backoff", append(open, after(time.Second, failure)), BreakerOpen, 3 * time.Second},
+		{"backoff is capped", append(open, after(time.Second, failure), after(2*time.Second, failure), after(4*time.Second, failure)), BreakerOpen, 11 * time.Second},
+		{"success resets the backoff", append(open, after(time.Second, failure), after(2*time.Second, nil), request(failure), request(failure), request(failure)), BreakerOpen, 4 * time.Second},
+		{"only the probe decides", append(open,
+			breakerStep{wait: time.Second, kind: stepStartProbe},
+			breakerStep{kind: stepRejected},
+			breakerStep{kind: stepStraggler},
+			breakerStep{kind: stepStraggler, err: failure},
+		), BreakerHalfOpen, 0},
+		{"probe decides after stragglers", append(open,
+			breakerStep{wait: time.Second, kind: stepStartProbe},
+			breakerStep{kind: stepStraggler},
+			breakerStep{kind: stepFinishProbe, err: failure},
+		), BreakerOpen, 3 * time.Second},
+		{"not found is a success", []breakerStep{request(failure), request(failure), request(ErrBlockNotFound), request(failure), request(fmt.Errorf("state: %w", ErrStateNotFound)), request(failure)}, BreakerClosed, 0},
+		{"not found probe closes", append(open, after(time.Second, ErrBlockNotFound)), BreakerClosed, 0},
+		{"cancelled requests are ignored", []breakerStep{request(failure), request(failure), request(context.Canceled), request(context.Canceled), request(failure)}, BreakerOpen, time.Second},
+		{"cancelled probe is released", append(open, after(time.Second, context.Canceled)), BreakerHalfOpen, 0},
+		{"probe after a cancelled probe", append(open, after(time.Second, context.Canceled), request(nil)), BreakerClosed, 0},
+	}
+	for _, test := range tests {
+		start := time.Unix(1_700_000_000, 0)
+		clock := &manualClock{now: start}
+		breaker := NewCircuitBreaker(3, time.Second, 4*time.Second, clock)
+
+		for i, step := range test.steps {
+			clock.now = clock.now.Add(step.wait)
+			switch step.kind {
+			case stepRequest, stepStartProbe:
+				allowed, probe := breaker.Allow()
+				if !allowed {
+					t.Fatalf("%s: step %d was rejected", test.name, i)
+				}
+				if step.kind == stepRequest {
+					breaker.Record(step.err, probe)
+				} else if !probe {
+					t.Fatalf("%s: step %d is not the probe", test.name, i)
+				}
+			case stepRejected:
+				if allowed, _ := breaker.Allow(); allowed {
+					t.Fatalf("%s: step %d was allowed", test.name, i)
+				}
+			case stepStraggler:
+				breaker.Record(step.err, false)
+			case stepFinishProbe:
+				breaker.Record(step.err, true)
+			}
+		}
+
+		status := breaker.Status()
+		var nextProbe time.Duration
+		if !status.NextProbe.IsZero() {
+			nextProbe = status.NextProbe.Sub(start)
+		}
+		if status.State != test.state || nextProbe != test.nextProbe {
+			t.Errorf("%s: breaker is %s with the next probe at %v, want %s at %v",
+				test.name, status.State, nextProbe, test.state, test.nextProbe)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package indexer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -14,40 +16,100 @@ type Client struct {
 	config     *types.EndpointConfig
 	httpClient *HTTPClient
 
+	healthTimeout time.Duration
+	clock         Clock
+
+	// Trips on consecutive request failures, probeTimer fires when a probe is due
+	breaker    *CircuitBreaker
+	probeTimer *time.Timer
+	closed     bool
+
 	// Connection state
 	isHealthy   bool
 	lastError   error
 	lastChecked time.Time
 
+	// Request statistics used by the selection strategies
+	head         *types.BlockHeader // Head from the last successful head request
+	headChanged  time.Time          // When the reported head slot last changed
+	latencyEWMA  time.Duration      // Exponentially weighted moving average of successful request times
+	requestCount uint64
+	failureCount uint64
+
+	// Status from the last StatusEvaluator run
+	status       string
+	statusReason string
+
+	// Notified of every header and full block the endpoint returns, nil if unset
+	headerObserver HeaderObserver
+	blockObserver  BlockObserver
+
 	// Synchronization
-	mutex sync.RWMutex
+	mutex      sync.RWMutex
+	statsMutex sync.RWMutex // Separate from mutex, which guards the health check result
 
 	logger logrus.FieldLogger
 }
 
-// NewClient creates a new client for a PQ Devnet endpoint
-func NewClient(config *types.EndpointConfig, logger logrus.FieldLogger) *Client {
+// HeaderObserver is notified of the headers a client returns, e.g. to compare them across clients
+type HeaderObserver func(client string, headers ...*types.BlockHeader)
+
+// BlockObserver is notified of the full blocks a client returns, after their root is checked
+type BlockObserver func(client string, root []byte, signedBlock *types.SignedBlock)
+
+// NewClient creates a new client for a PQ Devnet endpoint, the endpoint's timeouts
+// override the indexer defaults
+func NewClient(config *types.EndpointConfig, indexerConfig *types.IndexerConfig, clock Clock, wrapper TransportWrapper, logger logrus.FieldLogger) (*Client, error) {
+	clientLogger := logger.WithField("endpoint", config.Name)
+	httpClient, err := NewHTTPClient(config, indexerConfig.HTTPTimeout, wrapper, clientLogger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP client for %s: %w", config.Name, err)
+	}
+
+	healthTimeout := indexerConfig.HealthTimeout
+	if config.HealthTimeout > 0 {
+		healthTimeout = config.HealthTimeout
+	}
+
 	return &Client{
-		config:      config,
-		httpClient:  NewHTTPClient(config.Url, defaultHTTPTimeout),
+		config:        config,
+		httpClient:    httpClient,
+		healthTimeout: healthTimeout,
+		clock:         clock,
+		breaker: NewCircuitBreaker(
+			indexerConfig.BreakerFailureThreshold,
+			indexerConfig.BreakerBaseBackoff,
+			indexerConfig.BreakerMaxBackoff,
+			clock,
+		),
+		status:      StatusHealthy,
 		isHealthy:   true, // Start optimistically
-		lastChecked: time.Now(),
-		logger:      logger.WithField("endpoint", config.Name),
-	}
+		lastChecked: clock.Now(),
+		logger:      clientLogger,
+	}, nil
 }
 
-// HealthCheck performs a health check using the /lean/v0/headers/head endpoint
+// HealthCheck performs a health check using the /lean/v0/headers/head endpoint. The mutex
+// is only held to store the result, so selection does not wait on a slow endpoint.
 func (c *Client) HealthCheck(ctx context.Context) error {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
 	// Create a context with health check timeout
-	healthCtx, cancel := context.WithTimeout(ctx, defaultHealthTimeout)
+	healthCtx, cancel := context.WithTimeout(ctx, c.healthTimeout)
 	defer cancel()
 
 	// Try to fetch the head block to verify connectivity
-	_, err := c.httpClient.GetHeadBlock(healthCtx)
-	c.lastChecked = time.Now()
+	block, err := execute(c, healthCtx, 1, c.httpClient.GetHeadBlock)
+	if errors.Is(err, ErrCircuitOpen) {
+		// Not checked, the breaker keeps the client out of selection until its next probe
+		return err
+	}
+	if err == nil {
+		c.observeHead(block)
+		c.observeHeaders(block)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lastChecked = c.clock.Now()
 
 	if err != nil {
 		c.isHealthy = false
@@ -64,44 +126,334 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 
 // GetLatestBlock fetches the current head block
 func (c *Client) GetLatestBlock(ctx context.Context) (*types.BlockHeader, error) {
-	return c.httpClient.GetHeadBlock(ctx)
+	block, err := execute(c, ctx, 1, c.httpClient.GetHeadBlock)
+	if err == nil {
+		c.observeHead(block)
+		c.observeHeaders(block)
+	}
+	return block, err
 }
 
 // GetBlockBySlot fetches a block by slot number
 func (c *Client) GetBlockBySlot(ctx context.Context, slot uint64) (*types.BlockHeader, error) {
-	return c.httpClient.GetBlockBySlot(ctx, slot)
+	block, err := execute(c, ctx, 1, func(ctx context.Context) (*types.BlockHeader, error) {
+		return c.httpClient.GetBlockBySlot(ctx, slot)
+	})
+	if err == nil {
+		c.observeHeaders(block)
+	}
+	return block, err
 }
 
 // GetBlockByRoot fetches a block by its root hash
 func (c *Client) GetBlockByRoot(ctx context.Context, root []byte) (*types.BlockHeader, error) {
-	return c.httpClient.GetBlockByRoot(ctx, root)
+	block, err := execute(c, ctx, 1, func(ctx context.Context) (*types.BlockHeader, error) {
+		return c.httpClient.GetBlockByRoot(ctx, root)
+	})
+	if err == nil {
+		c.observeHeaders(block)
+	}
+	return block, err
+}
+
+// GetSignedBlock fetches a full block by its root hash and checks that it hashes to the root
+func (c *Client) GetSignedBlock(ctx context.Context, root []byte) (*types.SignedBlock, error) {
+	var header *types.BlockHeader
+	signedBlock, err := execute(c, ctx, 1, func(ctx context.Context) (*types.SignedBlock, error) {
+		signedBlock, err := c.httpClient.GetSignedBlock(ctx, fmt.Sprintf("0x%x", root))
+		if err != nil {
+			return nil, err
+		}
+		header, err = signedBlock.Message.Header()
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyBlockRoot(header, root); err != nil {
+			return nil, err
+		}
+		return signedBlock, nil
+	})
+	if err == nil {
+		c.observeHeaders(header)
+		c.observeBlock(root, signedBlock)
+	}
+	return signedBlock, err
+}
+
+// GetState fetches a state by state id, see HTTPClient.GetState
+func (c *Client) GetState(ctx context.Context, stateId string) (*types.State, error) {
+	return execute(c, ctx, 1, func(ctx context.Context) (*types.State, error) {
+		return c.httpClient.GetState(ctx, stateId)
+	})
 }
 
 // GetFinalizedBlock fetches the finalized block
 func (c *Client) GetFinalizedBlock(ctx context.Context) (*types.BlockHeader, error) {
-	return c.httpClient.GetFinalizedBlock(ctx)
+	block, err := execute(c, ctx, 1, c.httpClient.GetFinalizedBlock)
+	if err == nil {
+		c.observeHeaders(block)
+	}
+	return block, err
 }
 
 // GetJustifiedBlock fetches the justified block
 func (c *Client) GetJustifiedBlock(ctx context.Context) (*types.BlockHeader, error) {
-	return c.httpClient.GetJustifiedBlock(ctx)
+	block, err := execute(c, ctx, 1, c.httpClient.GetJustifiedBlock)
+	if err == nil {
+		c.observeHeaders(block)
+	}
+	return block, err
 }
 
 // GetGenesisBlock fetches the genesis block
 func (c *Client) GetGenesisBlock(ctx context.Context) (*types.BlockHeader, error) {
-	return c.httpClient.GetGenesisBlock(ctx)
+	return execute(c, ctx, 1, c.httpClient.GetGenesisBlock)
 }
 
-// GetBlockRange fetches a range of blocks by slot numbers
+// GetBlockRange fetches a range of blocks by slot numbers, missed slots are skipped
 func (c *Client) GetBlockRange(ctx context.Context, start, end uint64) ([]*types.BlockHeader, error) {
-	return c.httpClient.GetBlockRange(ctx, start, end)
+	blocks, err := execute(c, ctx, int(end-start+1), func(ctx context.Context) ([]*types.BlockHeader, error) {
+		return c.httpClient.GetBlockRange(ctx, start, end)
+	})
+	if err == nil {
+		c.observeHeaders(blocks...)
+	}
+	return blocks, err
+}
+
+// execute runs a request through the client's circuit breaker and records its outcome.
+// requests is the number of HTTP requests the call makes, used for the latency average.
+func execute[T any](c *Client, ctx context.Context, requests int, request func(context.Context) (T, error)) (T, error) {
+	allowed, probe := c.breaker.Allow()
+	if !allowed {
+		var zero T
+		return zero, fmt.Errorf("%w for %s", ErrCircuitOpen, c.config.Name)
+	}
+
+	start := c.clock.Now()
+	result, err := request(ctx)
+	c.observeRequest(start, requests, err)
+	c.recordBreakerOutcome(err, probe)
+
+	return result, err
+}
+
+// recordBreakerOutcome feeds a request outcome to the breaker and schedules a probe when it opens
+func (c *Client) recordBreakerOutcome(err error, probe bool) {
+	from, to := c.breaker.Record(err, probe)
+	if from == to {
+		return
+	}
+
+	status := c.breaker.Status()
+	switch to {
+	case BreakerOpen:
+		c.logger.WithFields(logrus.Fields{
+			"failures":   status.ConsecutiveFailures,
+			"reason":     status.LastFailure,
+			"next_probe": status.NextProbe,
+		}).Warn("Circuit breaker opened")
+		c.scheduleProbe(status.NextProbe.Sub(c.clock.Now()))
+	case BreakerClosed:
+		c.logger.Info("Circuit breaker closed")
+	}
+}
+
+// scheduleProbe runs a health check once the breaker lets the next probe through
+func (c *Client) scheduleProbe(delay time.Duration) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+
+	if c.closed {
+		return
+	}
+	if c.probeTimer != nil {
+		c.probeTimer.Stop()
+	}
+	c.probeTimer = c.clock.AfterFunc(delay, func() {
+		if err := c.HealthCheck(context.Background()); err != nil {
+			c.logger.WithError(err).Debug("Circuit breaker probe failed")
+		}
+	})
+}
+
+// Close stops pending circuit breaker probes, called when the client leaves the pool
+func (c *Client) Close() {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+
+	c.closed = true
+	if c.probeTimer != nil {
+		c.probeTimer.Stop()
+	}
 }
 
-// IsHealthy returns the current health status of the client
+// GetBreakerStatus returns a snapshot of the client's circuit breaker
+func (c *Client) GetBreakerStatus() BreakerStatus {
+	return c.breaker.Status()
+}
+
+// observeRequest records the outcome of requests made to the endpoint, the latency
+// sample is the average time per request
+func (c *Client) observeRequest(start time.Time, requests int, err error) {
+	elapsed := c.clock.Now().Sub(start)
+
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+
+	c.requestCount++
+	if err != nil {
+		c.failureCount++
+		return
+	}
+
+	sample := elapsed / time.Duration(max(requests, 1))
+	if c.latencyEWMA == 0 {
+		c.latencyEWMA = sample
+	} else {
+		c.latencyEWMA = time.Duration(latencyEWMAWeight*float64(sample) + (1-latencyEWMAWeight)*float64(c.latencyEWMA))
+	}
+}
+
+// observeHead records the head reported by the endpoint
+func (c *Client) observeHead(block *types.BlockHeader) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+
+	if c.head == nil || c.head.Slot != block.Slot {
+		c.headChanged = c.clock.Now()
+	}
+	c.head = block
+}
+
+// SetHeaderObserver sets the observer notified of every header the endpoint returns
+func (c *Client) SetHeaderObserver(observer HeaderObserver) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.headerObserver = observer
+}
+
+// observeHeaders passes headers the endpoint returned to the header observer
+func (c *Client) observeHeaders(headers ...*types.BlockHeader) {
+	c.statsMutex.RLock()
+	observer := c.headerObserver
+	c.statsMutex.RUnlock()
+
+	if observer != nil {
+		observer(c.config.Name, headers...)
+	}
+}
+
+// SetBlockObserver sets the observer notified of every full block the endpoint returns
+func (c *Client) SetBlockObserver(observer BlockObserver) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.blockObserver = observer
+}
+
+// observeBlock passes a full block the endpoint returned to the block observer
+func (c *Client) observeBlock(root []byte, signedBlock *types.SignedBlock) {
+	c.statsMutex.RLock()
+	observer := c.blockObserver
+	c.statsMutex.RUnlock()
+
+	if observer != nil {
+		observer(c.config.Name, root, signedBlock)
+	}
+}
+
+// SetForkSchedule sets the fork schedule full blocks are decoded with, see HTTPClient.SetForkSchedule
+func (c *Client) SetForkSchedule(forks *types.ForkSchedule) {
+	c.httpClient.SetForkSchedule(forks)
+}
+
+// GetHeadSlot returns the head slot from the last successful head request, 0 if none
+func (c *Client) GetHeadSlot() uint64 {
+	c.statsMutex.RLock()
+	defer c.statsMutex.RUnlock()
+
+	if c.head == nil {
+		return 0
+	}
+	return c.head.Slot
+}
+
+// GetHead returns the head from the last successful head request, nil if none,
+// and when its slot last changed
+func (c *Client) GetHead() (*types.BlockHeader, time.Time) {
+	c.statsMutex.RLock()
+	defer c.statsMutex.RUnlock()
+	return c.head, c.headChanged
+}
+
+// setStatus stores the status from the status evaluator, logging changes
+func (c *Client) setStatus(status, reason string) {
+	c.statsMutex.Lock()
+	previous := c.status
+	c.status = status
+	c.statusReason = reason
+	c.statsMutex.Unlock()
+
+	if status != previous {
+		c.logger.WithFields(logrus.Fields{
+			"from":   previous,
+			"to":     status,
+			"reason": reason,
+		}).Info("Client status changed")
+	}
+}
+
+// GetStatus returns the client status and the reason it is not healthy. Reachability is
+// live, the head based statuses come from the last evaluation.
+func (c *Client) GetStatus() (string, string) {
+	reachable := c.IsReachable()
+
+	c.statsMutex.RLock()
+	defer c.statsMutex.RUnlock()
+
+	switch {
+	case !reachable:
+		if c.status == StatusUnreachable {
+			return c.status, c.statusReason
+		}
+		return StatusUnreachable, "health check failed or circuit breaker open"
+	case c.status == StatusUnreachable:
+		// Recovered since the last evaluation
+		return StatusHealthy, ""
+	}
+	return c.status, c.statusReason
+}
+
+// GetLatency returns the moving average of successful request times, 0 if none succeeded yet
+func (c *Client) GetLatency() time.Duration {
+	c.statsMutex.RLock()
+	defer c.statsMutex.RUnlock()
+	return c.latencyEWMA
+}
+
+// GetEncoding returns the response encoding detected for the endpoint, empty until the first response
+func (c *Client) GetEncoding() string {
+	return c.httpClient.GetEncoding()
+}
+
+// GetRequestStats returns the number of requests made to the endpoint and how many failed
+func (c *Client) GetRequestStats() (requests, failures uint64) {
+	c.statsMutex.RLock()
+	defer c.statsMutex.RUnlock()
+	return c.requestCount, c.failureCount
+}
+
+// IsHealthy returns whether the client is reachable and at the network head
 func (c *Client) IsHealthy() bool {
+	status, _ := c.GetStatus()
+	return status == StatusHealthy
+}
+
+// IsReachable returns whether the last health check passed and the circuit breaker lets requests through
+func (c *Client) IsReachable() bool {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
-	return c.isHealthy
+	return c.isHealthy && c.breaker.Ready()
 }
 
 // GetLastError returns the last error encountered by this client
@@ -2,6 +2,8 @@ package indexer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -9,23 +11,55 @@ import (
 	"github.com/syjn99/leanView/backend/types"
 )
 
+// Errors returned when mutating the client pool
+var (
+	ErrEndpointExists   = errors.New("endpoint already exists")
+	ErrEndpointNotFound = errors.New("endpoint not found")
+	ErrLastEndpoint     = errors.New("cannot remove the last endpoint")
+)
+
 // ClientPool manages multiple endpoint connections
 type ClientPool struct {
 	clients []*Client
 	primary *Client
+	config  *types.IndexerConfig
 	logger  logrus.FieldLogger
 
+	// Passed to every client, also those added at runtime
+	clock   Clock
+	wrapper TransportWrapper
+
+	// Client selection for regular and bulk requests
+	selector     ClientSelector
+	bulkSelector ClientSelector
+
+	// Classifies clients after every health check round, nil skips classification
+	statusEvaluator *StatusEvaluator
+
+	// Set on every client, also those added at runtime
+	headerObserver HeaderObserver
+	blockObserver  BlockObserver
+	forks          *types.ForkSchedule
+
 	// Health check management
-	healthCheckTicker *time.Ticker
-	stopHealthCheck   chan bool
-	mutex             sync.RWMutex
+	healthCheckInterval time.Duration
+	healthCheckTicker   Ticker
+	healthCheckCtx      context.Context // Set once health checks run, used to check added clients
+	stopHealthCheck     chan bool
+	mutex               sync.RWMutex
 }
 
 // NewClientPool creates a new client pool with multiple endpoints
-func NewClientPool(endpoints []types.EndpointConfig, logger logrus.FieldLogger) *ClientPool {
-	clients := make([]*Client, len(endpoints))
-	for i, endpoint := range endpoints {
-		clients[i] = NewClient(&endpoint, logger)
+func NewClientPool(endpoints []types.EndpointConfig, config *types.IndexerConfig, clock Clock, wrapper TransportWrapper, logger logrus.FieldLogger) *ClientPool {
+	clients := make([]*Client, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		client, err := NewClient(&endpoint, config, clock, wrapper, logger)
+		if err != nil {
+			// Options are validated with the config, so this only happens if e.g. a CA file went missing
+			logger.WithError(err).WithField("endpoint", endpoint.Name).Error("Skipping endpoint")
+			continue
+		}
+		clients = append(clients, client)
 	}
 
 	var primary *Client
@@ -33,35 +67,118 @@ func NewClientPool(endpoints []types.EndpointConfig, logger logrus.FieldLogger)
 		primary = clients[0]
 	}
 
+	poolLogger := logger.WithField("component", "client_pool")
+	newSelector := func(strategy string) ClientSelector {
+		selector, err := NewClientSelector(strategy)
+		if err != nil {
+			poolLogger.WithError(err).Warn("Falling back to primary client selection")
+			return &primarySelector{}
+		}
+		return selector
+	}
+
 	return &ClientPool{
-		clients:         clients,
-		primary:         primary,
-		logger:          logger.WithField("component", "client_pool"),
-		stopHealthCheck: make(chan bool, 1),
+		clients:             clients,
+		primary:             primary,
+		config:              config,
+		logger:              poolLogger,
+		clock:               clock,
+		wrapper:             wrapper,
+		selector:            newSelector(config.ClientSelection),
+		bulkSelector:        newSelector(config.BulkClientSelection),
+		healthCheckInterval: config.HealthCheckInterval,
+		stopHealthCheck:     make(chan bool, 1),
 	}
 }
 
-// GetHealthyClient returns a healthy client from the pool, or nil if none available
+// GetHealthyClient returns a healthy client chosen by the configured selection strategy,
+// or nil if none available
 func (cp *ClientPool) GetHealthyClient() *Client {
+	return cp.selectClient(cp.selector)
+}
+
+// GetBulkClient returns a healthy client for a bulk fetch chosen by the bulk selection
+// strategy, or nil if none available
+func (cp *ClientPool) GetBulkClient() *Client {
+	return cp.selectClient(cp.bulkSelector)
+}
+
+// selectionTiers are the statuses a client may be selected in, in order of preference.
+// Forked and unreachable clients are never selected.
+var selectionTiers = []string{StatusHealthy, StatusSyncing, StatusStalled}
+
+// selectClient runs the selector over the clients of the best available status
+func (cp *ClientPool) selectClient(selector ClientSelector) *Client {
 	cp.mutex.RLock()
 	defer cp.mutex.RUnlock()
 
-	// First try the primary client
-	if cp.primary != nil && cp.primary.IsHealthy() {
-		return cp.primary
+	statuses := make(map[*Client]string, len(cp.clients))
+	for _, client := range cp.clients {
+		statuses[client], _ = client.GetStatus()
 	}
 
-	// Fall back to any healthy client
-	for _, client := range cp.clients {
-		if client.IsHealthy() {
-			return client
+	for _, tier := range selectionTiers {
+		candidates := make([]*Client, 0, len(cp.clients))
+		for _, client := range cp.clients {
+			if statuses[client] == tier {
+				candidates = append(candidates, client)
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		var primary *Client
+		if cp.primary != nil && statuses[cp.primary] == tier {
+			primary = cp.primary
 		}
+		return selector.Select(candidates, primary)
 	}
 
 	cp.logger.Warn("No healthy clients available")
 	return nil
 }
 
+// SetStatusEvaluator sets the evaluator that classifies clients after every health check round
+func (cp *ClientPool) SetStatusEvaluator(evaluator *StatusEvaluator) {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+	cp.statusEvaluator = evaluator
+}
+
+// SetHeaderObserver sets the observer notified of the headers every client returns
+func (cp *ClientPool) SetHeaderObserver(observer HeaderObserver) {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+
+	cp.headerObserver = observer
+	for _, client := range cp.clients {
+		client.SetHeaderObserver(observer)
+	}
+}
+
+// SetBlockObserver sets the observer notified of the full blocks every client returns
+func (cp *ClientPool) SetBlockObserver(observer BlockObserver) {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+
+	cp.blockObserver = observer
+	for _, client := range cp.clients {
+		client.SetBlockObserver(observer)
+	}
+}
+
+// SetForkSchedule sets the fork schedule every client decodes full blocks with
+func (cp *ClientPool) SetForkSchedule(forks *types.ForkSchedule) {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+
+	cp.forks = forks
+	for _, client := range cp.clients {
+		client.SetForkSchedule(forks)
+	}
+}
+
 // GetPrimaryClient returns the primary client regardless of health status
 func (cp *ClientPool) GetPrimaryClient() *Client {
 	cp.mutex.RLock()
@@ -71,12 +188,15 @@ func (cp *ClientPool) GetPrimaryClient() *Client {
 
 // RunHealthChecks starts background health checking for all clients
 func (cp *ClientPool) RunHealthChecks(ctx context.Context) {
-	cp.healthCheckTicker = time.NewTicker(defaultHealthCheckInterval)
+	cp.mutex.Lock()
+	cp.healthCheckTicker = cp.clock.NewTicker(cp.healthCheckInterval)
+	cp.healthCheckCtx = ctx
+	cp.mutex.Unlock()
 
 	go func() {
 		for {
 			select {
-			case <-cp.healthCheckTicker.C:
+			case <-cp.healthCheckTicker.C():
 				cp.performHealthChecks(ctx)
 			case <-cp.stopHealthCheck:
 				cp.healthCheckTicker.Stop()
@@ -99,15 +219,42 @@ func (cp *ClientPool) StopHealthChecks() {
 	cp.logger.Info("Health checking stopped")
 }
 
-// performHealthChecks runs health checks on all clients
+// CheckHealth runs one round of health checks on all clients and classifies them
+func (cp *ClientPool) CheckHealth(ctx context.Context) {
+	cp.performHealthChecks(ctx)
+}
+
+// performHealthChecks runs health checks on all clients, then classifies them
 func (cp *ClientPool) performHealthChecks(ctx context.Context) {
-	for _, client := range cp.clients {
+	clients := cp.GetAllClients()
+
+	var wg sync.WaitGroup
+	for _, client := range clients {
+		wg.Add(1)
 		go func(c *Client) {
-			if err := c.HealthCheck(ctx); err != nil {
+			defer wg.Done()
+
+			if err := c.HealthCheck(ctx); errors.Is(err, ErrCircuitOpen) {
+				cp.logger.WithField("endpoint", c.config.Name).Debug("Skipped health check, circuit breaker is open")
+			} else if err != nil {
 				cp.logger.WithError(err).WithField("endpoint", c.config.Name).Warn("Client health check failed")
 			}
 		}(client)
 	}
+	wg.Wait()
+
+	cp.EvaluateStatuses()
+}
+
+// EvaluateStatuses classifies all clients with the status evaluator, if one is set
+func (cp *ClientPool) EvaluateStatuses() {
+	cp.mutex.RLock()
+	evaluator := cp.statusEvaluator
+	cp.mutex.RUnlock()
+
+	if evaluator != nil {
+		evaluator.Evaluate(cp.GetAllClients())
+	}
 }
 
 // GetClientCount returns the total number of clients in the pool
@@ -131,6 +278,20 @@ func (cp *ClientPool) GetHealthyClientCount() int {
 	return count
 }
 
+// GetOpenCircuitCount returns the number of clients whose circuit breaker is not closed
+func (cp *ClientPool) GetOpenCircuitCount() int {
+	cp.mutex.RLock()
+	defer cp.mutex.RUnlock()
+
+	count := 0
+	for _, client := range cp.clients {
+		if client.GetBreakerStatus().State != BreakerClosed {
+			count++
+		}
+	}
+	return count
+}
+
 // GetAllClients returns all clients in the pool
 func (cp *ClientPool) GetAllClients() []*Client {
 	cp.mutex.RLock()
@@ -141,3 +302,139 @@ func (cp *ClientPool) GetAllClients() []*Client {
 	copy(clients, cp.clients)
 	return clients
 }
+
+// GetClientByName returns the client with the given endpoint name, or nil if not in the pool
+func (cp *ClientPool) GetClientByName(name string) *Client {
+	cp.mutex.RLock()
+	defer cp.mutex.RUnlock()
+	return cp.findClient(name)
+}
+
+// AddClient adds a client for the endpoint and health checks it right away if health checks are running
+func (cp *ClientPool) AddClient(endpoint types.EndpointConfig) (*Client, error) {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+
+	if cp.findClient(endpoint.Name) != nil {
+		return nil, fmt.Errorf("%w: %s", ErrEndpointExists, endpoint.Name)
+	}
+
+	client, err := NewClient(&endpoint, cp.config, cp.clock, cp.wrapper, cp.logger)
+	if err != nil {
+		return nil, err
+	}
+	client.SetHeaderObserver(cp.headerObserver)
+	client.SetBlockObserver(cp.blockObserver)
+	client.SetForkSchedule(cp.forks)
+	cp.clients = append(cp.clients, client)
+	if cp.primary == nil {
+		cp.primary = client
+	}
+
+	// Later checks are picked up by the health check ticker
+	if cp.healthCheckCtx != nil {
+		go func(ctx context.Context) {
+			if err := client.HealthCheck(ctx); err != nil {
+				cp.logger.WithError(err).WithField("endpoint", endpoint.Name).Warn("Client health check failed")
+			}
+		}(cp.healthCheckCtx)
+	}
+
+	cp.logger.WithField("endpoint", endpoint.Name).Info("Client added to pool")
+	return client, nil
+}
+
+// RemoveClient removes the client with the given endpoint name, the next client becomes
+// primary if the primary is removed
+func (cp *ClientPool) RemoveClient(name string) error {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+
+	idx := -1
+	for i, client := range cp.clients {
+		if client.config.Name == name {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+	}
+	if len(cp.clients) == 1 {
+		return ErrLastEndpoint
+	}
+
+	removed := cp.clients[idx]
+	removed.Close()
+	cp.clients = append(cp.clients[:idx:idx], cp.clients[idx+1:]...)
+	if cp.primary == removed {
+		cp.primary = cp.clients[0]
+	}
+
+	cp.logger.WithField("endpoint", name).Info("Client removed from pool")
+	return nil
+}
+
+// UpdateClient replaces the client of an existing endpoint, e.g. after its URL changed.
+// The new client keeps the position and primary status of the old one.
+func (cp *ClientPool) UpdateClient(endpoint types.EndpointConfig) error {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+
+	for i, old := range cp.clients {
+		if old.config.Name != endpoint.Name {
+			continue
+		}
+
+		client, err := NewClient(&endpoint, cp.config, cp.clock, cp.wrapper, cp.logger)
+		if err != nil {
+			return err
+		}
+		client.SetHeaderObserver(cp.headerObserver)
+		client.SetBlockObserver(cp.blockObserver)
+		client.SetForkSchedule(cp.forks)
+		old.Close()
+		cp.clients[i] = client
+		if cp.primary == old {
+			cp.primary = client
+		}
+
+		if cp.healthCheckCtx != nil {
+			go func(ctx context.Context) {
+				if err := client.HealthCheck(ctx); err != nil {
+					cp.logger.WithError(err).WithField("endpoint", endpoint.Name).Warn("Client health check failed")
+				}
+			}(cp.healthCheckCtx)
+		}
+
+		cp.logger.WithField("endpoint", endpoint.Name).Info("Client updated in pool")
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrEndpointNotFound, endpoint.Name)
+}
+
+// SetPrimary makes the client with the given endpoint name the primary client
+func (cp *ClientPool) SetPrimary(name string) error {
+	cp.mutex.Lock()
+	defer cp.mutex.Unlock()
+
+	client := cp.findClient(name)
+	if client == nil {
+		return fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+	}
+	cp.primary = client
+
+	cp.logger.WithField("endpoint", name).Info("Primary client changed")
+	return nil
+}
+
+// findClient returns the client with the given endpoint name, callers must hold the mutex
+func (cp *ClientPool) findClient(name string) *Client {
+	for _, client := range cp.clients {
+		if client.config.Name == name {
+			return client
+		}
+	}
+	return nil
+}
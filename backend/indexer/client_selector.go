@@ -0,0 +1,85 @@
+package indexer
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// latencyEWMAWeight is the weight of the newest sample in a client's latency average
+const latencyEWMAWeight = 0.2
+
+// ClientSelector picks the client to serve a request among the healthy clients.
+// Clients are passed in pool order, primary is nil if the primary is unhealthy.
+type ClientSelector interface {
+	Select(healthy []*Client, primary *Client) *Client
+}
+
+// NewClientSelector creates the selector for a strategy name
+func NewClientSelector(strategy string) (ClientSelector, error) {
+	switch strategy {
+	case types.ClientSelectionPrimary:
+		return &primarySelector{}, nil
+	case types.ClientSelectionHighestHead:
+		return &highestHeadSelector{}, nil
+	case types.ClientSelectionLowestLatency:
+		return &lowestLatencySelector{}, nil
+	case types.ClientSelectionRoundRobin:
+		return &roundRobinSelector{}, nil
+	}
+	return nil, fmt.Errorf("unknown client selection strategy %q", strategy)
+}
+
+// primarySelector prefers the primary client and falls back to the first healthy client
+type primarySelector struct{}
+
+func (s *primarySelector) Select(healthy []*Client, primary *Client) *Client {
+	if primary != nil {
+		return primary
+	}
+	return healthy[0]
+}
+
+// highestHeadSelector picks the client that last reported the highest head slot,
+// preferring the primary on ties
+type highestHeadSelector struct{}
+
+func (s *highestHeadSelector) Select(healthy []*Client, primary *Client) *Client {
+	best := primary
+	if best == nil {
+		best = healthy[0]
+	}
+	for _, client := range healthy {
+		if client.GetHeadSlot() > best.GetHeadSlot() {
+			best = client
+		}
+	}
+	return best
+}
+
+// lowestLatencySelector picks the client with the lowest average request time.
+// Clients without samples count as fastest so every client gets measured.
+type lowestLatencySelector struct{}
+
+func (s *lowestLatencySelector) Select(healthy []*Client, primary *Client) *Client {
+	best := primary
+	if best == nil {
+		best = healthy[0]
+	}
+	for _, client := range healthy {
+		if client.GetLatency() < best.GetLatency() {
+			best = client
+		}
+	}
+	return best
+}
+
+// roundRobinSelector cycles through the healthy clients to spread bulk load
+type roundRobinSelector struct {
+	next atomic.Uint64
+}
+
+func (s *roundRobinSelector) Select(healthy []*Client, primary *Client) *Client {
+	return healthy[s.next.Add(1)%uint64(len(healthy))]
+}
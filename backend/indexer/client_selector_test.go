@@ -0,0 +1,179 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// fakeClient is the state of a client in a selector test, the pool never sends requests
+type fakeClient struct {
+	name     string
+	status   string
+	headSlot uint64
+	latency  time.Duration
+}
+
+// newFakePool creates a pool of clients in the given state using the selection strategy
+// for regular and bulk requests, the first client is the primary
+func newFakePool(t *testing.T, strategy string, clients ...fakeClient) *ClientPool {
+	t.Helper()
+
+	endpoints := make([]types.EndpointConfig, len(clients))
+	for i, client := range clients {
+		endpoints[i] = types.EndpointConfig{Name: client.name, Url: "http://localhost:1"}
+	}
+	config := &types.IndexerConfig{
+		ClientSelection:         strategy,
+		BulkClientSelection:     strategy,
+		BreakerFailureThreshold: 1,
+		BreakerBaseBackoff:      time.Second,
+		BreakerMaxBackoff:       time.Second,
+	}
+	pool := NewClientPool(endpoints, config, SystemClock, nil, logrus.New())
+
+	for i, client := range pool.GetAllClients() {
+		client.status = clients[i].status
+		client.latencyEWMA = clients[i].latency
+		if clients[i].headSlot > 0 {
+			client.head = &types.BlockHeader{Slot: clients[i].headSlot}
+		}
+		if client.status == StatusUnreachable {
+			client.isHealthy = false
+		}
+	}
+	return pool
+}
+
+func TestClientSelectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy string
+		clients  []fakeClient
+		expected []string // Clients selected by consecutive requests, empty for none
+	}{
+		{
+			name:     "primary",
+			strategy: types.ClientSelectionPrimary,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy},
+				{name: "ream-0", status: StatusHealthy},
+			},
+			expected: []string{"zeam-0", "zeam-0"},
+		},
+		{
+			name:     "primary falls back to the first healthy client",
+			strategy: types.ClientSelectionPrimary,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusUnreachable},
+				{name: "ream-0", status: StatusSyncing},
+				{name: "qlean-0", status: StatusHealthy},
+				{name: "lantern-0", status: StatusHealthy},
+			},
+			expected: []string{"qlean-0"},
+		},
+		{
+			name:     "primary falls back to a syncing client",
+			strategy: types.ClientSelectionPrimary,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusForked},
+				{name: "ream-0", status: StatusStalled},
+				{name: "qlean-0", status: StatusSyncing},
+			},
+			expected: []string{"qlean-0"},
+		},
+		{
+			name:     "no selectable client",
+			strategy: types.ClientSelectionPrimary,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusForked},
+				{name: "ream-0", status: StatusUnreachable},
+			},
+			expected: []string{""},
+		},
+		{
+			name:     "highest head",
+			strategy: types.ClientSelectionHighestHead,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy, headSlot: 10},
+				{name: "ream-0", status: StatusHealthy, headSlot: 12},
+				{name: "qlean-0", status: StatusHealthy, headSlot: 11},
+			},
+			expected: []string{"ream-0"},
+		},
+		{
+			name:     "highest head prefers the primary on ties",
+			strategy: types.ClientSelectionHighestHead,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy, headSlot: 12},
+				{name: "ream-0", status: StatusHealthy, headSlot: 12},
+			},
+			expected: []string{"zeam-0"},
+		},
+		{
+			name:     "highest head among healthy clients only",
+			strategy: types.ClientSelectionHighestHead,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy, headSlot: 10},
+				{name: "ream-0", status: StatusForked, headSlot: 20},
+				{name: "qlean-0", status: StatusHealthy, headSlot: 11},
+			},
+			expected: []string{"qlean-0"},
+		},
+		{
+			name:     "lowest latency",
+			strategy: types.ClientSelectionLowestLatency,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy, latency: 80 * time.Millisecond},
+				{name: "ream-0", status: StatusHealthy, latency: 20 * time.Millisecond},
+				{name: "qlean-0", status: StatusHealthy, latency: 40 * time.Millisecond},
+			},
+			expected: []string{"ream-0"},
+		},
+		{
+			name:     "unmeasured client is tried first",
+			strategy: types.ClientSelectionLowestLatency,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy, latency: 80 * time.Millisecond},
+				{name: "ream-0", status: StatusHealthy, latency: 20 * time.Millisecond},
+				{name: "qlean-0", status: StatusHealthy},
+			},
+			expected: []string{"qlean-0"},
+		},
+		{
+			name:     "round robin",
+			strategy: types.ClientSelectionRoundRobin,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy},
+				{name: "ream-0", status: StatusHealthy},
+				{name: "qlean-0", status: StatusHealthy},
+			},
+			expected: []string{"ream-0", "qlean-0", "zeam-0", "ream-0"},
+		},
+		{
+			name:     "round robin skips unhealthy clients",
+			strategy: types.ClientSelectionRoundRobin,
+			clients: []fakeClient{
+				{name: "zeam-0", status: StatusHealthy},
+				{name: "ream-0", status: StatusUnreachable},
+				{name: "qlean-0", status: StatusHealthy},
+			},
+			expected: []string{"qlean-0", "zeam-0", "qlean-0"},
+		},
+	}
+	for _, test := range tests {
+		pool := newFakePool(t, test.strategy, test.clients...)
+		for i, expected := range test.expected {
+			name := ""
+			if client := pool.GetHealthyClient(); client != nil {
+				name = client.GetConfig().Name
+			}
+			if name != expected {
+				t.Errorf("%s: request %d was served by %q, want %q", test.name, i+1, name, expected)
+			}
+		}
+	}
+}
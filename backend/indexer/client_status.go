@@ -0,0 +1,145 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Client statuses derived from reachability and head progress
+const (
+	StatusHealthy     = "healthy"     // Reachable and at the network head
+	StatusSyncing     = "syncing"     // Reachable and advancing, but behind the network head
+	StatusStalled     = "stalled"     // Reachable, but its head stopped advancing while the network moved on
+	StatusForked      = "forked"      // Reachable, but its head conflicts with the indexed chain
+	StatusUnreachable = "unreachable" // Failing health checks or rejected by its circuit breaker
+)
+
+// StatusEvaluator classifies clients by comparing their heads against the slot clock,
+// the other clients and the indexed chain
+type StatusEvaluator struct {
+	slotClock *SlotClock
+
+	// syncingDistance is how many slots a client may trail the network head and still be healthy
+	syncingDistance uint64
+
+	// stallTimeout is how long a trailing client's head may stay unchanged before it is stalled
+	stallTimeout time.Duration
+}
+
+// NewStatusEvaluator creates a status evaluator from the indexer config
+func NewStatusEvaluator(slotClock *SlotClock, config *types.IndexerConfig, slotDuration time.Duration) *StatusEvaluator {
+	return &StatusEvaluator{
+		slotClock:       slotClock,
+		syncingDistance: config.SyncingDistance,
+		stallTimeout:    time.Duration(config.StallSlots) * slotDuration,
+	}
+}
+
+// Evaluate updates the status of every client
+func (se *StatusEvaluator) Evaluate(clients []*Client) {
+	now := se.slotClock.Now()
+
+	// The network head is the highest head among reachable clients or the wall clock slot
+	var networkHead uint64
+	for _, client := range clients {
+		if client.IsReachable() {
+			networkHead = max(networkHead, client.GetHeadSlot())
+		}
+	}
+	if wallClockSlot, ok := se.slotClock.SlotAt(now); ok {
+		networkHead = max(networkHead, wallClockSlot)
+	}
+
+	for _, client := range clients {
+		status, reason := se.evaluateClient(client, networkHead, now)
+		client.setStatus(status, reason)
+	}
+}
+
+// evaluateClient classifies a single client against the network head
+func (se *StatusEvaluator) evaluateClient(client *Client, networkHead uint64, now time.Time) (string, string) {
+	if !client.IsReachable() {
+		reason := "health check failed"
+		if err := client.GetLastError(); err != nil {
+			reason = err.Error()
+		}
+		if breaker := client.GetBreakerStatus(); breaker.State != BreakerClosed {
+			reason = fmt.Sprintf("circuit breaker %s: %s", breaker.State, breaker.LastFailure)
+		}
+		return StatusUnreachable, reason
+	}
+
+	head, advancedAt := client.GetHead()
+	if head == nil {
+		return StatusHealthy, ""
+	}
+
+	if forked, reason := se.isForked(head); forked {
+		return StatusForked, reason
+	}
+
+	var behind uint64
+	if networkHead > head.Slot {
+		behind = networkHead - head.Slot
+	}
+	if behind <= se.syncingDistance {
+		return StatusHealthy, ""
+	}
+
+	if unchanged := now.Sub(advancedAt); unchanged > se.stallTimeout {
+		return StatusStalled, fmt.Sprintf("head stuck at slot %d for %s, %d slots behind", head.Slot, unchanged.Round(time.Second), behind)
+	}
+	return StatusSyncing, fmt.Sprintf("%d slots behind", behind)
+}
+
+// isForked reports whether the client's head conflicts with the indexed block at the same slot,
+// or builds on another block than the indexed block of the slot before if the indexer has not
+// reached the head yet
+func (se *StatusEvaluator) isForked(head *types.BlockHeader) (bool, string) {
+	stored, err := db.GetBlockHeaderBySlot(head.Slot)
+	if err != nil {
+		return false, ""
+	}
+	if stored == nil {
+		return se.isParentForked(head)
+	}
+
+	headRoot, err := head.HashTreeRoot()
+	if err != nil {
+		return false, ""
+	}
+	storedRoot, err := stored.HashTreeRoot()
+	if err != nil {
+		return false, ""
+	}
+
+	if bytes.Equal(headRoot[:], storedRoot[:]) {
+		return false, ""
+	}
+	return true, fmt.Sprintf("head at slot %d is 0x%x, indexed block is 0x%x", head.Slot, headRoot[:4], storedRoot[:4])
+}
+
+// isParentForked reports whether the head's parent conflicts with the indexed block at the slot
+// right before the head, which must be its parent
+func (se *StatusEvaluator) isParentForked(head *types.BlockHeader) (bool, string) {
+	if head.Slot == 0 {
+		return false, ""
+	}
+	parent, err := db.GetBlockHeaderBySlot(head.Slot - 1)
+	if err != nil || parent == nil {
+		return false, ""
+	}
+
+	parentRoot, err := parent.HashTreeRoot()
+	if err != nil {
+		return false, ""
+	}
+	if bytes.Equal(head.ParentRoot, parentRoot[:]) {
+		return false, ""
+	}
+	return true, fmt.Sprintf("head at slot %d builds on 0x%x, indexed block at slot %d is 0x%x", head.Slot, head.ParentRoot[:4], parent.Slot, parentRoot[:4])
+}
@@ -0,0 +1,105 @@
+package indexer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestStatusEvaluatorClassifiesClients(t *testing.T) {
+	initTestDB(t)
+
+	// Slots 1 and 2 are indexed
+	b1 := childHeader(t, 1, 1, nil)
+	b2 := childHeader(t, 2, 2, b1)
+	if err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlockHeaderBatch([]*types.BlockHeader{b1, b2}, make([]string, 2), tx)
+	}); err != nil {
+		t.Fatalf("storing headers: %v", err)
+	}
+	conflicting := childHeader(t, 2, 2, b1)
+	conflicting.BodyRoot = bytes.Repeat([]byte{0x01}, 32)
+	offChain := childHeader(t, 3, 3, nil)
+
+	// Slots of 4s with the wall clock at slot 20. Clients may trail the network head by
+	// 2 slots, and a trailing head is stalled once unchanged for 3 slots.
+	const slotDuration = 4 * time.Second
+	genesis := time.Unix(1_700_000_000, 0)
+	clock := &manualClock{now: genesis.Add(20 * slotDuration)}
+	evaluator := NewStatusEvaluator(NewSlotClock(uint64(genesis.Unix()), slotDuration, clock),
+		&types.IndexerConfig{SyncingDistance: 2, StallSlots: 3}, slotDuration)
+
+	// statusClient is a client with a head reported the given time ago
+	type statusClient struct {
+		name        string
+		unreachable bool
+		head        *types.BlockHeader
+		advancedAgo time.Duration
+		expected    string
+	}
+	atSlot := func(slot uint64) *types.BlockHeader { return childHeader(t, slot, slot%4, nil) }
+
+	tests := []struct {
+		name    string
+		clients []statusClient
+	}{
+		{
+			name: "against the wall clock",
+			clients: []statusClient{
+				{name: "at-head", head: atSlot(20), expected: StatusHealthy},
+				{name: "within-distance", head: atSlot(18), advancedAgo: time.Hour, expected: StatusHealthy},
+				{name: "syncing", head: atSlot(15), advancedAgo: slotDuration, expected: StatusSyncing},
+				{name: "at-stall-timeout", head: atSlot(15), advancedAgo: 3 * slotDuration, expected: StatusSyncing},
+				{name: "stalled", head: atSlot(15), advancedAgo: 3*slotDuration + time.Second, expected: StatusStalled},
+				{name: "conflicting", head: conflicting, expected: StatusForked},
+				{name: "off-chain", head: offChain, expected: StatusForked},
+				{name: "indexed", head: b2, advancedAgo: time.Second, expected: StatusSyncing},
+				{name: "no-head", expected: StatusHealthy},
+				{name: "unreachable", unreachable: true, head: atSlot(20), expected: StatusUnreachable},
+			},
+		},
+		{
+			// A reachable client ahead of the wall clock sets the network head
+			name: "against a client ahead",
+			clients: []statusClient{
+				{name: "ahead", head: atSlot(30), expected: StatusHealthy},
+				{name: "at-wall-clock", head: atSlot(20), advancedAgo: slotDuration, expected: StatusSyncing},
+				{name: "within-distance", head: atSlot(28), expected: StatusHealthy},
+			},
+		},
+		{
+			// Unreachable clients do not move the network head
+			name: "against an unreachable client ahead",
+			clients: []statusClient{
+				{name: "unreachable", unreachable: true, head: atSlot(30), expected: StatusUnreachable},
+				{name: "at-wall-clock", head: atSlot(20), advancedAgo: time.Hour, expected: StatusHealthy},
+			},
+		},
+	}
+	for _, test := range tests {
+		fakes := make([]fakeClient, len(test.clients))
+		for i, client := range test.clients {
+			fakes[i] = fakeClient{name: client.name, status: StatusHealthy}
+			if client.unreachable {
+				fakes[i].status = StatusUnreachable
+			}
+		}
+		clients := newFakePool(t, types.ClientSelectionPrimary, fakes...).GetAllClients()
+		for i, client := range test.clients {
+			clients[i].head = client.head
+			clients[i].headChanged = clock.now.Add(-client.advancedAgo)
+		}
+
+		evaluator.Evaluate(clients)
+		for i, client := range test.clients {
+			if status, reason := clients[i].GetStatus(); status != client.expected {
+				t.Errorf("%s: %s is %s (%s), want %s", test.name, client.name, status, reason, client.expected)
+			}
+		}
+	}
+}
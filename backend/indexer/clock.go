@@ -0,0 +1,90 @@
+package indexer
+
+import (
+	"time"
+)
+
+// Clock is the time source of the indexer. Replays run the indexer on a VirtualClock so
+// recorded traffic is served at the time it was recorded.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+	AfterFunc(d time.Duration, f func()) *time.Timer
+	After(d time.Duration) <-chan time.Time
+}
+
+// Ticker delivers ticks at intervals of its clock
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+	Reset(d time.Duration)
+}
+
+// SystemClock is the wall clock
+var SystemClock Clock = &VirtualClock{speed: 1}
+
+// VirtualClock runs from an origin time at a multiple of wall clock speed. Durations passed
+// to it are in virtual time, a 4s ticker at speed 2 ticks every 2s of wall clock time.
+type VirtualClock struct {
+	origin  time.Time // Virtual time at start, zero for the wall clock
+	started time.Time
+	speed   float64
+}
+
+// NewVirtualClock creates a clock that starts at origin and runs speed times as fast as the wall clock
+func NewVirtualClock(origin time.Time, speed float64) *VirtualClock {
+	return &VirtualClock{
+		origin:  origin,
+		started: time.Now(),
+		speed:   speed,
+	}
+}
+
+// Now returns the current virtual time
+func (vc *VirtualClock) Now() time.Time {
+	if vc.origin.IsZero() {
+		return time.Now()
+	}
+	return vc.origin.Add(time.Duration(float64(time.Since(vc.started)) * vc.speed))
+}
+
+// NewTicker creates a ticker with a period of d virtual time
+func (vc *VirtualClock) NewTicker(d time.Duration) Ticker {
+	return &scaledTicker{
+		ticker: time.NewTicker(vc.wallDuration(d)),
+		clock:  vc,
+	}
+}
+
+// AfterFunc calls f after d virtual time
+func (vc *VirtualClock) AfterFunc(d time.Duration, f func()) *time.Timer {
+	return time.AfterFunc(vc.wallDuration(d), f)
+}
+
+// After delivers the time after d virtual time
+func (vc *VirtualClock) After(d time.Duration) <-chan time.Time {
+	return time.After(vc.wallDuration(d))
+}
+
+// wallDuration converts a virtual duration to wall clock time
+func (vc *VirtualClock) wallDuration(d time.Duration) time.Duration {
+	return time.Duration(float64(d) / vc.speed)
+}
+
+// scaledTicker is a wall clock ticker whose period is set in virtual time
+type scaledTicker struct {
+	ticker *time.Ticker
+	clock  *VirtualClock
+}
+
+func (st *scaledTicker) C() <-chan time.Time {
+	return st.ticker.C
+}
+
+func (st *scaledTicker) Stop() {
+	st.ticker.Stop()
+}
+
+func (st *scaledTicker) Reset(d time.Duration) {
+	st.ticker.Reset(st.clock.wallDuration(d))
+}
@@ -0,0 +1,353 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+	"github.com/syjn99/leanView/backend/utils"
+)
+
+// ErrReplaying is returned for endpoint changes while a traffic archive is replayed,
+// whose endpoints are fixed by the recording
+var ErrReplaying = errors.New("endpoints cannot be changed while replaying recorded traffic")
+
+// ErrInlineSecret is returned when adding an endpoint whose auth secret or header value is
+// set inline, secrets of runtime endpoints are only stored as file or environment references
+var ErrInlineSecret = errors.New("runtime endpoint secrets must be given as a file or environment variable")
+
+// endpointOptions encodes the options of a runtime endpoint for its record, with the
+// secrets as their file or environment references. Empty if the endpoint has none.
+func endpointOptions(endpoint types.EndpointConfig) (string, error) {
+	auth := endpoint.Auth
+	if auth.Password != "" && auth.PasswordFile == "" && auth.PasswordEnv == "" {
+		return "", fmt.Errorf("%w: password of endpoint %s", ErrInlineSecret, endpoint.Name)
+	}
+	if auth.BearerToken != "" && auth.BearerTokenFile == "" && auth.BearerTokenEnv == "" {
+		return "", fmt.Errorf("%w: bearer token of endpoint %s", ErrInlineSecret, endpoint.Name)
+	}
+	// Header values often carry API keys, so none is stored inline
+	for name := range endpoint.Headers {
+		if endpoint.HeaderFiles[name] == "" && endpoint.HeaderEnv[name] == "" {
+			return "", fmt.Errorf("%w: header %s of endpoint %s", ErrInlineSecret, name, endpoint.Name)
+		}
+	}
+
+	options := endpoint
+	options.Name, options.Url = "", ""
+	options.Auth.Password, options.Auth.BearerToken = "", ""
+	options.Headers = nil
+	if len(options.HeaderFiles) == 0 {
+		options.HeaderFiles = nil
+	}
+	if len(options.HeaderEnv) == 0 {
+		options.HeaderEnv = nil
+	}
+	if reflect.DeepEqual(options, types.EndpointConfig{}) {
+		return "", nil
+	}
+
+	data, err := yaml.Marshal(options)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode options of endpoint %s: %w", endpoint.Name, err)
+	}
+	return string(data), nil
+}
+
+// endpointFromRecord rebuilds a runtime endpoint from its record and loads its secrets
+func endpointFromRecord(record *types.EndpointRecord) (types.EndpointConfig, error) {
+	endpoint := types.EndpointConfig{}
+	if record.Options != "" {
+		if err := yaml.Unmarshal([]byte(record.Options), &endpoint); err != nil {
+			return endpoint, fmt.Errorf("failed to decode options of endpoint %s: %w", record.Name, err)
+		}
+	}
+	endpoint.Name = record.Name
+	endpoint.Url = record.Url
+
+	if err := utils.ResolveEndpointSecrets(&endpoint); err != nil {
+		return endpoint, err
+	}
+	return endpoint, nil
+}
+
+// mergeEndpoints applies the stored runtime changes on top of the configured endpoints and
+// returns the resulting endpoint list along with the name of the stored primary, if any.
+// Runtime endpoints that cannot be restored are skipped with a warning.
+func mergeEndpoints(configured []types.EndpointConfig, records []*types.EndpointRecord, logger logrus.FieldLogger) ([]types.EndpointConfig, string) {
+	endpoints := make([]types.EndpointConfig, len(configured))
+	copy(endpoints, configured)
+
+	indexOf := func(name string) int {
+		for i, endpoint := range endpoints {
+			if endpoint.Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	primary := ""
+	for _, record := range records {
+		idx := indexOf(record.Name)
+
+		if record.Removed {
+			if idx >= 0 {
+				endpoints = append(endpoints[:idx], endpoints[idx+1:]...)
+			}
+			continue
+		}
+
+		// Endpoints added at runtime carry their own definition
+		if !record.FromConfig {
+			endpoint, err := endpointFromRecord(record)
+			if err != nil {
+				logger.WithError(err).WithField("endpoint", record.Name).Warn("Failed to restore runtime endpoint, skipping it")
+				continue
+			}
+			if idx >= 0 {
+				endpoints[idx] = endpoint
+			} else {
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+
+		if record.IsPrimary {
+			primary = record.Name
+		}
+	}
+
+	if primary != "" && indexOf(primary) < 0 {
+		primary = ""
+	}
+
+	return endpoints, primary
+}
+
+// loadEndpoints returns the configured endpoints merged with the changes stored in the database
+func (i *Indexer) loadEndpoints() ([]types.EndpointConfig, string) {
+	records, err := db.GetEndpointRecords()
+	if err != nil {
+		i.logger.WithError(err).Warn("Failed to load stored endpoints, using configured endpoints only")
+		return i.configEndpoints, ""
+	}
+
+	endpoints, primary := mergeEndpoints(i.configEndpoints, records, i.logger)
+	if len(endpoints) == 0 {
+		i.logger.Warn("Stored endpoint changes remove every endpoint, using configured endpoints only")
+		return i.configEndpoints, ""
+	}
+	return endpoints, primary
+}
+
+// IsConfiguredEndpoint reports whether the endpoint name is defined in the config file
+func (i *Indexer) IsConfiguredEndpoint(name string) bool {
+	i.endpointsMutex.Lock()
+	defer i.endpointsMutex.Unlock()
+	return i.isConfiguredEndpoint(name)
+}
+
+// isConfiguredEndpoint is IsConfiguredEndpoint for callers holding the endpoints mutex
+func (i *Indexer) isConfiguredEndpoint(name string) bool {
+	for _, endpoint := range i.configEndpoints {
+		if endpoint.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AddEndpoint adds an endpoint to the client pool and persists it. Auth secrets must be
+// file or environment references, which are loaded here and again after a restart. The
+// client is created first, so an endpoint whose client cannot be created is never stored.
+func (i *Indexer) AddEndpoint(endpoint types.EndpointConfig) error {
+	i.endpointsMutex.Lock()
+	defer i.endpointsMutex.Unlock()
+
+	if i.replayer != nil {
+		return ErrReplaying
+	}
+
+	options, err := endpointOptions(endpoint)
+	if err != nil {
+		return err
+	}
+	if err := utils.ResolveEndpointSecrets(&endpoint); err != nil {
+		return err
+	}
+
+	if _, err := i.clientPool.AddClient(endpoint); err != nil {
+		return err
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.UpsertEndpointRecord(&types.EndpointRecord{
+			Name:      endpoint.Name,
+			Url:       endpoint.Url,
+			Options:   options,
+			UpdatedAt: time.Now().UnixMilli(),
+		}, tx)
+	})
+	if err != nil {
+		// Keep the pool in sync with the stored endpoints
+		if removeErr := i.clientPool.RemoveClient(endpoint.Name); removeErr != nil {
+			i.logger.WithError(removeErr).WithField("endpoint", endpoint.Name).Warn("Failed to remove unpersisted endpoint")
+		}
+		return fmt.Errorf("failed to persist endpoint %s: %w", endpoint.Name, err)
+	}
+	return nil
+}
+
+// RemoveEndpoint removes an endpoint from the client pool and persists the removal
+func (i *Indexer) RemoveEndpoint(name string) error {
+	i.endpointsMutex.Lock()
+	defer i.endpointsMutex.Unlock()
+
+	if i.replayer != nil {
+		return ErrReplaying
+	}
+
+	client := i.clientPool.GetClientByName(name)
+	if client == nil {
+		return fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+	}
+	if i.clientPool.GetClientCount() == 1 {
+		return ErrLastEndpoint
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		// Runtime endpoints are forgotten, configured endpoints need a tombstone
+		if !i.isConfiguredEndpoint(name) {
+			return db.DeleteEndpointRecord(name, tx)
+		}
+		return db.UpsertEndpointRecord(&types.EndpointRecord{
+			Name:       name,
+			Url:        client.GetConfig().Url,
+			FromConfig: true,
+			Removed:    true,
+			UpdatedAt:  time.Now().UnixMilli(),
+		}, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to persist removal of endpoint %s: %w", name, err)
+	}
+
+	return i.clientPool.RemoveClient(name)
+}
+
+// SetPrimaryEndpoint makes an endpoint the primary client and persists the choice
+func (i *Indexer) SetPrimaryEndpoint(name string) error {
+	i.endpointsMutex.Lock()
+	defer i.endpointsMutex.Unlock()
+
+	if i.replayer != nil {
+		return ErrReplaying
+	}
+
+	client := i.clientPool.GetClientByName(name)
+	if client == nil {
+		return fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+	}
+
+	record, err := db.GetEndpointRecord(name)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		record = &types.EndpointRecord{
+			Name:       name,
+			Url:        client.GetConfig().Url,
+			FromConfig: true,
+		}
+	}
+	record.IsPrimary = true
+	record.UpdatedAt = time.Now().UnixMilli()
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		if err := db.ClearPrimaryEndpoint(tx); err != nil {
+			return err
+		}
+		return db.UpsertEndpointRecord(record, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to persist primary endpoint %s: %w", name, err)
+	}
+
+	return i.clientPool.SetPrimary(name)
+}
+
+// ReloadEndpoints applies a new list of configured endpoints to the live client pool.
+// Stored runtime changes still apply on top, unchanged clients keep their state.
+func (i *Indexer) ReloadEndpoints(configured []types.EndpointConfig) error {
+	i.endpointsMutex.Lock()
+	defer i.endpointsMutex.Unlock()
+
+	if i.replayer != nil {
+		return ErrReplaying
+	}
+
+	records, err := db.GetEndpointRecords()
+	if err != nil {
+		return err
+	}
+
+	endpoints, primary := mergeEndpoints(configured, records, i.logger)
+	if len(endpoints) == 0 {
+		return fmt.Errorf("reloaded config leaves no endpoints")
+	}
+	i.configEndpoints = configured
+
+	// Add and update first so the pool is never left empty
+	wanted := make(map[string]bool, len(endpoints))
+	added, updated, removed := 0, 0, 0
+	for _, endpoint := range endpoints {
+		wanted[endpoint.Name] = true
+
+		existing := i.clientPool.GetClientByName(endpoint.Name)
+		switch {
+		case existing == nil:
+			if _, err := i.clientPool.AddClient(endpoint); err != nil {
+				return err
+			}
+			added++
+		case !reflect.DeepEqual(*existing.GetConfig(), endpoint):
+			if err := i.clientPool.UpdateClient(endpoint); err != nil {
+				return err
+			}
+			updated++
+		}
+	}
+
+	for _, client := range i.clientPool.GetAllClients() {
+		name := client.GetConfig().Name
+		if wanted[name] {
+			continue
+		}
+		if err := i.clientPool.RemoveClient(name); err != nil {
+			return err
+		}
+		removed++
+	}
+
+	// Like at startup, the first endpoint is primary unless one was chosen at runtime
+	if primary == "" {
+		primary = endpoints[0].Name
+	}
+	if err := i.clientPool.SetPrimary(primary); err != nil {
+		return err
+	}
+
+	i.logger.WithFields(logrus.Fields{
+		"added":   added,
+		"updated": updated,
+		"removed": removed,
+	}).Info("Endpoints reloaded")
+	return nil
+}
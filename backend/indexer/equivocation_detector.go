@@ -0,0 +1,256 @@
+package indexer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// equivocationWindowSlots is how many slots behind the latest observed slot headers and
+// votes are kept for comparison
+const equivocationWindowSlots = 1024
+
+// proposalKey identifies the block a proposer may sign for a slot
+type proposalKey struct {
+	slot     uint64
+	proposer uint64
+}
+
+// observedHeader is the first header seen for a slot and proposer
+type observedHeader struct {
+	header *types.BlockHeader
+	root   [32]byte
+	client string
+}
+
+// observedVote is a distinct vote of a validator
+type observedVote struct {
+	vote   *types.Vote
+	root   [32]byte
+	client string
+}
+
+// EquivocationDetector compares every header observed from any client with the others of
+// the same slot and proposer, and every ingested vote with the other votes of the same
+// validator. Double proposals, double votes and surround votes are stored in the
+// equivocations table and raise an alert the first time they are found.
+type EquivocationDetector struct {
+	proposals   map[proposalKey]*observedHeader
+	votes       map[uint64][]*observedVote // By validator id
+	highestSlot uint64
+
+	alerter *Alerter
+	clock   Clock
+	mutex   sync.Mutex
+	logger  logrus.FieldLogger
+}
+
+// NewEquivocationDetector creates a detector that alerts through the alerter
+func NewEquivocationDetector(alerter *Alerter, clock Clock, logger logrus.FieldLogger) *EquivocationDetector {
+	return &EquivocationDetector{
+		proposals: make(map[proposalKey]*observedHeader),
+		votes:     make(map[uint64][]*observedVote),
+		alerter:   alerter,
+		clock:     clock,
+		logger:    logger.WithField("component", "equivocation_detector"),
+	}
+}
+
+// ObserveHeaders compares headers a client returned with the headers observed earlier for
+// the same slot and proposer
+func (ed *EquivocationDetector) ObserveHeaders(client string, headers ...*types.BlockHeader) {
+	var found []*types.Equivocation
+
+	ed.mutex.Lock()
+	for _, header := range headers {
+		if header == nil {
+			continue
+		}
+		root, err := header.HashTreeRoot()
+		if err != nil {
+			continue
+		}
+		ed.advance(header.Slot)
+
+		key := proposalKey{slot: header.Slot, proposer: header.ProposerIndex}
+		first, ok := ed.proposals[key]
+		if !ok {
+			if header.Slot+equivocationWindowSlots > ed.highestSlot {
+				ed.proposals[key] = &observedHeader{header: header, root: root, client: client}
+			}
+			continue
+		}
+		if first.root == root {
+			continue
+		}
+
+		evidenceA, errA := first.header.MarshalSSZ()
+		evidenceB, errB := header.MarshalSSZ()
+		if errA != nil || errB != nil {
+			continue
+		}
+		found = append(found, &types.Equivocation{
+			Kind:        types.EquivocationDoubleProposal,
+			ValidatorId: header.ProposerIndex,
+			Slot:        header.Slot,
+			RootA:       first.root[:],
+			RootB:       root[:],
+			EvidenceA:   evidenceA,
+			EvidenceB:   evidenceB,
+			ClientA:     first.client,
+			ClientB:     client,
+		})
+	}
+	ed.mutex.Unlock()
+
+	ed.record(found)
+}
+
+// ObserveVotes compares votes included in a block a client served with the earlier votes
+// of the same validators
+func (ed *EquivocationDetector) ObserveVotes(client string, votes []*types.Vote) {
+	var found []*types.Equivocation
+
+	ed.mutex.Lock()
+	for _, vote := range votes {
+		if vote == nil || vote.Source == nil || vote.Target == nil {
+			continue
+		}
+		root, err := vote.HashTreeRoot()
+		if err != nil {
+			continue
+		}
+		ed.advance(vote.Slot)
+
+		observed := &observedVote{vote: vote, root: root, client: client}
+		known := false
+		for _, earlier := range ed.votes[vote.ValidatorId] {
+			if earlier.root == root {
+				known = true
+				break
+			}
+			if equivocation := conflictingVotes(earlier, observed); equivocation != nil {
+				found = append(found, equivocation)
+			}
+		}
+		if !known {
+			ed.votes[vote.ValidatorId] = append(ed.votes[vote.ValidatorId], observed)
+		}
+	}
+	ed.mutex.Unlock()
+
+	ed.record(found)
+}
+
+// conflictingVotes returns the equivocation two distinct votes of a validator form, nil if
+// they do not conflict. A vote surrounds another if its source is earlier and its target later.
+func conflictingVotes(earlier, later *observedVote) *types.Equivocation {
+	a, b := earlier, later
+	var kind string
+	switch {
+	case a.vote.Slot == b.vote.Slot:
+		kind = types.EquivocationDoubleVote
+	case a.vote.Source.Slot < b.vote.Source.Slot && b.vote.Target.Slot < a.vote.Target.Slot:
+		kind = types.EquivocationSurroundVote
+	case b.vote.Source.Slot < a.vote.Source.Slot && a.vote.Target.Slot < b.vote.Target.Slot:
+		kind = types.EquivocationSurroundVote
+		a, b = later, earlier
+	default:
+		return nil
+	}
+
+	evidenceA, errA := a.vote.MarshalSSZ()
+	evidenceB, errB := b.vote.MarshalSSZ()
+	if errA != nil || errB != nil {
+		return nil
+	}
+	return &types.Equivocation{
+		Kind:        kind,
+		ValidatorId: a.vote.ValidatorId,
+		Slot:        a.vote.Slot,
+		RootA:       a.root[:],
+		RootB:       b.root[:],
+		EvidenceA:   evidenceA,
+		EvidenceB:   evidenceB,
+		ClientA:     a.client,
+		ClientB:     b.client,
+	}
+}
+
+// advance moves the window to the slot and forgets headers and votes that fell out of it.
+// Must be called with mutex already locked
+func (ed *EquivocationDetector) advance(slot uint64) {
+	if slot <= ed.highestSlot {
+		return
+	}
+	ed.highestSlot = slot
+	if slot < equivocationWindowSlots {
+		return
+	}
+	oldest := slot - equivocationWindowSlots
+
+	for key := range ed.proposals {
+		if key.slot < oldest {
+			delete(ed.proposals, key)
+		}
+	}
+	for validator, votes := range ed.votes {
+		kept := votes[:0]
+		for _, observed := range votes {
+			if observed.vote.Slot >= oldest {
+				kept = append(kept, observed)
+			}
+		}
+		if len(kept) == 0 {
+			delete(ed.votes, validator)
+		} else {
+			ed.votes[validator] = kept
+		}
+	}
+}
+
+// record stores the equivocations and alerts on those not stored before
+func (ed *EquivocationDetector) record(equivocations []*types.Equivocation) {
+	if len(equivocations) == 0 {
+		return
+	}
+
+	detectedAt := ed.clock.Now().UnixMilli()
+	var inserted []*types.Equivocation
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		inserted = inserted[:0]
+		for _, equivocation := range equivocations {
+			equivocation.DetectedAt = detectedAt
+			isNew, err := db.InsertEquivocation(equivocation, tx)
+			if err != nil {
+				return err
+			}
+			if isNew {
+				inserted = append(inserted, equivocation)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ed.logger.WithError(err).Error("Failed to store equivocations")
+		return
+	}
+
+	for _, equivocation := range inserted {
+		ed.alerter.Send(equivocation.Kind,
+			fmt.Sprintf("Validator %d equivocated at slot %d", equivocation.ValidatorId, equivocation.Slot),
+			map[string]any{
+				"validator_id": equivocation.ValidatorId,
+				"slot":         equivocation.Slot,
+				"root_a":       fmt.Sprintf("0x%x", equivocation.RootA),
+				"root_b":       fmt.Sprintf("0x%x", equivocation.RootB),
+				"client_a":     equivocation.ClientA,
+				"client_b":     equivocation.ClientB,
+			})
+	}
+}
@@ -0,0 +1,69 @@
+package indexer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestConflictingVotes(t *testing.T) {
+	// observed is a vote of validator 7 at the slot for checkpoints at the head, target and source slots
+	observed := func(slot, head, target, source uint64, client string) *observedVote {
+		checkpoint := func(slot uint64) *types.Checkpoint {
+			return &types.Checkpoint{Root: bytes.Repeat([]byte{byte(slot)}, 32), Slot: slot}
+		}
+		vote := &types.Vote{ValidatorId: 7, Slot: slot, Head: checkpoint(head), Target: checkpoint(target), Source: checkpoint(source)}
+		root, err := vote.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("hashing vote: %v", err)
+		}
+		return &observedVote{vote: vote, root: root, client: client}
+	}
+
+	tests := []struct {
+		name           string
+		earlier, later *observedVote
+		kind           string // Empty if the votes do not conflict
+		earlierIsA     bool   // The earlier vote is evidence a
+	}{
+		{"double vote", observed(12, 11, 11, 6, "zeam-0"), observed(12, 10, 10, 6, "ream-0"), types.EquivocationDoubleVote, true},
+		{"earlier vote surrounds", observed(20, 19, 19, 2, "zeam-0"), observed(12, 11, 11, 6, "ream-0"), types.EquivocationSurroundVote, true},
+		{"later vote surrounds", observed(12, 11, 11, 6, "zeam-0"), observed(20, 19, 19, 2, "ream-0"), types.EquivocationSurroundVote, false},
+		{"consecutive votes", observed(12, 11, 11, 6, "zeam-0"), observed(16, 15, 15, 11, "ream-0"), "", false},
+		{"same source", observed(12, 11, 11, 6, "zeam-0"), observed(20, 19, 19, 6, "ream-0"), "", false},
+		{"same target", observed(12, 11, 11, 2, "zeam-0"), observed(13, 12, 11, 6, "ream-0"), "", false},
+	}
+	for _, test := range tests {
+		equivocation := conflictingVotes(test.earlier, test.later)
+		if test.kind == "" {
+			if equivocation != nil {
+				t.Errorf("%s: found a %s equivocation", test.name, equivocation.Kind)
+			}
+			continue
+		}
+		if equivocation == nil {
+			t.Errorf("%s: no equivocation found, want %s", test.name, test.kind)
+			continue
+		}
+
+		a, b := test.earlier, test.later
+		if !test.earlierIsA {
+			a, b = b, a
+		}
+		if equivocation.Kind != test.kind || equivocation.ValidatorId != 7 || equivocation.Slot != a.vote.Slot {
+			t.Errorf("%s: got %s of validator %d at slot %d, want %s of validator 7 at slot %d",
+				test.name, equivocation.Kind, equivocation.ValidatorId, equivocation.Slot, test.kind, a.vote.Slot)
+		}
+		if !bytes.Equal(equivocation.RootA, a.root[:]) || !bytes.Equal(equivocation.RootB, b.root[:]) ||
+			equivocation.ClientA != a.client || equivocation.ClientB != b.client {
+			t.Errorf("%s: evidence a is from %s and b from %s, want %s and %s",
+				test.name, equivocation.ClientA, equivocation.ClientB, a.client, b.client)
+		}
+
+		var evidence types.Vote
+		if err := evidence.UnmarshalSSZ(equivocation.EvidenceA); err != nil || evidence.Source.Slot != a.vote.Source.Slot {
+			t.Errorf("%s: evidence a does not decode to the vote: %v", test.name, err)
+		}
+	}
+}
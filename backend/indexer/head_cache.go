@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 
@@ -8,21 +9,34 @@ import (
 	"github.com/syjn99/leanView/backend/types"
 )
 
-const (
-	// MaxRecentBlocks is the maximum number of recent blocks to keep in cache
-	MaxRecentBlocks = 32
-)
-
 // CacheStats represents head cache statistics
 type CacheStats struct {
-	RecentBlocksCount int    `json:"recent_blocks_count"`
-	MaxRecentBlocks   int    `json:"max_recent_blocks"`
-	HasCurrentHead    bool   `json:"has_current_head"`
-	HasJustified      bool   `json:"has_justified"`
-	HasFinalized      bool   `json:"has_finalized"`
-	CurrentHeadSlot   uint64 `json:"current_head_slot,omitempty"`
-	JustifiedSlot     uint64 `json:"justified_slot,omitempty"`
-	FinalizedSlot     uint64 `json:"finalized_slot,omitempty"`
+	TreeBlocksCount     int    `json:"tree_blocks_count"`
+	MaxTreeBlocks       int    `json:"max_tree_blocks"`
+	LatestVotes         int    `json:"latest_votes"`
+	HasCurrentHead      bool   `json:"has_current_head"`
+	HasJustified        bool   `json:"has_justified"`
+	HasFinalized        bool   `json:"has_finalized"`
+	CurrentHeadSlot     uint64 `json:"current_head_slot,omitempty"`
+	JustifiedSlot       uint64 `json:"justified_slot,omitempty"`
+	FinalizedSlot       uint64 `json:"finalized_slot,omitempty"`
+	ReorgCount          uint64 `json:"reorg_count"`
+	UnlinkedHeadChanges uint64 `json:"unlinked_head_changes"`
+}
+
+// ForkChoiceNode is a block of the fork choice tree with its LMD-GHOST weight
+type ForkChoiceNode struct {
+	Root   []byte
+	Header *types.BlockHeader
+	Weight uint64 // Latest votes for this block or one of its descendants
+}
+
+// ForkChoiceTree is a snapshot of the block tree
+type ForkChoiceTree struct {
+	Nodes       []*ForkChoiceNode // Ordered by slot, parents before their children
+	Head        []byte            // LMD-GHOST head, nil if the tree is empty
+	Anchor      []byte            // Block LMD-GHOST started from
+	LatestVotes int               // Validators with a latest vote
 }
 
 // HeadCache maintains current chain head state aligned with Lean consensus
@@ -34,8 +48,13 @@ type HeadCache struct {
 	latestJustified *types.Checkpoint // Latest justified checkpoint
 	latestFinalized *types.Checkpoint // Latest finalized checkpoint
 
-	// Recent blocks for fork choice (LMD-GHOST)
-	recentBlocks map[string]*types.BlockHeader // root hex -> block
+	// Blocks since the finalized checkpoint and latest votes for fork choice (LMD-GHOST)
+	tree *blockTree
+
+	// Number of head changes that did not extend the previous head, and of head changes whose
+	// ancestry back to the previous head is not in the tree, e.g. across a catchup gap
+	reorgCount          uint64
+	unlinkedHeadChanges uint64
 
 	// Synchronization
 	mutex sync.RWMutex
@@ -46,18 +65,17 @@ type HeadCache struct {
 // NewHeadCache creates a new head cache
 func NewHeadCache(logger logrus.FieldLogger) *HeadCache {
 	return &HeadCache{
-		recentBlocks: make(map[string]*types.BlockHeader),
-		logger:       logger.WithField("component", "head_cache"),
+		tree:   newBlockTree(),
+		logger: logger.WithField("component", "head_cache"),
 	}
 }
 
-// UpdateHead updates the current head block and maintains recent blocks cache
+// UpdateHead updates the current head block and adds it to the block tree.
+// Blocks older than the current head, e.g. from catchup, are only added to the tree.
 func (hc *HeadCache) UpdateHead(block *types.BlockHeader) {
 	hc.mutex.Lock()
 	defer hc.mutex.Unlock()
 
-	hc.currentHead = block
-
 	// Calculate proper block root using SSZ
 	blockRoot, err := block.HashTreeRoot()
 	if err != nil {
@@ -65,21 +83,104 @@ func (hc *HeadCache) UpdateHead(block *types.BlockHeader) {
 		return
 	}
 
-	// Add to recent blocks cache using proper block root
-	rootHex := fmt.Sprintf("%x", blockRoot)
-	hc.recentBlocks[rootHex] = block
-
-	// Prune old blocks if we exceed the limit
-	if len(hc.recentBlocks) > MaxRecentBlocks {
-		hc.pruneOldBlocks()
+	node := hc.tree.add(blockRoot, block)
+
+	if hc.currentHead == nil || block.Slot >= hc.currentHead.Slot {
+		switch reorg, known := hc.isReorg(node); {
+		case !known:
+			hc.unlinkedHeadChanges++
+			hc.logger.WithFields(logrus.Fields{
+				"previous_head_slot": hc.currentHead.Slot,
+				"new_head_slot":      block.Slot,
+			}).Info("Head changed without known ancestry to the previous head")
+		case reorg:
+			hc.reorgCount++
+			hc.logger.WithFields(logrus.Fields{
+				"previous_head_slot": hc.currentHead.Slot,
+				"new_head_slot":      block.Slot,
+				"reorg_count":        hc.reorgCount,
+			}).Warn("Chain reorg detected")
+		}
+		hc.currentHead = block
 	}
 
+	rootHex := fmt.Sprintf("%x", blockRoot)
 	hc.logger.WithFields(logrus.Fields{
 		"slot":       block.Slot,
 		"block_root": rootHex[:8] + "...", // Log first 8 chars
 	}).Debug("Updated head cache with new block")
 }
 
+// AddBlock adds a block to the block tree without changing the current head
+func (hc *HeadCache) AddBlock(block *types.BlockHeader) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+
+	blockRoot, err := block.HashTreeRoot()
+	if err != nil {
+		hc.logger.WithError(err).WithField("slot", block.Slot).Error("Failed to calculate block root for block tree")
+		return
+	}
+	hc.tree.add(blockRoot, block)
+}
+
+// AddVotes records the votes of a block, a validator's vote counts until a vote with a later slot replaces it
+func (hc *HeadCache) AddVotes(votes []*types.Vote) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+
+	for _, vote := range votes {
+		hc.tree.addVote(vote)
+	}
+}
+
+// isReorg reports whether the new head does not descend from the current head, and whether
+// that is known. Ancestry is followed through the block tree, it is unknown if the current
+// head or a block in between is not in the tree.
+// Must be called with mutex already locked
+func (hc *HeadCache) isReorg(node *blockTreeNode) (reorg, known bool) {
+	if hc.currentHead == nil || node == nil {
+		return false, true
+	}
+
+	headRoot, err := hc.currentHead.HashTreeRoot()
+	if err != nil {
+		return false, false
+	}
+	if headRoot == node.root {
+		return false, true
+	}
+	head := hc.tree.get(headRoot)
+	if head == nil {
+		return false, false
+	}
+
+	// Walk down to the old head's slot, a missing link means the ancestry is unknown
+	current := node
+	for current.header.Slot > head.header.Slot {
+		if current.parent == nil {
+			return false, false
+		}
+		current = current.parent
+	}
+	return current != head, true
+}
+
+// GetReorgCount returns the number of reorgs observed since startup
+func (hc *HeadCache) GetReorgCount() uint64 {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	return hc.reorgCount
+}
+
+// GetUnlinkedHeadChanges returns the number of head changes since startup whose ancestry
+// back to the previous head was unknown, so they may have been reorgs
+func (hc *HeadCache) GetUnlinkedHeadChanges() uint64 {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	return hc.unlinkedHeadChanges
+}
+
 // GetCurrentHead returns the current head block (thread-safe)
 func (hc *HeadCache) GetCurrentHead() *types.BlockHeader {
 	hc.mutex.RLock()
@@ -107,6 +208,7 @@ func (hc *HeadCache) UpdateFinalized(checkpoint *types.Checkpoint) {
 	defer hc.mutex.Unlock()
 
 	hc.latestFinalized = checkpoint
+	hc.tree.setAnchor(checkpoint)
 
 	rootHex := fmt.Sprintf("%x", checkpoint.Root)
 	hc.logger.WithFields(logrus.Fields{
@@ -129,37 +231,91 @@ func (hc *HeadCache) GetFinalizedCheckpoint() *types.Checkpoint {
 	return hc.latestFinalized
 }
 
-// GetRecentBlocks returns a copy of recent blocks for fork choice
-func (hc *HeadCache) GetRecentBlocks() map[string]*types.BlockHeader {
+// GetBlock returns the block with the given root from the block tree, nil if it is not in the tree
+func (hc *HeadCache) GetBlock(root []byte) *types.BlockHeader {
+	if len(root) != 32 {
+		return nil
+	}
+
 	hc.mutex.RLock()
 	defer hc.mutex.RUnlock()
 
-	// Return a copy to avoid race conditions
-	recent := make(map[string]*types.BlockHeader, len(hc.recentBlocks))
-	for root, block := range hc.recentBlocks {
-		recent[root] = block
+	if node := hc.tree.get([32]byte(root)); node != nil {
+		return node.header
 	}
-	return recent
+	return nil
 }
 
-// AddRecentBlock adds a block to the recent blocks cache
-func (hc *HeadCache) AddRecentBlock(block *types.BlockHeader) {
-	hc.mutex.Lock()
-	defer hc.mutex.Unlock()
+// GetDescendantPath returns the blocks of the tree from the child of the given block to the
+// highest slot block descending from it, nil if the block has no children in the tree
+func (hc *HeadCache) GetDescendantPath(root []byte) []*types.BlockHeader {
+	if len(root) != 32 {
+		return nil
+	}
 
-	// Calculate proper block root using SSZ
-	blockRoot, err := block.HashTreeRoot()
-	if err != nil {
-		hc.logger.WithError(err).WithField("slot", block.Slot).Error("Failed to calculate block root for recent blocks")
-		return
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+
+	node := hc.tree.get([32]byte(root))
+	if node == nil {
+		return nil
 	}
 
-	rootHex := fmt.Sprintf("%x", blockRoot)
-	hc.recentBlocks[rootHex] = block
+	var headers []*types.BlockHeader
+	for _, descendant := range hc.tree.longestPath(node) {
+		headers = append(headers, descendant.header)
+	}
+	return headers
+}
+
+// GetForkChoiceHead returns the head LMD-GHOST selects from the justified block, or from the
+// tree's anchor if the justified block is not in the tree. It returns nil if the tree is empty.
+func (hc *HeadCache) GetForkChoiceHead() *types.BlockHeader {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+
+	start := hc.forkChoiceStart()
+	if start == nil {
+		return nil
+	}
+	return hc.tree.head(start, hc.tree.weights()).header
+}
+
+// GetForkChoiceTree returns a snapshot of the block tree with LMD-GHOST weights and head
+func (hc *HeadCache) GetForkChoiceTree() *ForkChoiceTree {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+
+	forkChoice := &ForkChoiceTree{LatestVotes: len(hc.tree.latestVotes)}
+	start := hc.forkChoiceStart()
+	if start == nil {
+		return forkChoice
+	}
 
-	if len(hc.recentBlocks) > MaxRecentBlocks {
-		hc.pruneOldBlocks()
+	weights := hc.tree.weights()
+	head := hc.tree.head(start, weights)
+	forkChoice.Head = bytes.Clone(head.root[:])
+	forkChoice.Anchor = bytes.Clone(start.root[:])
+
+	for _, node := range hc.tree.sortedNodes() {
+		forkChoice.Nodes = append(forkChoice.Nodes, &ForkChoiceNode{
+			Root:   bytes.Clone(node.root[:]),
+			Header: node.header,
+			Weight: weights[node],
+		})
 	}
+	return forkChoice
+}
+
+// forkChoiceStart returns the block LMD-GHOST starts from: the justified block if it is in
+// the tree, otherwise the tree's root. Must be called with mutex already locked
+func (hc *HeadCache) forkChoiceStart() *blockTreeNode {
+	if justified := hc.latestJustified; justified != nil && len(justified.Root) == 32 {
+		if node := hc.tree.get([32]byte(justified.Root)); node != nil {
+			return node
+		}
+	}
+	return hc.tree.root()
 }
 
 // GetCacheStats returns cache statistics for monitoring
@@ -168,11 +324,14 @@ func (hc *HeadCache) GetCacheStats() *CacheStats {
 	defer hc.mutex.RUnlock()
 
 	stats := &CacheStats{
-		RecentBlocksCount: len(hc.recentBlocks),
-		MaxRecentBlocks:   MaxRecentBlocks,
-		HasCurrentHead:    hc.currentHead != nil,
-		HasJustified:      hc.latestJustified != nil,
-		HasFinalized:      hc.latestFinalized != nil,
+		TreeBlocksCount:     len(hc.tree.nodes),
+		MaxTreeBlocks:       MaxTreeBlocks,
+		LatestVotes:         len(hc.tree.latestVotes),
+		HasCurrentHead:      hc.currentHead != nil,
+		HasJustified:        hc.latestJustified != nil,
+		HasFinalized:        hc.latestFinalized != nil,
+		ReorgCount:          hc.reorgCount,
+		UnlinkedHeadChanges: hc.unlinkedHeadChanges,
 	}
 
 	if hc.currentHead != nil {
@@ -187,31 +346,3 @@ func (hc *HeadCache) GetCacheStats() *CacheStats {
 
 	return stats
 }
-
-// pruneOldBlocks removes the oldest blocks to maintain cache size
-// Must be called with mutex already locked
-func (hc *HeadCache) pruneOldBlocks() {
-	if len(hc.recentBlocks) <= MaxRecentBlocks {
-		return
-	}
-
-	// Find the oldest blocks by slot number
-	var oldestSlot uint64 = ^uint64(0) // Max uint64
-	var oldestRoot string
-
-	for root, block := range hc.recentBlocks {
-		if block.Slot < oldestSlot {
-			oldestSlot = block.Slot
-			oldestRoot = root
-		}
-	}
-
-	// Remove the oldest block
-	delete(hc.recentBlocks, oldestRoot)
-
-	hc.logger.WithFields(logrus.Fields{
-		"pruned_slot":       oldestSlot,
-		"remaining_blocks":  len(hc.recentBlocks),
-		"max_recent_blocks": MaxRecentBlocks,
-	}).Debug("Pruned old block from head cache")
-}
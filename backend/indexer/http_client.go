@@ -2,30 +2,160 @@ package indexer
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/sirupsen/logrus"
+
 	"github.com/syjn99/leanView/backend/types"
 )
 
+const (
+	contentTypeSSZ  = "application/octet-stream"
+	contentTypeJSON = "application/json"
+
+	// headersPath and blocksPath are the paths of the block header and full block APIs,
+	// followed by the block id. statesPath is followed by a state id, which takes the same
+	// forms with a state root instead of a block root.
+	headersPath = "/lean/v0/headers/"
+	blocksPath  = "/lean/v0/blocks/"
+	statesPath  = "/lean/v0/states/"
+
+	// maxResponseSize bounds a header or block response, headers are a few hundred bytes
+	maxResponseSize = 1 << 20
+
+	// maxStateResponseSize bounds a state response, states grow by a block hash per slot
+	maxStateResponseSize = 64 << 20
+)
+
+// Response encodings detected per endpoint path
+const (
+	encodingUnknown int32 = iota
+	encodingSSZ
+	encodingJSON
+)
+
+// errSSZUnsupported makes fetch retry a request as JSON
+var errSSZUnsupported = errors.New("SSZ not supported")
+
+// errResponseTooLarge is returned when a response body exceeds the size limit of its path
+var errResponseTooLarge = errors.New("response too large")
+
+// ErrBlockNotFound is returned when the endpoint has no block for the block_id, e.g. a missed slot
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrStateNotFound is returned when the endpoint has no state for the state_id, e.g. a pruned state
+var ErrStateNotFound = errors.New("state not found")
+
 // HTTPClient handles communication with PQ Devnet API
 type HTTPClient struct {
 	client  *http.Client
 	baseURL string
 	timeout time.Duration
+
+	// Added to every request
+	headers http.Header
+	auth    types.EndpointAuthConfig
+
+	// Detected response encoding by endpoint path, each starts as encodingUnknown
+	encodings map[string]*atomic.Int32
+
+	// Selects the signed block layout by slot, nil decodes Devnet 0 blocks
+	forks atomic.Pointer[types.ForkSchedule]
+
+	logger logrus.FieldLogger
 }
 
-// NewHTTPClient creates a new HTTP client for API communication
-func NewHTTPClient(baseURL string, timeout time.Duration) *HTTPClient {
+// NewHTTPClient creates a new HTTP client for API communication with the endpoint's
+// headers, auth, TLS and proxy options applied, the optional wrapper records or replays traffic
+func NewHTTPClient(endpoint *types.EndpointConfig, timeout time.Duration, wrapper TransportWrapper, logger logrus.FieldLogger) (*HTTPClient, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	switch endpoint.Proxy {
+	case "":
+		// Keep the environment proxy of the default transport
+	case types.EndpointProxyNone:
+		transport.Proxy = nil
+	default:
+		proxyURL, err := url.Parse(endpoint.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if endpoint.TLS.CAFile != "" || endpoint.TLS.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: endpoint.TLS.InsecureSkipVerify,
+		}
+		if endpoint.TLS.CAFile != "" {
+			pool, err := loadCertPool(endpoint.TLS.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if endpoint.Timeout > 0 {
+		timeout = endpoint.Timeout
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if wrapper != nil {
+		roundTripper = wrapper.WrapTransport(endpoint.Name, transport)
+	}
+
+	headers := make(http.Header, len(endpoint.Headers))
+	for name, value := range endpoint.Headers {
+		headers.Set(name, value)
+	}
+
 	return &HTTPClient{
 		client: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: roundTripper,
 		},
-		baseURL: baseURL,
+		baseURL: endpoint.Url,
 		timeout: timeout,
+		headers: headers,
+		auth:    endpoint.Auth,
+		encodings: map[string]*atomic.Int32{
+			headersPath: new(atomic.Int32),
+			blocksPath:  new(atomic.Int32),
+			statesPath:  new(atomic.Int32),
+		},
+		logger: logger,
+	}, nil
+}
+
+// loadCertPool returns the system roots extended with the certificates in a PEM file
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
 	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("CA file %v contains no PEM certificates", caFile)
+	}
+	return pool, nil
 }
 
 // GetHeadBlock fetches the current head block
@@ -53,18 +183,28 @@ func (hc *HTTPClient) GetBlockBySlot(ctx context.Context, slot uint64) (*types.B
 	return hc.fetchBlockHeader(ctx, fmt.Sprintf("%d", slot))
 }
 
-// GetBlockByRoot fetches a block by its root hash
+// GetBlockByRoot fetches a block by its root hash and checks that the header hashes to it
 func (hc *HTTPClient) GetBlockByRoot(ctx context.Context, root []byte) (*types.BlockHeader, error) {
 	rootHex := fmt.Sprintf("0x%x", root)
-	return hc.fetchBlockHeader(ctx, rootHex)
+	blockHeader, err := hc.fetchBlockHeader(ctx, rootHex)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyBlockRoot(blockHeader, root); err != nil {
+		return nil, err
+	}
+	return blockHeader, nil
 }
 
-// GetBlockRange fetches a range of blocks by slot numbers
+// GetBlockRange fetches a range of blocks by slot numbers, missed slots are skipped
 func (hc *HTTPClient) GetBlockRange(ctx context.Context, start, end uint64) ([]*types.BlockHeader, error) {
 	var blocks []*types.BlockHeader
 
 	for slot := start; slot <= end; slot++ {
 		block, err := hc.GetBlockBySlot(ctx, slot)
+		if errors.Is(err, ErrBlockNotFound) {
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch block at slot %d: %w", slot, err)
 		}
@@ -74,40 +214,166 @@ func (hc *HTTPClient) GetBlockRange(ctx context.Context, start, end uint64) ([]*
 	return blocks, nil
 }
 
-// fetchBlockHeader is the internal method that handles the actual HTTP request
+// SetForkSchedule sets the fork schedule that selects the layout of each signed block
+func (hc *HTTPClient) SetForkSchedule(forks *types.ForkSchedule) {
+	hc.forks.Store(forks)
+}
+
+// GetSignedBlock fetches a full block with its signature from the blocks endpoint, decoded
+// in the layout of the fork active at its slot
+func (hc *HTTPClient) GetSignedBlock(ctx context.Context, blockId string) (*types.SignedBlock, error) {
+	forks := hc.forks.Load()
+	return fetch(hc, ctx, blocksPath, blockId, func(body []byte, ssz bool) (*types.SignedBlock, error) {
+		return decodeSignedBlock(body, ssz, forks)
+	})
+}
+
+// GetState fetches a state by state id: head, finalized, justified, genesis, a slot or a
+// state root hex encoded with 0x prefix
+func (hc *HTTPClient) GetState(ctx context.Context, stateId string) (*types.State, error) {
+	state, err := fetch(hc, ctx, statesPath, stateId, decodeState)
+	if errors.Is(err, ErrBlockNotFound) {
+		return nil, fmt.Errorf("%w: state_id %s", ErrStateNotFound, stateId)
+	}
+	return state, err
+}
+
+// fetchBlockHeader fetches a header from the headers endpoint
 func (hc *HTTPClient) fetchBlockHeader(ctx context.Context, blockId string) (*types.BlockHeader, error) {
-	url := hc.buildEndpointURL(blockId)
+	return fetch(hc, ctx, headersPath, blockId, decodeBlockHeader)
+}
+
+// responseDecoder decodes a successful response body, SSZ reports whether it is SSZ encoded
+type responseDecoder[T any] func(body []byte, ssz bool) (T, error)
+
+// fetch requests a block_id from an endpoint path. SSZ is requested until the endpoint is
+// found to serve the path as JSON only.
+func fetch[T any](hc *HTTPClient, ctx context.Context, path, blockId string, decode responseDecoder[T]) (T, error) {
+	if hc.encodings[path].Load() != encodingJSON {
+		result, err := request(hc, ctx, path, blockId, true, decode)
+		if !errors.Is(err, errSSZUnsupported) {
+			return result, err
+		}
+		hc.setEncoding(path, encodingJSON, err)
+	}
+
+	return request(hc, ctx, path, blockId, false, decode)
+}
+
+// request fetches a block_id from an endpoint path, accepting SSZ if preferSSZ is set
+func request[T any](hc *HTTPClient, ctx context.Context, path, blockId string, preferSSZ bool, decode responseDecoder[T]) (T, error) {
+	var zero T
+	url := hc.buildEndpointURL(path, blockId)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return zero, fmt.Errorf("failed to create request: %w", err)
+	}
+	hc.authorize(req)
+	if preferSSZ {
+		req.Header.Set("Accept", contentTypeSSZ+", "+contentTypeJSON+";q=0.9")
+	} else {
+		req.Header.Set("Accept", contentTypeJSON)
 	}
 
 	resp, err := hc.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch block header: %w", err)
+		return zero, fmt.Errorf("failed to fetch %s: %w", path, err)
 	}
 	defer resp.Body.Close()
 
+	if preferSSZ && (resp.StatusCode == http.StatusNotAcceptable || resp.StatusCode == http.StatusUnsupportedMediaType) {
+		return zero, fmt.Errorf("%w: API returned status %d", errSSZUnsupported, resp.StatusCode)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return zero, fmt.Errorf("%w: block_id %s", ErrBlockNotFound, blockId)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d for block_id %s", resp.StatusCode, blockId)
+		return zero, fmt.Errorf("API returned status %d for block_id %s", resp.StatusCode, blockId)
+	}
+
+	limit := int64(maxResponseSize)
+	if path == statesPath {
+		limit = maxStateResponseSize
+	}
+	// Read one byte past the limit to tell a truncated body from one that fits exactly
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return zero, fmt.Errorf("failed to read response: %w", err)
+	}
+	if int64(len(body)) > limit {
+		return zero, fmt.Errorf("%w: %s%s exceeds %d bytes", errResponseTooLarge, path, blockId, limit)
+	}
+
+	// A body that fails to decode is a bad response, not a sign that SSZ is unsupported
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == contentTypeSSZ {
+		result, err := decode(body, true)
+		if err != nil {
+			return zero, err
+		}
+		hc.setEncoding(path, encodingSSZ, nil)
+		return result, nil
 	}
 
-	return hc.parseBlockHeaderResponse(resp)
+	result, err := decode(body, false)
+	if err != nil {
+		return zero, err
+	}
+	if preferSSZ {
+		// Served JSON even though SSZ was accepted
+		hc.setEncoding(path, encodingJSON, nil)
+	}
+	return result, nil
 }
 
-// buildEndpointURL constructs the full URL for the API request
-// TODO: Make baseURL configurable (not only `headers`)
-func (hc *HTTPClient) buildEndpointURL(blockId string) string {
-	return fmt.Sprintf("%s/lean/v0/headers/%s", hc.baseURL, blockId)
+// setEncoding records the encoding detected for an endpoint path, logging changes
+func (hc *HTTPClient) setEncoding(path string, encoding int32, reason error) {
+	if hc.encodings[path].Swap(encoding) == encoding {
+		return
+	}
+
+	logger := hc.logger.WithField("path", strings.TrimSuffix(path, "/"))
+	switch encoding {
+	case encodingSSZ:
+		logger.Info("Endpoint serves SSZ")
+	case encodingJSON:
+		entry := logger.WithField("reason", "endpoint serves JSON")
+		if reason != nil {
+			entry = logger.WithField("reason", reason.Error())
+		}
+		entry.Info("Using JSON for endpoint")
+	}
+}
+
+// GetEncoding returns the response encoding detected for headers: "ssz", "json" or "" before
+// the first response
+func (hc *HTTPClient) GetEncoding() string {
+	switch hc.encodings[headersPath].Load() {
+	case encodingSSZ:
+		return "ssz"
+	case encodingJSON:
+		return "json"
+	}
+	return ""
 }
 
-// parseBlockHeaderResponse parses the JSON response into a BlockHeader
-func (hc *HTTPClient) parseBlockHeaderResponse(resp *http.Response) (*types.BlockHeader, error) {
-	var blockHeader types.BlockHeader
-	if err := json.NewDecoder(resp.Body).Decode(&blockHeader); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+// authorize adds the configured headers and credentials to a request. Credentials in the
+// URL userinfo are sent by net/http unless auth is configured explicitly.
+func (hc *HTTPClient) authorize(req *http.Request) {
+	for name, values := range hc.headers {
+		req.Header[name] = values
 	}
 
-	return &blockHeader, nil
+	switch {
+	case hc.auth.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+hc.auth.BearerToken)
+	case hc.auth.Username != "":
+		req.SetBasicAuth(hc.auth.Username, hc.auth.Password)
+	}
+}
+
+// buildEndpointURL constructs the full URL for the API request
+func (hc *HTTPClient) buildEndpointURL(path, blockId string) string {
+	return hc.baseURL + path + blockId
 }
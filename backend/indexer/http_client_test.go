@@ -0,0 +1,135 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestHTTPClientNegotiatesEncodingPerPath(t *testing.T) {
+	header := &types.BlockHeader{
+		Slot:       7,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+	headerSSZ, err := header.MarshalSSZ()
+	if err != nil {
+		t.Fatalf("encoding header: %v", err)
+	}
+	blockJSON, err := json.Marshal(&types.Block{Slot: 7, ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), Body: &types.BlockBody{}})
+	if err != nil {
+		t.Fatalf("encoding block: %v", err)
+	}
+	stateJSON, err := json.Marshal(&types.State{
+		Config:                   &types.StateConfig{NumValidators: 4},
+		Slot:                     7,
+		LatestBlockHeader:        header,
+		LatestJustified:          &types.Checkpoint{Root: make([]byte, 32)},
+		LatestFinalized:          &types.Checkpoint{Root: make([]byte, 32)},
+		JustificationsValidators: []byte{0x01},
+	})
+	if err != nil {
+		t.Fatalf("encoding state: %v", err)
+	}
+
+	// Headers are served as SSZ, blocks reject SSZ and states are served as JSON only
+	var mutex sync.Mutex
+	sszRequests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptsSSZ := strings.HasPrefix(r.Header.Get("Accept"), contentTypeSSZ)
+		path := r.URL.Path[:strings.LastIndex(r.URL.Path, "/")+1]
+		if acceptsSSZ {
+			mutex.Lock()
+			sszRequests[path]++
+			mutex.Unlock()
+		}
+
+		switch {
+		case r.URL.Path == headersPath+"corrupt":
+			w.Header().Set("Content-Type", contentTypeSSZ)
+			w.Write(headerSSZ[:10])
+		case r.URL.Path == headersPath+"large":
+			w.Header().Set("Content-Type", contentTypeSSZ)
+			w.Write(make([]byte, maxResponseSize+1))
+		case path == headersPath:
+			w.Header().Set("Content-Type", contentTypeSSZ)
+			w.Write(headerSSZ)
+		case path == blocksPath && acceptsSSZ:
+			w.WriteHeader(http.StatusNotAcceptable)
+		case path == blocksPath:
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(blockJSON)
+		case path == statesPath:
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(stateJSON)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(&types.EndpointConfig{Name: "zeam-0", Url: server.URL}, time.Second, nil, logrus.New())
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	ctx := context.Background()
+
+	// A body that fails to decode or exceeds the limit is an error, SSZ is still requested
+	if _, err := client.fetchBlockHeader(ctx, "corrupt"); err == nil || errors.Is(err, errSSZUnsupported) {
+		t.Errorf("corrupt SSZ header returned %v, want a decoding error", err)
+	}
+	if _, err := client.fetchBlockHeader(ctx, "large"); !errors.Is(err, errResponseTooLarge) {
+		t.Errorf("oversized header returned %v, want errResponseTooLarge", err)
+	}
+	if encoding := client.GetEncoding(); encoding != "" {
+		t.Errorf("encoding is %q after failed requests, want it undetected", encoding)
+	}
+	if _, err := client.GetHeadBlock(ctx); err != nil {
+		t.Fatalf("fetching SSZ header: %v", err)
+	}
+	if encoding := client.GetEncoding(); encoding != "ssz" {
+		t.Errorf("header encoding is %q, want ssz", encoding)
+	}
+	if _, err := client.fetchBlockHeader(ctx, "corrupt"); err == nil {
+		t.Errorf("corrupt SSZ header decoded")
+	}
+	if encoding := client.GetEncoding(); encoding != "ssz" {
+		t.Errorf("header encoding is %q after a corrupt header, want ssz", encoding)
+	}
+
+	// Rejecting SSZ and serving JSON only move their own path to JSON
+	for range 2 {
+		if _, err := client.GetSignedBlock(ctx, "head"); err != nil {
+			t.Fatalf("fetching block: %v", err)
+		}
+		if _, err := client.GetState(ctx, "head"); err != nil {
+			t.Fatalf("fetching state: %v", err)
+		}
+	}
+	for path, expected := range map[string]int32{headersPath: encodingSSZ, blocksPath: encodingJSON, statesPath: encodingJSON} {
+		if encoding := client.encodings[path].Load(); encoding != expected {
+			t.Errorf("encoding of %s is %d, want %d", path, encoding, expected)
+		}
+	}
+	if sszRequests[blocksPath] != 1 || sszRequests[statesPath] != 1 {
+		t.Errorf("SSZ was requested %d times for blocks and %d times for states, want once each",
+			sszRequests[blocksPath], sszRequests[statesPath])
+	}
+	if _, err := client.GetHeadBlock(ctx); err != nil {
+		t.Errorf("fetching SSZ header after JSON blocks: %v", err)
+	}
+	if sszRequests[headersPath] != 5 {
+		t.Errorf("SSZ was requested %d times for headers, want every time", sszRequests[headersPath])
+	}
+}
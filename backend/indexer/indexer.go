@@ -3,8 +3,10 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/sirupsen/logrus"
+	"github.com/syjn99/leanView/backend/db"
 	"github.com/syjn99/leanView/backend/types"
 )
 
@@ -13,31 +15,177 @@ type Indexer struct {
 	clientPool     *ClientPool
 	blockProcessor *BlockProcessor
 	poller         *BlockPoller
+	stateVerifier  *StateVerifier // Nil unless state transition verification is enabled
+	chainVerifier  *ChainVerifier
+	snapshotter    *StateSnapshotter
+	equivocations  *EquivocationDetector
+	alerter        *Alerter
+	forks          *types.ForkSchedule
 	headCache      *HeadCache
+	chainQuery     *ChainQuery
+	slotClock      *SlotClock
+	clock          Clock
 	logger         logrus.FieldLogger
+
+	// Set when lean node traffic is recorded to or replayed from an archive
+	recorder *TrafficRecorder
+	replayer *TrafficReplayer
+
+	// Serializes runtime endpoint changes so the pool and database stay in sync
+	endpointsMutex sync.Mutex
+
+	// Endpoints from the config file, replaced on config reload
+	configEndpoints []types.EndpointConfig
 }
 
-func NewIndexer(config *types.Config, logger logrus.FieldLogger) *Indexer {
-	// Create client pool from endpoint configuration
-	clientPool := NewClientPool(config.LeanApi.Endpoints, logger)
+func NewIndexer(config *types.Config, logger logrus.FieldLogger) (*Indexer, error) {
+	indexer := &Indexer{
+		config:          config,
+		clock:           SystemClock,
+		logger:          logger,
+		configEndpoints: config.LeanApi.Endpoints,
+	}
+
+	genesisTime := config.Chain.GenesisTime
+	slotDuration := config.Chain.SlotDuration
+
+	var endpoints []types.EndpointConfig
+	var primary string
+	var wrapper TransportWrapper
+	if config.Indexer.ReplayFile != "" {
+		// Replay the archive on a clock starting at the time it was recorded, with the
+		// endpoints and chain timing of the recording
+		header, records, err := ReadTrafficArchive(config.Indexer.ReplayFile)
+		if err != nil {
+			return nil, err
+		}
+		indexer.clock = NewVirtualClock(header.StartedAt, config.Indexer.ReplaySpeed)
+		indexer.replayer = NewTrafficReplayer(header, records, indexer.clock, logger)
+		if header.GenesisTime != 0 {
+			genesisTime = header.GenesisTime
+		}
+		if header.SlotDuration != 0 {
+			slotDuration = header.SlotDuration
+		}
+
+		endpoints = indexer.replayer.Endpoints()
+		if len(endpoints) == 0 {
+			return nil, fmt.Errorf("traffic archive %v has no endpoints", config.Indexer.ReplayFile)
+		}
+		wrapper = indexer.replayer
+
+		logger.WithFields(logrus.Fields{
+			"file":        config.Indexer.ReplayFile,
+			"recorded_at": header.StartedAt,
+			"records":     len(records),
+			"speed":       config.Indexer.ReplaySpeed,
+		}).Info("Replaying recorded lean node traffic")
+	} else {
+		// Create client pool from endpoint configuration and stored runtime changes
+		endpoints, primary = indexer.loadEndpoints()
+
+		if config.Indexer.RecordFile != "" {
+			header := &types.TrafficArchiveHeader{
+				Version:      types.TrafficArchiveVersion,
+				StartedAt:    indexer.clock.Now(),
+				GenesisTime:  genesisTime,
+				SlotDuration: slotDuration,
+			}
+			for _, endpoint := range endpoints {
+				header.Endpoints = append(header.Endpoints, types.TrafficArchiveEndpoint{
+					Name: endpoint.Name,
+					Url:  endpoint.RedactedUrl(),
+				})
+			}
+
+			recorder, err := NewTrafficRecorder(config.Indexer.RecordFile, header, indexer.clock, logger)
+			if err != nil {
+				return nil, err
+			}
+			indexer.recorder = recorder
+			wrapper = recorder
+
+			logger.WithField("file", config.Indexer.RecordFile).Info("Recording lean node traffic")
+		}
+	}
+
+	forks, err := types.NewForkSchedule(config.Chain.Fork, config.Chain.ForkActivations)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fork schedule: %w", err)
+	}
+
+	clientPool := NewClientPool(endpoints, &config.Indexer, indexer.clock, wrapper, logger)
+	clientPool.SetForkSchedule(forks)
+	if primary != "" {
+		if err := clientPool.SetPrimary(primary); err != nil {
+			logger.WithError(err).Warn("Failed to restore primary endpoint")
+		}
+	}
 
 	// Create head cache
 	headCache := NewHeadCache(logger)
 
+	// Compare the headers of every client and the ingested votes for equivocations
+	alerter := NewAlerter(config.Indexer.AlertWebhook, config.Indexer.HTTPTimeout, indexer.clock, logger)
+	equivocations := NewEquivocationDetector(alerter, indexer.clock, logger)
+	clientPool.SetHeaderObserver(equivocations.ObserveHeaders)
+
+	// Store the signature and size of every full block fetched
+	signatures := NewSignatureRecorder(forks, indexer.clock, logger)
+	clientPool.SetBlockObserver(signatures.ObserveBlock)
+
 	// Create block processor
-	blockProcessor := NewBlockProcessor(headCache, logger)
+	blockProcessor := NewBlockProcessor(headCache, equivocations, &config.Indexer, indexer.clock, logger)
 
 	// Create block poller with processor
-	poller := NewBlockPoller(clientPool, blockProcessor, logger)
+	poller := NewBlockPoller(clientPool, blockProcessor, &config.Indexer, indexer.clock, logger)
 
-	return &Indexer{
-		config:         config,
-		clientPool:     clientPool,
-		blockProcessor: blockProcessor,
-		poller:         poller,
-		headCache:      headCache,
-		logger:         logger,
+	// Warn about validator assignments that no endpoint can be attributed to
+	if validators := config.Chain.Validators; validators != nil {
+		endpointNames := make(map[string]bool, len(endpoints))
+		for _, endpoint := range endpoints {
+			endpointNames[endpoint.Name] = true
+		}
+		for _, assignment := range validators.Validators {
+			if !endpointNames[assignment.Client] {
+				logger.WithField("client", assignment.Client).Warn("Validator config references a client without a configured endpoint")
+			}
+		}
 	}
+
+	indexer.clientPool = clientPool
+	indexer.equivocations = equivocations
+	indexer.alerter = alerter
+	indexer.forks = forks
+	indexer.blockProcessor = blockProcessor
+	indexer.poller = poller
+	indexer.headCache = headCache
+	indexer.chainQuery = NewChainQuery(headCache)
+	indexer.slotClock = NewSlotClock(genesisTime, slotDuration, indexer.clock)
+
+	// Classify clients by head progress after every health check round
+	clientPool.SetStatusEvaluator(NewStatusEvaluator(indexer.slotClock, &config.Indexer, slotDuration))
+
+	validatorCount := config.Chain.ValidatorCount
+	if validatorCount == 0 {
+		validatorCount = config.Chain.Validators.ValidatorCount()
+	}
+
+	// Always created so the stored chain can be verified on demand
+	indexer.chainVerifier = NewChainVerifier(clientPool, validatorCount, config.Indexer.IntegrityCheckInterval, config.Indexer.IntegrityRefetch, indexer.clock, logger)
+
+	// Always created so snapshots can be taken on demand
+	indexer.snapshotter = NewStateSnapshotter(clientPool, config.Indexer.StateSnapshotInterval, config.Indexer.StateSnapshotKeep, indexer.clock, logger)
+
+	if config.Indexer.VerifyStateTransition {
+		stateVerifier, err := NewStateVerifier(clientPool, genesisTime, validatorCount, slotDuration, indexer.clock, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create state verifier: %w", err)
+		}
+		indexer.stateVerifier = stateVerifier
+	}
+
+	return indexer, nil
 }
 
 func (i *Indexer) Start(ctx context.Context) error {
@@ -51,6 +199,27 @@ func (i *Indexer) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to start block poller: %w", err)
 	}
 
+	// Start verifying indexed blocks against the local state transition
+	if i.stateVerifier != nil {
+		if err := i.stateVerifier.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start state verifier: %w", err)
+		}
+	}
+
+	// Check the stored chain for consistency every interval
+	if i.config.Indexer.IntegrityCheckInterval > 0 {
+		if err := i.chainVerifier.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start chain verifier: %w", err)
+		}
+	}
+
+	// Store the head state of a client every interval
+	if i.config.Indexer.StateSnapshotInterval > 0 {
+		if err := i.snapshotter.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start state snapshotter: %w", err)
+		}
+	}
+
 	i.logger.WithFields(logrus.Fields{
 		"client_count": i.clientPool.GetClientCount(),
 		"endpoints":    len(i.config.LeanApi.Endpoints),
@@ -69,9 +238,29 @@ func (i *Indexer) Stop() error {
 		i.logger.WithError(err).Warn("Error stopping block poller")
 	}
 
+	if i.stateVerifier != nil {
+		if err := i.stateVerifier.Stop(); err != nil {
+			i.logger.WithError(err).Warn("Error stopping state verifier")
+		}
+	}
+
+	if err := i.chainVerifier.Stop(); err != nil {
+		i.logger.WithError(err).Warn("Error stopping chain verifier")
+	}
+
+	if err := i.snapshotter.Stop(); err != nil {
+		i.logger.WithError(err).Warn("Error stopping state snapshotter")
+	}
+
 	// Stop client health checking
 	i.clientPool.StopHealthChecks()
 
+	if i.recorder != nil {
+		if err := i.recorder.Close(); err != nil {
+			i.logger.WithError(err).Warn("Error closing traffic archive")
+		}
+	}
+
 	i.logger.Info("Indexer stopped successfully")
 	return nil
 }
@@ -81,6 +270,88 @@ func (i *Indexer) GetHeadCache() *HeadCache {
 	return i.headCache
 }
 
+// GetChainQuery returns the ancestry queries over the block tree and the indexed chain
+func (i *Indexer) GetChainQuery() *ChainQuery {
+	return i.chainQuery
+}
+
+// GetValidatorCount returns the number of validators used for round robin proposals.
+// The configured count wins, then the validator config, otherwise it is inferred from
+// the highest indexed proposer.
+func (i *Indexer) GetValidatorCount() (uint64, error) {
+	if i.config.Chain.ValidatorCount > 0 {
+		return i.config.Chain.ValidatorCount, nil
+	}
+	if count := i.config.Chain.Validators.ValidatorCount(); count > 0 {
+		return count, nil
+	}
+
+	maxIndex, found, err := db.GetMaxProposerIndex()
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, nil
+	}
+	return maxIndex + 1, nil
+}
+
+// GetValidatorClient returns the name of the client running the given validator,
+// or an empty string if no validator config assigns it
+func (i *Indexer) GetValidatorClient(validatorIndex uint64) string {
+	return i.config.Chain.Validators.ClientForValidator(validatorIndex)
+}
+
+// GetPoller returns the block poller for external access
+func (i *Indexer) GetPoller() *BlockPoller {
+	return i.poller
+}
+
+// GetStateVerifier returns the state verifier, nil if verification is disabled
+func (i *Indexer) GetStateVerifier() *StateVerifier {
+	return i.stateVerifier
+}
+
+// GetChainVerifier returns the verifier checking the stored chain for consistency
+func (i *Indexer) GetChainVerifier() *ChainVerifier {
+	return i.chainVerifier
+}
+
+// GetStateSnapshotter returns the snapshotter storing the head states of clients
+func (i *Indexer) GetStateSnapshotter() *StateSnapshotter {
+	return i.snapshotter
+}
+
+// GetEquivocationDetector returns the detector comparing observed headers and votes
+func (i *Indexer) GetEquivocationDetector() *EquivocationDetector {
+	return i.equivocations
+}
+
+// GetAlerter returns the alerter posting findings to the webhook
+func (i *Indexer) GetAlerter() *Alerter {
+	return i.alerter
+}
+
+// GetForkSchedule returns the fork schedule blocks are decoded with
+func (i *Indexer) GetForkSchedule() *types.ForkSchedule {
+	return i.forks
+}
+
+// GetClock returns the time source of the indexer, a virtual clock while replaying
+func (i *Indexer) GetClock() Clock {
+	return i.clock
+}
+
+// IsReplaying reports whether the indexer replays a traffic archive
+func (i *Indexer) IsReplaying() bool {
+	return i.replayer != nil
+}
+
+// GetSlotClock returns the slot clock for external access
+func (i *Indexer) GetSlotClock() *SlotClock {
+	return i.slotClock
+}
+
 // GetClientPool returns the client pool for external access
 func (i *Indexer) GetClientPool() *ClientPool {
 	return i.clientPool
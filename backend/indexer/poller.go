@@ -1,7 +1,9 @@
 package indexer
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -19,28 +21,38 @@ type BlockPoller struct {
 	pollInterval time.Duration
 	maxRetries   int
 	retryDelay   time.Duration
+	clock        Clock
 
 	// State tracking
-	lastProcessedSlot uint64
-	isRunning         bool
-	catchupInProgress bool // Track if catchup is running
+	lastProcessedSlot  uint64
+	lastSuccessfulPoll time.Time // Last time a head block was fetched
+	lastPollClient     string    // Name of the client that served the last head block
+	isRunning          bool
+	catchupInProgress  bool       // Track if catchup is running
+	catchupRetry       *slotRange // Range a stopped catchup has to retry, nil if none
 
 	// Synchronization
-	ticker      *time.Ticker
+	ticker      Ticker
 	stopChannel chan bool
 	mutex       sync.RWMutex
 
 	logger logrus.FieldLogger
 }
 
+// slotRange is an inclusive range of slots
+type slotRange struct {
+	start, end uint64
+}
+
 // NewBlockPoller creates a new block poller with slot-based timing
-func NewBlockPoller(clientPool *ClientPool, blockProcessor *BlockProcessor, logger logrus.FieldLogger) *BlockPoller {
+func NewBlockPoller(clientPool *ClientPool, blockProcessor *BlockProcessor, config *types.IndexerConfig, clock Clock, logger logrus.FieldLogger) *BlockPoller {
 	return &BlockPoller{
 		clientPool:     clientPool,
 		blockProcessor: blockProcessor,
-		pollInterval:   defaultPollingInterval, // 4 seconds per slot
-		maxRetries:     defaultMaxRetries,
-		retryDelay:     defaultRetryDelay,
+		pollInterval:   config.PollInterval,
+		maxRetries:     config.MaxRetries,
+		retryDelay:     config.RetryDelay,
+		clock:          clock,
 		stopChannel:    make(chan bool, 1),
 		logger:         logger.WithField("component", "block_poller"),
 	}
@@ -54,7 +66,7 @@ func (bp *BlockPoller) Start(ctx context.Context) error {
 		return fmt.Errorf("poller is already running")
 	}
 	bp.isRunning = true
-	bp.ticker = time.NewTicker(bp.pollInterval)
+	bp.ticker = bp.clock.NewTicker(bp.pollInterval)
 	
 	// Initialize lastProcessedSlot from database
 	bp.initializeLastProcessedSlot()
@@ -92,6 +104,22 @@ func (bp *BlockPoller) Stop() error {
 	return nil
 }
 
+// SetPollInterval changes the polling interval, taking effect from the next tick
+func (bp *BlockPoller) SetPollInterval(interval time.Duration) {
+	bp.mutex.Lock()
+	defer bp.mutex.Unlock()
+
+	if interval == bp.pollInterval {
+		return
+	}
+	bp.pollInterval = interval
+	if bp.ticker != nil {
+		bp.ticker.Reset(interval)
+	}
+
+	bp.logger.WithField("poll_interval", interval).Info("Poll interval changed")
+}
+
 // pollLoop is the main polling loop that runs in a goroutine
 func (bp *BlockPoller) pollLoop(ctx context.Context) {
 	defer func() {
@@ -102,7 +130,7 @@ func (bp *BlockPoller) pollLoop(ctx context.Context) {
 
 	for {
 		select {
-		case <-bp.ticker.C:
+		case <-bp.ticker.C():
 			if err := bp.pollForNewBlocks(ctx); err != nil {
 				bp.logger.WithError(err).Warn("Failed to poll for new blocks")
 			}
@@ -125,11 +153,16 @@ func (bp *BlockPoller) pollForNewBlocks(ctx context.Context) error {
 	}
 
 	// Fetch the current head block
-	headBlock, err := bp.fetchHeadBlockWithRetry(ctx, client)
+	headBlock, client, err := bp.fetchHeadBlockWithRetry(ctx, client)
 	if err != nil {
 		return fmt.Errorf("failed to fetch head block: %w", err)
 	}
 
+	bp.mutex.Lock()
+	bp.lastSuccessfulPoll = bp.clock.Now()
+	bp.lastPollClient = client.GetConfig().Name
+	bp.mutex.Unlock()
+
 	// Check if this is a new slot
 	if headBlock.Slot > bp.lastProcessedSlot {
 		slotGap := headBlock.Slot - bp.lastProcessedSlot
@@ -137,6 +170,7 @@ func (bp *BlockPoller) pollForNewBlocks(ctx context.Context) error {
 			"new_slot":      headBlock.Slot,
 			"previous_slot": bp.lastProcessedSlot,
 			"slot_gap":      slotGap,
+			"client":        client.GetConfig().Name,
 		}).Info("New block detected")
 
 		// Check for gaps and trigger catchup if needed
@@ -145,9 +179,11 @@ func (bp *BlockPoller) pollForNewBlocks(ctx context.Context) error {
 		}
 
 		// Process the detected new block using the block processor
-		if err := bp.blockProcessor.ProcessBlock(ctx, headBlock); err != nil {
+		if err := bp.blockProcessor.ProcessBlock(ctx, client, headBlock); err != nil {
 			bp.logger.WithError(err).WithField("slot", headBlock.Slot).Error("Failed to process new block")
 			// Continue and update the slot even if processing failed to avoid getting stuck
+		} else {
+			bp.blockProcessor.IngestVotes(ctx, client, headBlock)
 		}
 
 		bp.updateLastProcessedSlot(headBlock.Slot)
@@ -155,20 +191,48 @@ func (bp *BlockPoller) pollForNewBlocks(ctx context.Context) error {
 		bp.logger.WithField("current_slot", headBlock.Slot).Debug("No new blocks")
 	}
 
+	// Retry a catchup that stopped at a slot without a quorum
+	bp.retryCatchup(ctx)
+
+	// Track justification and finalization progress
+	bp.updateCheckpoints(ctx, client)
+
 	return nil
 }
 
-// fetchHeadBlockWithRetry attempts to fetch the head block with retry logic
-func (bp *BlockPoller) fetchHeadBlockWithRetry(ctx context.Context, client *Client) (*types.BlockHeader, error) {
+// updateCheckpoints fetches the justified and finalized blocks and updates the head cache on change
+func (bp *BlockPoller) updateCheckpoints(ctx context.Context, client *Client) {
+	headCache := bp.blockProcessor.headCache
+
+	if justifiedBlock, err := client.GetJustifiedBlock(ctx); err != nil {
+		bp.logger.WithError(err).Debug("Failed to fetch justified block")
+	} else if checkpoint, err := bp.blockProcessor.CreateCheckpoint(justifiedBlock); err != nil {
+		bp.logger.WithError(err).Warn("Failed to create justified checkpoint")
+	} else if current := headCache.GetJustifiedCheckpoint(); current == nil || !bytes.Equal(current.Root, checkpoint.Root) {
+		headCache.UpdateJustified(checkpoint)
+	}
+
+	if finalizedBlock, err := client.GetFinalizedBlock(ctx); err != nil {
+		bp.logger.WithError(err).Debug("Failed to fetch finalized block")
+	} else if checkpoint, err := bp.blockProcessor.CreateCheckpoint(finalizedBlock); err != nil {
+		bp.logger.WithError(err).Warn("Failed to create finalized checkpoint")
+	} else if current := headCache.GetFinalizedCheckpoint(); current == nil || !bytes.Equal(current.Root, checkpoint.Root) {
+		headCache.UpdateFinalized(checkpoint)
+	}
+}
+
+// fetchHeadBlockWithRetry attempts to fetch the head block with retry logic,
+// returning the client that served it
+func (bp *BlockPoller) fetchHeadBlockWithRetry(ctx context.Context, client *Client) (*types.BlockHeader, *Client, error) {
 	var lastErr error
 
 	for attempt := 0; attempt < bp.maxRetries; attempt++ {
 		if attempt > 0 {
 			// Wait before retry
 			select {
-			case <-time.After(bp.retryDelay):
+			case <-bp.clock.After(bp.retryDelay):
 			case <-ctx.Done():
-				return nil, ctx.Err()
+				return nil, nil, ctx.Err()
 			}
 
 			// Try to get a different healthy client
@@ -180,7 +244,10 @@ func (bp *BlockPoller) fetchHeadBlockWithRetry(ctx context.Context, client *Clie
 		block, err := client.GetLatestBlock(ctx)
 		if err != nil {
 			lastErr = err
-			bp.logger.WithError(err).WithField("attempt", attempt+1).Warn("Failed to fetch head block")
+			bp.logger.WithError(err).WithFields(logrus.Fields{
+				"attempt": attempt + 1,
+				"client":  client.GetConfig().Name,
+			}).Warn("Failed to fetch head block")
 			continue
 		}
 
@@ -188,10 +255,10 @@ func (bp *BlockPoller) fetchHeadBlockWithRetry(ctx context.Context, client *Clie
 			bp.logger.WithField("attempt", attempt+1).Info("Successfully fetched head block after retry")
 		}
 
-		return block, nil
+		return block, client, nil
 	}
 
-	return nil, fmt.Errorf("failed to fetch head block after %d attempts: %w", bp.maxRetries, lastErr)
+	return nil, nil, fmt.Errorf("failed to fetch head block after %d attempts: %w", bp.maxRetries, lastErr)
 }
 
 // updateLastProcessedSlot safely updates the last processed slot
@@ -208,6 +275,27 @@ func (bp *BlockPoller) GetLastProcessedSlot() uint64 {
 	return bp.lastProcessedSlot
 }
 
+// GetLastSuccessfulPoll returns when a head block was last fetched, zero if never
+func (bp *BlockPoller) GetLastSuccessfulPoll() time.Time {
+	bp.mutex.RLock()
+	defer bp.mutex.RUnlock()
+	return bp.lastSuccessfulPoll
+}
+
+// GetLastPollClient returns the name of the client that served the last head block, empty if none
+func (bp *BlockPoller) GetLastPollClient() string {
+	bp.mutex.RLock()
+	defer bp.mutex.RUnlock()
+	return bp.lastPollClient
+}
+
+// IsCatchupInProgress returns whether a catchup is currently running
+func (bp *BlockPoller) IsCatchupInProgress() bool {
+	bp.mutex.RLock()
+	defer bp.mutex.RUnlock()
+	return bp.catchupInProgress
+}
+
 // IsRunning returns whether the poller is currently running
 func (bp *BlockPoller) IsRunning() bool {
 	bp.mutex.RLock()
@@ -245,6 +333,23 @@ func (bp *BlockPoller) detectAndHandleGaps(ctx context.Context, headBlock *types
 		"last_slot":  bp.lastProcessedSlot,
 	}).Warn("Gap detected in block processing")
 
+	bp.startCatchup(ctx, startSlot, endSlot)
+}
+
+// retryCatchup restarts a stopped catchup unless a catchup is running
+func (bp *BlockPoller) retryCatchup(ctx context.Context) {
+	bp.mutex.RLock()
+	retry := bp.catchupRetry
+	bp.mutex.RUnlock()
+	if retry == nil {
+		return
+	}
+	bp.startCatchup(ctx, retry.start, retry.end)
+}
+
+// startCatchup launches a catchup for a range of slots, extended to cover the range a
+// stopped catchup has to retry
+func (bp *BlockPoller) startCatchup(ctx context.Context, startSlot, endSlot uint64) {
 	// Check if catchup is already in progress
 	bp.mutex.Lock()
 	if bp.catchupInProgress {
@@ -252,6 +357,11 @@ func (bp *BlockPoller) detectAndHandleGaps(ctx context.Context, headBlock *types
 		bp.logger.Debug("Catchup already in progress, skipping new catchup")
 		return
 	}
+	if retry := bp.catchupRetry; retry != nil {
+		startSlot = min(startSlot, retry.start)
+		endSlot = max(endSlot, retry.end)
+		bp.catchupRetry = nil
+	}
 	bp.catchupInProgress = true
 	bp.mutex.Unlock()
 
@@ -277,6 +387,18 @@ func (bp *BlockPoller) performCatchup(ctx context.Context, startSlot, endSlot ui
 
 	// Use the block processor's ProcessBlockRange method
 	err := bp.blockProcessor.ProcessBlockRange(ctx, bp.clientPool, startSlot, endSlot)
+	var stopped *catchupStoppedError
+	if errors.As(err, &stopped) {
+		// Retry from the stopped slot on the next poll, the slots after it stay missing until then
+		bp.mutex.Lock()
+		bp.catchupRetry = &slotRange{start: stopped.slot, end: endSlot}
+		bp.mutex.Unlock()
+		bp.logger.WithError(err).WithFields(logrus.Fields{
+			"start_slot": startSlot,
+			"end_slot":   endSlot,
+		}).Warn("Catchup stopped without a quorum, retrying on the next poll")
+		return
+	}
 	if err != nil {
 		bp.logger.WithError(err).WithFields(logrus.Fields{
 			"start_slot": startSlot,
@@ -0,0 +1,216 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// errNoQuorum is returned when too few clients answer a quorum read, or they return no
+// majority for a slot
+var errNoQuorum = errors.New("no quorum")
+
+// catchupStoppedError reports that catchup kept the blocks before a slot and has to be
+// retried from it
+type catchupStoppedError struct {
+	slot uint64
+	err  error
+}
+
+func (e *catchupStoppedError) Error() string {
+	return fmt.Sprintf("catchup stopped at slot %d: %v", e.slot, e.err)
+}
+
+func (e *catchupStoppedError) Unwrap() error {
+	return e.err
+}
+
+// quorumResponse is the range of headers one client returned for a quorum read
+type quorumResponse struct {
+	client  *Client
+	headers map[uint64]*types.BlockHeader // Valid headers by slot, missed slots are absent
+	roots   map[uint64][32]byte
+	agreed  int // Slots where the client returned the majority header
+}
+
+// quorumApplies reports whether a catchup batch ending at the slot is fetched from a quorum
+// of clients. Without a finalized checkpoint no range counts as finalized.
+func (bp *BlockProcessor) quorumApplies(endSlot uint64) bool {
+	if bp.quorumSize < 2 {
+		return false
+	}
+	if !bp.quorumFinalizedOnly {
+		return true
+	}
+	finalized := bp.headCache.GetFinalizedCheckpoint()
+	return finalized != nil && endSlot <= finalized.Slot
+}
+
+// fetchQuorumRange fetches a range of blocks from quorumSize healthy clients and returns
+// the headers a strict majority of them returned. At the first slot without a majority it
+// stops, returning the headers before it and a catchupStoppedError for that slot, as it does
+// for the start slot when too few clients answer. Clients that disagree with the majority are stored as divergences.
+// The client that agreed with the majority most often is returned for fetching votes.
+func (bp *BlockProcessor) fetchQuorumRange(ctx context.Context, clientPool *ClientPool, startSlot, endSlot uint64) ([]*types.BlockHeader, *Client, error) {
+	healthy := 0
+	var responses []*quorumResponse
+	for _, client := range clientPool.GetAllClients() {
+		if len(responses) == bp.quorumSize {
+			break
+		}
+		if !client.IsHealthy() {
+			continue
+		}
+		healthy++
+
+		headers, err := client.GetBlockRange(ctx, startSlot, endSlot)
+		if err != nil {
+			bp.logger.WithError(err).WithFields(logrus.Fields{
+				"batch_start": startSlot,
+				"batch_end":   endSlot,
+				"client":      client.GetConfig().Name,
+			}).Warn("Failed to fetch block range for quorum")
+			continue
+		}
+		responses = append(responses, bp.newQuorumResponse(client, headers))
+	}
+	if healthy == 0 {
+		return nil, nil, errNoHealthyClients
+	}
+	if len(responses) < bp.quorumSize {
+		err := fmt.Errorf("%w: %d of %d clients answered", errNoQuorum, len(responses), bp.quorumSize)
+		return nil, nil, &catchupStoppedError{slot: startSlot, err: err}
+	}
+
+	detectedAt := bp.clock.Now().UnixMilli()
+	var blocks []*types.BlockHeader
+	var divergences []*types.Divergence
+	var quorumErr error
+	for slot := startSlot; slot <= endSlot; slot++ {
+		// Count the clients returning each root, an empty key for a missed slot
+		counts := make(map[string]int, 1)
+		for _, response := range responses {
+			counts[response.key(slot)]++
+		}
+		majority, found := "", false
+		for key, count := range counts {
+			if 2*count > len(responses) {
+				majority, found = key, true
+			}
+		}
+		if !found {
+			err := fmt.Errorf("%w: %d versions among %d clients", errNoQuorum, len(counts), len(responses))
+			quorumErr = &catchupStoppedError{slot: slot, err: err}
+			break
+		}
+
+		var majorityHeader *types.BlockHeader
+		for _, response := range responses {
+			if response.key(slot) == majority {
+				response.agreed++
+				majorityHeader = response.headers[slot]
+			}
+		}
+		if majorityHeader != nil {
+			blocks = append(blocks, majorityHeader)
+		}
+		if counts[majority] == len(responses) {
+			continue
+		}
+
+		for _, response := range responses {
+			if response.key(slot) == majority {
+				continue
+			}
+			divergence := &types.Divergence{
+				Slot:       slot,
+				Client:     response.client.GetConfig().Name,
+				Agreeing:   counts[majority],
+				Queried:    len(responses),
+				DetectedAt: detectedAt,
+			}
+			if header := response.headers[slot]; header != nil {
+				root := response.roots[slot]
+				divergence.Root = root[:]
+				divergence.Header, _ = header.MarshalSSZ()
+			}
+			if majorityHeader != nil {
+				root := []byte(majority)
+				divergence.MajorityRoot = root
+				divergence.MajorityHeader, _ = majorityHeader.MarshalSSZ()
+			}
+			divergences = append(divergences, divergence)
+
+			bp.logger.WithFields(logrus.Fields{
+				"slot":          slot,
+				"client":        divergence.Client,
+				"root":          fmt.Sprintf("0x%x", divergence.Root),
+				"majority_root": fmt.Sprintf("0x%x", divergence.MajorityRoot),
+				"agreeing":      divergence.Agreeing,
+				"queried":       divergence.Queried,
+			}).Warn("Client diverges from the quorum majority")
+		}
+	}
+
+	if len(divergences) > 0 {
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			for _, divergence := range divergences {
+				if err := db.UpsertDivergence(divergence, tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			bp.logger.WithError(err).Error("Failed to store divergences")
+		}
+	}
+
+	best := responses[0]
+	for _, response := range responses[1:] {
+		if response.agreed > best.agreed {
+			best = response
+		}
+	}
+	return blocks, best.client, quorumErr
+}
+
+// newQuorumResponse indexes a client's headers by slot, dropping headers that fail validation
+func (bp *BlockProcessor) newQuorumResponse(client *Client, headers []*types.BlockHeader) *quorumResponse {
+	response := &quorumResponse{
+		client:  client,
+		headers: make(map[uint64]*types.BlockHeader, len(headers)),
+		roots:   make(map[uint64][32]byte, len(headers)),
+	}
+	for _, header := range headers {
+		if err := bp.validateBlockHeader(header); err != nil {
+			bp.logger.WithError(err).WithFields(logrus.Fields{
+				"slot":   header.Slot,
+				"client": client.GetConfig().Name,
+			}).Warn("Skipping invalid block in quorum read")
+			continue
+		}
+		root, err := header.HashTreeRoot()
+		if err != nil {
+			continue
+		}
+		response.headers[header.Slot] = header
+		response.roots[header.Slot] = root
+	}
+	return response
+}
+
+// key identifies the header the client returned at a slot, empty for a missed slot
+func (r *quorumResponse) key(slot uint64) string {
+	root, ok := r.roots[slot]
+	if !ok {
+		return ""
+	}
+	return string(root[:])
+}
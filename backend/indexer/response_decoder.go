@@ -0,0 +1,181 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// ErrRootMismatch is returned when a header does not hash to the root the endpoint claims for it
+var ErrRootMismatch = errors.New("block root mismatch")
+
+// decodeBlockHeaderJSON decodes a header response in any of the layouts lean clients serve:
+// a bare header, a header next to its "root", the beacon API {"root", "header": {"message"}}
+// nesting, and any of these wrapped in {"data": ...}. The returned root is nil if the
+// response has none.
+func decodeBlockHeaderJSON(body []byte) (*types.BlockHeader, []byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if data, ok := fields["data"]; ok {
+		if _, isHeader := fields["slot"]; !isHeader {
+			return decodeBlockHeaderJSON(data)
+		}
+	}
+
+	var root []byte
+	if raw, ok := fields["root"]; ok {
+		var rootHex string
+		if err := json.Unmarshal(raw, &rootHex); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode root: %w", err)
+		}
+		decoded, err := hex.DecodeString(strings.TrimPrefix(rootHex, "0x"))
+		if err != nil || len(decoded) != 32 {
+			return nil, nil, fmt.Errorf("invalid root %q", rootHex)
+		}
+		root = decoded
+	}
+
+	payload := body
+	if header, ok := fields["header"]; ok {
+		payload = header
+
+		var nested map[string]json.RawMessage
+		if err := json.Unmarshal(header, &nested); err == nil {
+			if message, ok := nested["message"]; ok {
+				payload = message
+			}
+		}
+	}
+
+	var blockHeader types.BlockHeader
+	if err := json.Unmarshal(payload, &blockHeader); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &blockHeader, root, nil
+}
+
+// decodeBlockHeaderSSZ decodes an SSZ encoded header response
+func decodeBlockHeaderSSZ(body []byte) (*types.BlockHeader, error) {
+	var blockHeader types.BlockHeader
+	if err := blockHeader.UnmarshalSSZ(body); err != nil {
+		return nil, fmt.Errorf("failed to decode SSZ response: %w", err)
+	}
+	return &blockHeader, nil
+}
+
+// decodeBlockHeader decodes a header response, checking the root if the response has one
+func decodeBlockHeader(body []byte, ssz bool) (*types.BlockHeader, error) {
+	if ssz {
+		return decodeBlockHeaderSSZ(body)
+	}
+
+	blockHeader, root, err := decodeBlockHeaderJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyBlockRoot(blockHeader, root); err != nil {
+		return nil, err
+	}
+	return blockHeader, nil
+}
+
+// decodeSignedBlock decodes a block response: an SSZ signed block in the layout of the fork
+// active at its slot, or a JSON signed block or bare block, optionally wrapped in
+// {"data": ...}. A bare block gets a zero signature of the fork's size.
+func decodeSignedBlock(body []byte, ssz bool, forks *types.ForkSchedule) (*types.SignedBlock, error) {
+	if ssz {
+		signedBlock, _, err := forks.UnmarshalSignedBlock(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode SSZ response: %w", err)
+		}
+		return signedBlock, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if data, ok := fields["data"]; ok {
+		if _, isBlock := fields["slot"]; !isBlock {
+			return decodeSignedBlock(data, false, forks)
+		}
+	}
+
+	if _, ok := fields["message"]; ok {
+		var signedBlock types.SignedBlock
+		if err := json.Unmarshal(body, &signedBlock); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		if err := forks.ForkAt(signedBlock.Message.Slot).ValidateSignature(signedBlock.Signature); err != nil {
+			return nil, fmt.Errorf("invalid signed block at slot %d: %w", signedBlock.Message.Slot, err)
+		}
+		return &signedBlock, nil
+	}
+
+	var block types.Block
+	if err := json.Unmarshal(body, &block); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	var signature []byte
+	if fork := forks.ForkAt(block.Slot); fork.FixedSignatureSize {
+		signature = make([]byte, fork.MaxSignatureSize)
+	}
+	return &types.SignedBlock{Message: &block, Signature: signature}, nil
+}
+
+// decodeState decodes a state response: an SSZ state, or a JSON state optionally wrapped
+// in {"data": ...}
+func decodeState(body []byte, ssz bool) (*types.State, error) {
+	if ssz {
+		var state types.State
+		if err := state.UnmarshalSSZ(body); err != nil {
+			return nil, fmt.Errorf("failed to decode SSZ response: %w", err)
+		}
+		return &state, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if data, ok := fields["data"]; ok {
+		if _, isState := fields["slot"]; !isState {
+			return decodeState(data, false)
+		}
+	}
+
+	var state types.State
+	if err := json.Unmarshal(body, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	// Hashing checks the root and list sizes that SSZ decoding would
+	if _, err := state.HashTreeRoot(); err != nil {
+		return nil, fmt.Errorf("invalid state: %w", err)
+	}
+	return &state, nil
+}
+
+// verifyBlockRoot checks that the header hashes to the expected root, a nil root is not checked
+func verifyBlockRoot(blockHeader *types.BlockHeader, root []byte) error {
+	if root == nil {
+		return nil
+	}
+
+	computed, err := blockHeader.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to compute block root: %w", err)
+	}
+	if !bytes.Equal(computed[:], root) {
+		return fmt.Errorf("%w: expected 0x%x, header hashes to 0x%x", ErrRootMismatch, root, computed)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestDecodeBlockHeaderJSON(t *testing.T) {
+	header := &types.BlockHeader{
+		Slot:          12,
+		ProposerIndex: 3,
+		ParentRoot:    bytes.Repeat([]byte{0x01}, 32),
+		StateRoot:     bytes.Repeat([]byte{0x02}, 32),
+		BodyRoot:      bytes.Repeat([]byte{0x03}, 32),
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("hashing header: %v", err)
+	}
+	roots := fmt.Sprintf(`"parent_root": "0x%x", "state_root": "0x%x", "body_root": "0x%x"`, header.ParentRoot, header.StateRoot, header.BodyRoot)
+	bare := fmt.Sprintf(`{"slot": 12, "proposer_index": 3, %s}`, roots)
+	quoted := fmt.Sprintf(`{"slot": "12", "proposer_index": "3", %s}`, roots)
+	otherRoot := bytes.Repeat([]byte{0xaa}, 32)
+
+	tests := []struct {
+		name     string
+		body     string
+		expected error // Nil if the response decodes to the header
+	}{
+		{"bare header", bare, nil},
+		{"string uint64s", quoted, nil},
+		{"data wrapper", fmt.Sprintf(`{"data": %s}`, quoted), nil},
+		{"header with root", fmt.Sprintf(`{"root": "0x%x", "header": %s}`, root, bare), nil},
+		{"beacon nesting", fmt.Sprintf(`{"data": {"root": "0x%x", "header": {"message": %s}}}`, root, quoted), nil},
+		{"root mismatch", fmt.Sprintf(`{"root": "0x%x", "header": %s}`, otherRoot, bare), ErrRootMismatch},
+		{"wrapped root mismatch", fmt.Sprintf(`{"data": {"root": "0x%x", "header": {"message": %s}}}`, otherRoot, bare), ErrRootMismatch},
+	}
+	for _, test := range tests {
+		decoded, err := decodeBlockHeader([]byte(test.body), false)
+		if test.expected != nil {
+			if !errors.Is(err, test.expected) {
+				t.Errorf("%s: got error %v, want %v", test.name, err, test.expected)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		decodedRoot, err := decoded.HashTreeRoot()
+		if err != nil || decodedRoot != root {
+			t.Errorf("%s: decoded %+v, want the header", test.name, decoded)
+		}
+	}
+
+	// Malformed uint64s and roots are rejected
+	for _, body := range []string{
+		fmt.Sprintf(`{"slot": "twelve", "proposer_index": 3, %s}`, roots),
+		fmt.Sprintf(`{"root": "0x1234", "header": %s}`, bare),
+	} {
+		if _, err := decodeBlockHeader([]byte(body), false); err == nil {
+			t.Errorf("decoded malformed header %s", body)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package indexer
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// SignatureRecorder stores the proposer signature of every full block a client returns, with
+// the signature and block sizes in the layout of the block's fork. Post-quantum signatures
+// make up most of a Devnet 1 block, so their sizes are tracked over time.
+type SignatureRecorder struct {
+	forks *types.ForkSchedule
+	clock Clock
+
+	logger logrus.FieldLogger
+}
+
+// NewSignatureRecorder creates a recorder that sizes blocks by the fork schedule
+func NewSignatureRecorder(forks *types.ForkSchedule, clock Clock, logger logrus.FieldLogger) *SignatureRecorder {
+	return &SignatureRecorder{
+		forks:  forks,
+		clock:  clock,
+		logger: logger.WithField("component", "signature_recorder"),
+	}
+}
+
+// ObserveBlock stores the signature of a full block a client returned, a BlockObserver.
+// Blocks already stored are kept as first observed.
+func (sr *SignatureRecorder) ObserveBlock(client string, root []byte, signedBlock *types.SignedBlock) {
+	block := signedBlock.Message
+	fork := sr.forks.ForkAt(block.Slot)
+
+	signature := &types.BlockSignature{
+		BlockRoot:     root,
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		Fork:          fork.Name,
+		Signature:     signedBlock.Signature,
+		SignatureSize: uint64(len(signedBlock.Signature)),
+		BlockSize:     uint64(fork.SignedBlockSize(signedBlock)),
+		Votes:         uint64(len(block.Body.Votes)),
+		Client:        client,
+		ObservedAt:    sr.clock.Now().UnixMilli(),
+	}
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlockSignature(signature, tx)
+	})
+	if err != nil {
+		sr.logger.WithError(err).WithFields(logrus.Fields{
+			"slot":   block.Slot,
+			"client": client,
+		}).Warn("Failed to store block signature")
+	}
+}
@@ -0,0 +1,55 @@
+package indexer
+
+import (
+	"time"
+)
+
+// SlotClock maps wall clock time to slots based on the configured genesis time
+type SlotClock struct {
+	genesisTime  time.Time
+	slotDuration time.Duration
+	clock        Clock
+}
+
+// NewSlotClock creates a slot clock, a zero genesis time leaves the clock disabled
+func NewSlotClock(genesisTime uint64, slotDuration time.Duration, clock Clock) *SlotClock {
+	slotClock := &SlotClock{
+		slotDuration: slotDuration,
+		clock:        clock,
+	}
+	if genesisTime > 0 {
+		slotClock.genesisTime = time.Unix(int64(genesisTime), 0)
+	}
+	return slotClock
+}
+
+// Now returns the current time of the underlying clock
+func (sc *SlotClock) Now() time.Time {
+	return sc.clock.Now()
+}
+
+// IsEnabled reports whether a genesis time is known
+func (sc *SlotClock) IsEnabled() bool {
+	return !sc.genesisTime.IsZero()
+}
+
+// CurrentSlot returns the wall clock slot, and false if the clock is disabled
+func (sc *SlotClock) CurrentSlot() (uint64, bool) {
+	return sc.SlotAt(sc.clock.Now())
+}
+
+// SlotAt returns the slot active at the given time, and false if the clock is disabled
+func (sc *SlotClock) SlotAt(t time.Time) (uint64, bool) {
+	if !sc.IsEnabled() {
+		return 0, false
+	}
+	if t.Before(sc.genesisTime) {
+		return 0, true
+	}
+	return uint64(t.Sub(sc.genesisTime) / sc.slotDuration), true
+}
+
+// SlotStartTime returns the wall clock time at which the given slot starts
+func (sc *SlotClock) SlotStartTime(slot uint64) time.Time {
+	return sc.genesisTime.Add(time.Duration(slot) * sc.slotDuration)
+}
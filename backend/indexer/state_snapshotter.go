@@ -0,0 +1,344 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// StateSnapshotter fetches the head state from a healthy client every interval and stores
+// it by state root. The block hashes of every snapshot are compared with the indexed chain,
+// a client whose state disagrees with the stored headers is on another fork or buggy.
+type StateSnapshotter struct {
+	clientPool *ClientPool
+	interval   time.Duration
+	keep       int // Snapshots kept, 0 keeps all
+	clock      Clock
+
+	lastSnapshot *types.StateSnapshot
+
+	// Synchronization
+	isRunning     bool
+	ticker        Ticker
+	stopChannel   chan bool
+	snapshotMutex sync.Mutex // Serializes snapshots
+	mutex         sync.RWMutex
+
+	logger logrus.FieldLogger
+}
+
+// NewStateSnapshotter creates a snapshotter that runs every interval once started, keeping
+// the given number of snapshots
+func NewStateSnapshotter(clientPool *ClientPool, interval time.Duration, keep int, clock Clock, logger logrus.FieldLogger) *StateSnapshotter {
+	return &StateSnapshotter{
+		clientPool:  clientPool,
+		interval:    interval,
+		keep:        keep,
+		clock:       clock,
+		stopChannel: make(chan bool, 1),
+		logger:      logger.WithField("component", "state_snapshotter"),
+	}
+}
+
+// Start begins taking a snapshot every interval
+func (ss *StateSnapshotter) Start(ctx context.Context) error {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	if ss.isRunning {
+		return fmt.Errorf("state snapshotter is already running")
+	}
+	ss.isRunning = true
+	ss.ticker = ss.clock.NewTicker(ss.interval)
+
+	go ss.snapshotLoop(ctx, ss.ticker)
+
+	ss.logger.WithFields(logrus.Fields{
+		"interval": ss.interval,
+		"keep":     ss.keep,
+	}).Info("State snapshotter started")
+	return nil
+}
+
+// Stop stops taking snapshots, a running snapshot is finished first
+func (ss *StateSnapshotter) Stop() error {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	if !ss.isRunning {
+		return nil
+	}
+	ss.isRunning = false
+	ss.ticker.Stop()
+
+	select {
+	case ss.stopChannel <- true:
+	default:
+	}
+
+	ss.logger.Info("State snapshotter stopped")
+	return nil
+}
+
+// GetLastSnapshot returns the last stored snapshot without its state data, nil if none was taken yet
+func (ss *StateSnapshotter) GetLastSnapshot() *types.StateSnapshot {
+	ss.mutex.RLock()
+	defer ss.mutex.RUnlock()
+	return ss.lastSnapshot
+}
+
+// GetJustificationProgress returns how far each candidate target slot after the finalized
+// slot is from being justified, according to the state of the latest stored snapshot. It
+// returns nil if no snapshot is stored. The slots that justified the targets are unknown.
+func (ss *StateSnapshotter) GetJustificationProgress() (*JustificationProgress, error) {
+	latest, err := db.GetLatestStateSnapshots(1)
+	if err != nil || len(latest) == 0 {
+		return nil, err
+	}
+	snapshot, err := db.GetStateSnapshotByRoot(latest[0].StateRoot)
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+
+	state := &types.State{}
+	if err := state.UnmarshalSSZ(snapshot.Data); err != nil {
+		return nil, fmt.Errorf("failed to decode state snapshot at slot %d: %w", snapshot.Slot, err)
+	}
+	progress, err := newJustificationProgress(state, snapshot.Slot, nil)
+	if err != nil {
+		return nil, err
+	}
+	progress.Client = snapshot.Client
+	return progress, nil
+}
+
+// snapshotLoop takes a snapshot on every tick until stopped
+func (ss *StateSnapshotter) snapshotLoop(ctx context.Context, ticker Ticker) {
+	for {
+		select {
+		case <-ticker.C():
+			if _, err := ss.Snapshot(ctx, ""); err != nil {
+				ss.logger.WithError(err).Warn("Failed to take state snapshot")
+			}
+		case <-ss.stopChannel:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Snapshot fetches the head state from the named client, or from a healthy client if the
+// name is empty, compares its block hashes with the indexed chain and stores it
+func (ss *StateSnapshotter) Snapshot(ctx context.Context, clientName string) (*types.StateSnapshot, error) {
+	ss.snapshotMutex.Lock()
+	defer ss.snapshotMutex.Unlock()
+
+	client := ss.clientPool.GetHealthyClient()
+	if clientName != "" {
+		client = ss.clientPool.GetClientByName(clientName)
+	}
+	if client == nil {
+		return nil, errors.New("no client available for state snapshot")
+	}
+
+	state, err := client.GetState(ctx, "head")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch head state from %s: %w", client.GetConfig().Name, err)
+	}
+	snapshot, err := NewStateSnapshot(state, client.GetConfig().Name, ss.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := CheckBlockHashes(state)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.CheckedHashes = check.Checked
+	snapshot.MismatchedHashes = uint64(len(check.Mismatches))
+	snapshot.UnindexedHashes = uint64(len(check.Unindexed))
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		if err := db.UpsertStateSnapshot(snapshot, tx); err != nil {
+			return err
+		}
+		if ss.keep > 0 {
+			return db.PruneStateSnapshots(ss.keep, tx)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stored := *snapshot
+	stored.Data = nil
+	ss.mutex.Lock()
+	ss.lastSnapshot = &stored
+	ss.mutex.Unlock()
+
+	entry := ss.logger.WithFields(logrus.Fields{
+		"slot":       snapshot.Slot,
+		"state_root": fmt.Sprintf("0x%x", snapshot.StateRoot),
+		"client":     snapshot.Client,
+		"size":       snapshot.Size,
+		"checked":    check.Checked,
+		"mismatched": len(check.Mismatches),
+		"unindexed":  len(check.Unindexed),
+	})
+	if len(check.Mismatches) > 0 {
+		first := check.Mismatches[0]
+		entry.WithFields(logrus.Fields{
+			"first_mismatch_slot": first.Slot,
+			"state_block_root":    fmt.Sprintf("0x%x", first.StateRoot),
+			"indexed_block_root":  fmt.Sprintf("0x%x", first.IndexedRoot),
+		}).Warn("State block hashes differ from the indexed chain")
+	} else {
+		entry.Info("Stored state snapshot")
+	}
+	return snapshot, nil
+}
+
+// NewStateSnapshot encodes a state as a snapshot fetched from the client at the given time,
+// without hash check results
+func NewStateSnapshot(state *types.State, client string, fetchedAt time.Time) (*types.StateSnapshot, error) {
+	if state.LatestBlockHeader == nil || state.LatestJustified == nil || state.LatestFinalized == nil {
+		return nil, errors.New("state is missing its latest block header or a checkpoint")
+	}
+
+	data, err := state.MarshalSSZ()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state: %w", err)
+	}
+	stateRoot, err := state.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute state root: %w", err)
+	}
+	blockRoot, err := StateBlockRoot(state, stateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StateSnapshot{
+		StateRoot:     stateRoot[:],
+		Slot:          state.Slot,
+		BlockRoot:     blockRoot[:],
+		Client:        client,
+		JustifiedSlot: state.LatestJustified.Slot,
+		JustifiedRoot: state.LatestJustified.Root,
+		FinalizedSlot: state.LatestFinalized.Slot,
+		FinalizedRoot: state.LatestFinalized.Root,
+		Data:          data,
+		Size:          uint64(len(data)),
+		FetchedAt:     fetchedAt.UnixMilli(),
+	}, nil
+}
+
+// StateBlockRoot returns the root of the state's latest block. A post-block state has the
+// state root of its latest header zeroed until the next slot, so it is filled in first.
+func StateBlockRoot(state *types.State, stateRoot [32]byte) ([32]byte, error) {
+	header := *state.LatestBlockHeader
+	if bytes.Equal(header.StateRoot, zeroRoot) {
+		header.StateRoot = stateRoot[:]
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to compute latest block root: %w", err)
+	}
+	return root, nil
+}
+
+// zeroRoot is the block hash of a missed slot and the state root of a fresh latest block header
+var zeroRoot = make([]byte, 32)
+
+// CheckBlockHashes compares the state's block hashes, historical_block_hashes followed by
+// the root of its latest block, with the indexed headers from slot 1 up to the latest
+// indexed slot. The genesis block is not indexed, so slot 0 is not compared.
+func CheckBlockHashes(state *types.State) (*types.HashCheck, error) {
+	stateRoot, err := state.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute state root: %w", err)
+	}
+	latestRoot, err := StateBlockRoot(state, stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	latestSlot := state.LatestBlockHeader.Slot
+
+	// Block root of the state at a slot, zero for a missed slot
+	stateHash := func(slot uint64) []byte {
+		switch {
+		case slot == latestSlot:
+			return latestRoot[:]
+		case slot < uint64(len(state.HistoricalBlockHashes)):
+			return state.HistoricalBlockHashes[slot]
+		}
+		return zeroRoot
+	}
+
+	check := &types.HashCheck{}
+	latest, err := db.GetLatestBlockHeaders(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(latest) == 0 || latestSlot == 0 {
+		return check, nil
+	}
+	lastSlot := min(latestSlot, latest[0].Slot)
+
+	next := uint64(1)
+	compare := func(upTo uint64, indexed *types.BlockHeader) error {
+		// Slots without an indexed header before the indexed one, or up to upTo
+		for ; next < upTo; next++ {
+			check.Checked++
+			if hash := stateHash(next); !bytes.Equal(hash, zeroRoot) {
+				check.Unindexed = append(check.Unindexed, next)
+			}
+		}
+		if indexed == nil {
+			return nil
+		}
+
+		indexedRoot, err := indexed.HashTreeRoot()
+		if err != nil {
+			return fmt.Errorf("failed to compute root of the indexed header at slot %d: %w", indexed.Slot, err)
+		}
+		check.Checked++
+		if hash := stateHash(indexed.Slot); !bytes.Equal(hash, indexedRoot[:]) {
+			check.Mismatches = append(check.Mismatches, &types.HashMismatch{
+				Slot:        indexed.Slot,
+				StateRoot:   hash,
+				IndexedRoot: indexedRoot[:],
+			})
+		}
+		next = indexed.Slot + 1
+		return nil
+	}
+
+	for next <= lastSlot {
+		end := min(next+chainVerifyBatchSize-1, lastSlot)
+		headers, err := db.GetBlockHeadersInRange(next, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, header := range headers {
+			if err := compare(header.Slot, header); err != nil {
+				return nil, err
+			}
+		}
+		if err := compare(end+1, nil); err != nil {
+			return nil, err
+		}
+	}
+	return check, nil
+}
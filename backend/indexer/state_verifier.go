@@ -0,0 +1,578 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/stf"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+const (
+	// verifyBatchSize is how many indexed headers are read from the database at a time
+	verifyBatchSize = 64
+
+	// keptVerifiedStates is how many recent post-states are kept to roll back to on a reorg
+	keptVerifiedStates = 64
+)
+
+// verifiedState is the post-state of a verified block
+type verifiedState struct {
+	root  []byte
+	slot  uint64
+	state *types.State
+}
+
+// JustificationTarget is the justification progress of a candidate target slot
+type JustificationTarget struct {
+	stf.TargetProgress
+	JustifiedAt uint64 // Slot of the block whose votes justified it, 0 if unknown
+}
+
+// JustificationProgress is the justification state of the verified tip or of a state snapshot
+type JustificationProgress struct {
+	StateSlot     uint64 // Slot of the block the progress is taken from
+	Client        string // Client the state snapshot was fetched from, empty for the verified tip
+	NumValidators uint64
+	Threshold     uint64 // Votes that justify a target
+	Justified     *types.Checkpoint
+	Finalized     *types.Checkpoint
+	Targets       []*JustificationTarget // Slots after the finalized slot, in slot order
+}
+
+// StateVerifier replays the indexed chain through the local state transition, starting
+// from the genesis state, and flags blocks whose state root differs from the computed
+// post-state or that the transition rejects. Full blocks are fetched from the clients
+// since only headers are indexed. The verified tip is stored, so a restart resumes from it.
+type StateVerifier struct {
+	clientPool *ClientPool
+	interval   time.Duration
+	clock      Clock
+
+	genesis *verifiedState
+
+	// Verified chain, recent[len(recent)-1] is the tip
+	recent []*verifiedState
+
+	// Root of the last rejected block, verification waits until it is reorged out
+	rejectedRoot []byte
+
+	// Slot of the block that justified each target slot since the finalized slot
+	justifiedAt map[uint64]uint64
+
+	// Whether the first indexed block was compared with the local genesis, and whether it
+	// differed, which stops verification
+	genesisChecked  bool
+	genesisMismatch bool
+
+	// Root of the last stored verified tip
+	savedRoot []byte
+
+	// Synchronization
+	isRunning   bool
+	ticker      Ticker
+	stopChannel chan bool
+	mutex       sync.RWMutex
+
+	logger logrus.FieldLogger
+}
+
+// NewStateVerifier creates a verifier for a chain with the given genesis time and validator count
+func NewStateVerifier(clientPool *ClientPool, genesisTime, validatorCount uint64, interval time.Duration, clock Clock, logger logrus.FieldLogger) (*StateVerifier, error) {
+	genesisState, err := stf.GenerateGenesisState(genesisTime, validatorCount)
+	if err != nil {
+		return nil, err
+	}
+	genesisBlock, err := stf.GenesisBlock(genesisState)
+	if err != nil {
+		return nil, err
+	}
+	genesisHeader, err := genesisBlock.Header()
+	if err != nil {
+		return nil, err
+	}
+	genesisRoot, err := genesisHeader.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash genesis block: %w", err)
+	}
+
+	genesis := &verifiedState{root: genesisRoot[:], state: genesisState}
+	return &StateVerifier{
+		clientPool:  clientPool,
+		interval:    interval,
+		clock:       clock,
+		genesis:     genesis,
+		recent:      []*verifiedState{genesis},
+		justifiedAt: make(map[uint64]uint64),
+		stopChannel: make(chan bool, 1),
+		logger:      logger.WithField("component", "state_verifier"),
+	}, nil
+}
+
+// Start begins verifying indexed blocks every interval
+func (sv *StateVerifier) Start(ctx context.Context) error {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+
+	if sv.isRunning {
+		return fmt.Errorf("state verifier is already running")
+	}
+	if err := sv.restoreProgress(); err != nil {
+		sv.logger.WithError(err).Warn("Failed to restore verification progress, starting from genesis")
+	}
+	sv.isRunning = true
+	sv.ticker = sv.clock.NewTicker(sv.interval)
+
+	go sv.verifyLoop(ctx, sv.ticker)
+
+	sv.logger.WithField("genesis_root", fmt.Sprintf("0x%x", sv.genesis.root)).Info("State verifier started")
+	return nil
+}
+
+// Stop stops verification, a block being verified is finished first
+func (sv *StateVerifier) Stop() error {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+
+	if !sv.isRunning {
+		return nil
+	}
+	sv.isRunning = false
+	sv.ticker.Stop()
+
+	select {
+	case sv.stopChannel <- true:
+	default:
+	}
+
+	sv.logger.Info("State verifier stopped")
+	return nil
+}
+
+// GetLastVerifiedSlot returns the slot of the last block applied to the verified state
+func (sv *StateVerifier) GetLastVerifiedSlot() uint64 {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	return sv.tip().slot
+}
+
+// GetJustificationProgress returns how far each candidate target slot after the finalized
+// slot is from being justified, according to the state of the verified tip
+func (sv *StateVerifier) GetJustificationProgress() (*JustificationProgress, error) {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+
+	tip := sv.tip()
+	return newJustificationProgress(tip.state, tip.slot, sv.justifiedAt)
+}
+
+// newJustificationProgress computes the progress of every candidate target of a state.
+// justifiedAt maps justified slots to the slot of the block that justified them, it may be nil.
+func newJustificationProgress(state *types.State, stateSlot uint64, justifiedAt map[uint64]uint64) (*JustificationProgress, error) {
+	targets, err := stf.JustificationProgress(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute justification progress at slot %d: %w", stateSlot, err)
+	}
+
+	progress := &JustificationProgress{
+		StateSlot:     stateSlot,
+		NumValidators: state.Config.NumValidators,
+		Threshold:     stf.JustificationThreshold(state.Config.NumValidators),
+		Justified:     state.LatestJustified,
+		Finalized:     state.LatestFinalized,
+		Targets:       make([]*JustificationTarget, 0, len(targets)),
+	}
+	for _, target := range targets {
+		progress.Targets = append(progress.Targets, &JustificationTarget{
+			TargetProgress: *target,
+			JustifiedAt:    justifiedAt[target.Slot],
+		})
+	}
+	return progress, nil
+}
+
+// verifyLoop verifies new blocks on every tick until stopped
+func (sv *StateVerifier) verifyLoop(ctx context.Context, ticker Ticker) {
+	for {
+		select {
+		case <-ticker.C():
+			if err := sv.verifyIndexedBlocks(ctx); err != nil {
+				sv.logger.WithError(err).Warn("Failed to verify indexed blocks")
+			}
+		case <-sv.stopChannel:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// verifyIndexedBlocks checks the genesis against the indexed chain, then applies the
+// indexed blocks after the verified tip and stores the new tip
+func (sv *StateVerifier) verifyIndexedBlocks(ctx context.Context) error {
+	if ok, err := sv.checkGenesis(); err != nil || !ok {
+		return err
+	}
+
+	err := sv.applyIndexedBlocks(ctx)
+	sv.saveProgress()
+	return err
+}
+
+// checkGenesis compares the local genesis block with the parent of the first indexed block.
+// They differ if chain.genesisTime or the validator count do not match the network, and no
+// block would ever apply, so the mismatch is flagged and verification stops. It reports
+// whether verification can proceed.
+func (sv *StateVerifier) checkGenesis() (bool, error) {
+	if sv.genesisChecked {
+		return !sv.genesisMismatch, nil
+	}
+
+	// Indexing starts at slot 1, the first indexed block is the first block after genesis
+	headers, err := db.GetBlockHeadersAfterSlot(0, 1)
+	if err != nil || len(headers) == 0 {
+		return false, err
+	}
+	first := headers[0]
+	sv.genesisChecked = true
+	if bytes.Equal(first.ParentRoot, sv.genesis.root) {
+		return true, nil
+	}
+
+	root, err := first.HashTreeRoot()
+	if err != nil {
+		return false, fmt.Errorf("failed to hash header at slot %d: %w", first.Slot, err)
+	}
+	sv.genesisMismatch = true
+	config := sv.genesis.state.Config
+	sv.flagBlock(&types.InvalidBlock{
+		Root:      root[:],
+		Slot:      first.Slot,
+		Reason:    types.InvalidBlockGenesisMismatch,
+		StateRoot: first.StateRoot,
+		Error: fmt.Sprintf("parent root 0x%x is not the root 0x%x of the genesis for time %d and %d validators, check chain.genesisTime and chain.validatorCount",
+			first.ParentRoot, sv.genesis.root, config.GenesisTime, config.NumValidators),
+	})
+	return false, nil
+}
+
+// applyIndexedBlocks applies the indexed blocks after the verified tip until it catches
+// up, or until it has to wait for catchup or a reorg
+func (sv *StateVerifier) applyIndexedBlocks(ctx context.Context) error {
+	for ctx.Err() == nil {
+		headers, err := db.GetBlockHeadersAfterSlot(sv.getTip().slot, verifyBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, header := range headers {
+			progressed, err := sv.verifyHeader(ctx, header)
+			if err != nil || !progressed {
+				return err
+			}
+		}
+
+		if len(headers) < verifyBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// verifyHeader applies the block of an indexed header on top of the verified tip. It
+// reports false if the block cannot be applied yet.
+func (sv *StateVerifier) verifyHeader(ctx context.Context, header *types.BlockHeader) (bool, error) {
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return false, fmt.Errorf("failed to hash header at slot %d: %w", header.Slot, err)
+	}
+	if bytes.Equal(root[:], sv.rejectedRoot) {
+		return false, nil
+	}
+
+	tip := sv.getTip()
+	if !bytes.Equal(header.ParentRoot, tip.root) {
+		canonical, err := sv.isCanonical(tip)
+		if err != nil {
+			return false, err
+		}
+		if canonical {
+			// The parent is not indexed yet, wait for catchup
+			sv.logger.WithField("slot", header.Slot).Debug("Parent of indexed block is not indexed yet")
+			return false, nil
+		}
+		// Verification continues from the rolled back tip on the next tick
+		return false, sv.rollback()
+	}
+
+	signedBlock, client, err := sv.fetchSignedBlock(ctx, root[:])
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch block at slot %d: %w", header.Slot, err)
+	}
+
+	// Devnet 0 signatures are placeholders, so they are not checked
+	postState, err := stf.Copy(tip.state)
+	if err != nil {
+		return false, err
+	}
+	if err := stf.StateTransition(postState, signedBlock, true, false); err != nil {
+		if !errors.Is(err, stf.ErrInvalidBlock) && !errors.Is(err, stf.ErrInvalidState) {
+			return false, err
+		}
+
+		sv.rejectedRoot = root[:]
+		sv.flagBlock(&types.InvalidBlock{
+			Root:      root[:],
+			Slot:      header.Slot,
+			Reason:    types.InvalidBlockRejected,
+			StateRoot: header.StateRoot,
+			Error:     err.Error(),
+			Client:    client,
+		})
+		return false, nil
+	}
+
+	stateRoot, err := postState.HashTreeRoot()
+	if err != nil {
+		return false, fmt.Errorf("failed to hash post-state at slot %d: %w", header.Slot, err)
+	}
+	if !bytes.Equal(stateRoot[:], header.StateRoot) {
+		// Continue from the computed state, later blocks are checked against our own transition
+		sv.flagBlock(&types.InvalidBlock{
+			Root:              root[:],
+			Slot:              header.Slot,
+			Reason:            types.InvalidBlockStateRootMismatch,
+			StateRoot:         header.StateRoot,
+			ComputedStateRoot: stateRoot[:],
+			Client:            client,
+		})
+	}
+
+	sv.advance(&verifiedState{root: root[:], slot: header.Slot, state: postState})
+	sv.logger.WithField("slot", header.Slot).Debug("Verified block")
+	return true, nil
+}
+
+// fetchSignedBlock fetches a full block from the first healthy client that serves it
+func (sv *StateVerifier) fetchSignedBlock(ctx context.Context, root []byte) (*types.SignedBlock, string, error) {
+	err := errors.New("no healthy clients available")
+	for _, client := range sv.clientPool.GetAllClients() {
+		if !client.IsHealthy() {
+			continue
+		}
+
+		var signedBlock *types.SignedBlock
+		signedBlock, err = client.GetSignedBlock(ctx, root)
+		if err == nil {
+			return signedBlock, client.GetConfig().Name, nil
+		}
+	}
+	return nil, "", err
+}
+
+// isCanonical reports whether a verified block is still the indexed block at its slot
+func (sv *StateVerifier) isCanonical(verified *verifiedState) (bool, error) {
+	if verified == sv.genesis {
+		return true, nil
+	}
+
+	header, err := db.GetBlockHeaderBySlot(verified.slot)
+	if err != nil || header == nil {
+		return false, err
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return false, fmt.Errorf("failed to hash header at slot %d: %w", header.Slot, err)
+	}
+	return bytes.Equal(root[:], verified.root), nil
+}
+
+// rollback drops verified blocks that were reorged out of the index, starting over from
+// genesis if none of the kept states is canonical
+func (sv *StateVerifier) rollback() error {
+	tip := sv.getTip()
+
+	keep := 0
+	for i := len(sv.recent) - 1; i >= 0; i-- {
+		canonical, err := sv.isCanonical(sv.recent[i])
+		if err != nil {
+			return err
+		}
+		if canonical {
+			keep = i + 1
+			break
+		}
+	}
+
+	sv.mutex.Lock()
+	if keep == 0 {
+		sv.recent = []*verifiedState{sv.genesis}
+	} else {
+		sv.recent = sv.recent[:keep]
+	}
+	sv.rejectedRoot = nil
+	newTip := sv.tip()
+	for slot, justifiedAt := range sv.justifiedAt {
+		if justifiedAt > newTip.slot {
+			delete(sv.justifiedAt, slot)
+		}
+	}
+	sv.mutex.Unlock()
+
+	sv.logger.WithFields(logrus.Fields{
+		"from_slot": tip.slot,
+		"to_slot":   newTip.slot,
+	}).Info("Rolled back verified state after a reorg")
+	return nil
+}
+
+// advance makes a verified block the new tip, recording the targets its votes justified
+func (sv *StateVerifier) advance(verified *verifiedState) {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+
+	previous := sv.tip().state.JustifiedSlots
+	for slot, justified := range verified.state.JustifiedSlots {
+		if justified != 0 && (slot >= len(previous) || previous[slot] == 0) {
+			sv.justifiedAt[uint64(slot)] = verified.slot
+		}
+	}
+	for slot := range sv.justifiedAt {
+		if slot < verified.state.LatestFinalized.Slot {
+			delete(sv.justifiedAt, slot)
+		}
+	}
+
+	sv.recent = append(sv.recent, verified)
+	if len(sv.recent) > keptVerifiedStates {
+		sv.recent = append([]*verifiedState(nil), sv.recent[len(sv.recent)-keptVerifiedStates:]...)
+	}
+}
+
+// restoreProgress resumes from the verified tip stored by an earlier run, unless it belongs
+// to another genesis or was reorged out of the index since
+func (sv *StateVerifier) restoreProgress() error {
+	progress, err := db.GetVerifierProgress()
+	if err != nil || progress == nil {
+		return err
+	}
+
+	state := &types.State{}
+	if err := state.UnmarshalSSZ(progress.Data); err != nil {
+		return fmt.Errorf("failed to decode stored state at slot %d: %w", progress.Slot, err)
+	}
+	genesisConfig := sv.genesis.state.Config
+	if state.Config.GenesisTime != genesisConfig.GenesisTime || state.Config.NumValidators != genesisConfig.NumValidators {
+		sv.logger.WithField("slot", progress.Slot).Info("Stored verification progress is for another genesis, starting from genesis")
+		return nil
+	}
+
+	verified := &verifiedState{root: progress.BlockRoot, slot: progress.Slot, state: state}
+	canonical, err := sv.isCanonical(verified)
+	if err != nil {
+		return err
+	}
+	if !canonical {
+		sv.logger.WithField("slot", progress.Slot).Info("Stored verified block is no longer indexed, starting from genesis")
+		return nil
+	}
+
+	justifiedAt := make(map[uint64]uint64)
+	if err := json.Unmarshal([]byte(progress.JustifiedAt), &justifiedAt); err != nil {
+		return fmt.Errorf("failed to decode stored justification slots: %w", err)
+	}
+
+	sv.recent = append(sv.recent, verified)
+	sv.justifiedAt = justifiedAt
+	sv.savedRoot = progress.BlockRoot
+
+	sv.logger.WithField("slot", progress.Slot).Info("Resumed state verification from stored progress")
+	return nil
+}
+
+// saveProgress stores the verified tip if it changed since it was last stored
+func (sv *StateVerifier) saveProgress() {
+	sv.mutex.RLock()
+	tip := sv.tip()
+	justifiedAt := maps.Clone(sv.justifiedAt)
+	sv.mutex.RUnlock()
+
+	if tip == sv.genesis || bytes.Equal(tip.root, sv.savedRoot) {
+		return
+	}
+
+	data, err := tip.state.MarshalSSZ()
+	if err != nil {
+		sv.logger.WithError(err).Warn("Failed to encode verified state")
+		return
+	}
+	justified, err := json.Marshal(justifiedAt)
+	if err != nil {
+		sv.logger.WithError(err).Warn("Failed to encode justification slots")
+		return
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.SaveVerifierProgress(&types.VerifierProgress{
+			BlockRoot:   tip.root,
+			Slot:        tip.slot,
+			Data:        data,
+			JustifiedAt: string(justified),
+			SavedAt:     sv.clock.Now().UnixMilli(),
+		}, tx)
+	})
+	if err != nil {
+		sv.logger.WithError(err).Warn("Failed to store verification progress")
+		return
+	}
+	sv.savedRoot = tip.root
+}
+
+// flagBlock logs and stores a block that failed verification
+func (sv *StateVerifier) flagBlock(invalidBlock *types.InvalidBlock) {
+	invalidBlock.DetectedAt = sv.clock.Now().UnixMilli()
+
+	entry := sv.logger.WithFields(logrus.Fields{
+		"slot":       invalidBlock.Slot,
+		"root":       fmt.Sprintf("0x%x", invalidBlock.Root),
+		"state_root": fmt.Sprintf("0x%x", invalidBlock.StateRoot),
+		"client":     invalidBlock.Client,
+	})
+	switch invalidBlock.Reason {
+	case types.InvalidBlockRejected:
+		entry.WithField("error", invalidBlock.Error).Error("State transition rejected an indexed block")
+	case types.InvalidBlockGenesisMismatch:
+		entry.WithField("error", invalidBlock.Error).Error("First indexed block does not build on the local genesis, state verification is stopped")
+	default:
+		entry.WithField("computed_state_root", fmt.Sprintf("0x%x", invalidBlock.ComputedStateRoot)).Error("Indexed block has a wrong state root")
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.UpsertInvalidBlock(invalidBlock, tx)
+	})
+	if err != nil {
+		sv.logger.WithError(err).Warn("Failed to store invalid block")
+	}
+}
+
+// getTip returns the verified tip
+func (sv *StateVerifier) getTip() *verifiedState {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	return sv.tip()
+}
+
+// tip returns the verified tip, the caller must hold the mutex
+func (sv *StateVerifier) tip() *verifiedState {
+	return sv.recent[len(sv.recent)-1]
+}
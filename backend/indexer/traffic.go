@@ -0,0 +1,370 @@
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// TransportWrapper wraps the HTTP transport of every endpoint client, used to record and
+// replay lean node traffic
+type TransportWrapper interface {
+	WrapTransport(endpoint string, transport http.RoundTripper) http.RoundTripper
+}
+
+// maxArchiveLine bounds a single line of a traffic archive when reading it back
+const maxArchiveLine = 4 * maxResponseSize
+
+// TrafficRecorder appends every request made to the lean nodes and its response to a
+// traffic archive, one JSON object per line after the archive header
+type TrafficRecorder struct {
+	file    *os.File
+	encoder *json.Encoder
+	clock   Clock
+	failed  bool // Set after the first write error, which is logged once
+	mutex   sync.Mutex
+
+	logger logrus.FieldLogger
+}
+
+// NewTrafficRecorder creates the archive file, replacing an existing one, and writes its header
+func NewTrafficRecorder(path string, header *types.TrafficArchiveHeader, clock Clock, logger logrus.FieldLogger) (*TrafficRecorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create traffic archive: %w", err)
+	}
+
+	encoder := json.NewEncoder(file)
+	if err := encoder.Encode(header); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to write traffic archive header: %w", err)
+	}
+
+	return &TrafficRecorder{
+		file:    file,
+		encoder: encoder,
+		clock:   clock,
+		logger:  logger.WithField("component", "traffic_recorder"),
+	}, nil
+}
+
+// WrapTransport records the requests made through the transport under the endpoint name
+func (tr *TrafficRecorder) WrapTransport(endpoint string, transport http.RoundTripper) http.RoundTripper {
+	return &recordingTransport{
+		endpoint: endpoint,
+		next:     transport,
+		recorder: tr,
+	}
+}
+
+// Close flushes and closes the archive
+func (tr *TrafficRecorder) Close() error {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	return tr.file.Close()
+}
+
+// write appends a record to the archive
+func (tr *TrafficRecorder) write(record *types.TrafficRecord) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	if err := tr.encoder.Encode(record); err != nil && !tr.failed {
+		tr.failed = true
+		tr.logger.WithError(err).Error("Failed to write traffic archive, further errors are not logged")
+	}
+}
+
+// recordingTransport passes requests on and records them along with their responses
+type recordingTransport struct {
+	endpoint string
+	next     http.RoundTripper
+	recorder *TrafficRecorder
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clock := rt.recorder.clock
+	record := &types.TrafficRecord{
+		Endpoint:  rt.endpoint,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		Accept:    req.Header.Get("Accept"),
+		StartedAt: clock.Now(),
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		record.Duration = clock.Now().Sub(record.StartedAt)
+		record.Error = err.Error()
+		rt.recorder.write(record)
+		return nil, err
+	}
+
+	// Read the body here so its transfer time is part of the recorded duration
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	resp.Body.Close()
+	record.Duration = clock.Now().Sub(record.StartedAt)
+	if err != nil {
+		record.Error = err.Error()
+		rt.recorder.write(record)
+		return nil, err
+	}
+
+	record.Status = resp.StatusCode
+	record.ContentType = resp.Header.Get("Content-Type")
+	record.Body = body
+	rt.recorder.write(record)
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// ReadTrafficArchive reads the header and records of a traffic archive
+func ReadTrafficArchive(path string) (*types.TrafficArchiveHeader, []*types.TrafficRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open traffic archive: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxArchiveLine)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, nil, fmt.Errorf("failed to read traffic archive: %w", err)
+		}
+		return nil, nil, errors.New("traffic archive is empty")
+	}
+	header := &types.TrafficArchiveHeader{}
+	if err := json.Unmarshal(scanner.Bytes(), header); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode traffic archive header: %w", err)
+	}
+	if header.Version != types.TrafficArchiveVersion {
+		return nil, nil, fmt.Errorf("unsupported traffic archive version %d", header.Version)
+	}
+
+	var records []*types.TrafficRecord
+	var decodeErr error
+	for line := 2; scanner.Scan(); line++ {
+		if decodeErr != nil {
+			return nil, nil, decodeErr
+		}
+		record := &types.TrafficRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			// Tolerated on the last line, which is cut short if the recording process was killed
+			decodeErr = fmt.Errorf("failed to decode traffic archive line %d: %w", line, err)
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read traffic archive: %w", err)
+	}
+
+	return header, records, nil
+}
+
+// ErrNotRecorded is returned by the replay transport for requests the archive holds no
+// response to
+var ErrNotRecorded = errors.New("no recorded response")
+
+// TrafficReplayer serves recorded responses in place of the lean nodes. A request is
+// answered with the last response recorded for the same endpoint, path and Accept header
+// at or before the current time of the replay clock, or the first one after it if none
+// was recorded before. Recorded durations and transport errors are replayed too.
+//
+// Requests without a recorded response fail with ErrNotRecorded, responses are never
+// borrowed from another endpoint or content type. Headers an endpoint returned as head
+// or checkpoint are also served when the same endpoint is asked for them by slot or root.
+type TrafficReplayer struct {
+	header *types.TrafficArchiveHeader
+	clock  Clock
+
+	// Records by endpoint, path and Accept header, sorted by start time
+	records map[string][]*types.TrafficRecord
+	end     time.Time
+
+	endOnce sync.Once
+	logger  logrus.FieldLogger
+}
+
+// replayKey is the key of the records answering a request
+func replayKey(endpoint, path, accept string) string {
+	return endpoint + "\x00" + path + "\x00" + accept
+}
+
+// NewTrafficReplayer indexes the records of an archive for replay on the given clock
+func NewTrafficReplayer(header *types.TrafficArchiveHeader, records []*types.TrafficRecord, clock Clock, logger logrus.FieldLogger) *TrafficReplayer {
+	tr := &TrafficReplayer{
+		header:  header,
+		clock:   clock,
+		records: make(map[string][]*types.TrafficRecord),
+		end:     header.StartedAt,
+		logger:  logger.WithField("component", "traffic_replayer"),
+	}
+
+	for _, record := range records {
+		key := replayKey(record.Endpoint, record.Path, record.Accept)
+		tr.records[key] = append(tr.records[key], record)
+
+		if recordEnd := record.StartedAt.Add(record.Duration); recordEnd.After(tr.end) {
+			tr.end = recordEnd
+		}
+
+		if blockHeader, root := decodeRecordedHeader(record); blockHeader != nil {
+			for _, blockId := range []string{fmt.Sprintf("%d", blockHeader.Slot), fmt.Sprintf("0x%x", root)} {
+				if path := headersPath + blockId; path != record.Path {
+					key := replayKey(record.Endpoint, path, record.Accept)
+					tr.records[key] = append(tr.records[key], record)
+				}
+			}
+		}
+	}
+	for _, list := range tr.records {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].StartedAt.Before(list[j].StartedAt)
+		})
+	}
+
+	return tr
+}
+
+// Endpoints returns the recorded endpoints in pool order, followed by endpoints that were
+// added while recording
+func (tr *TrafficReplayer) Endpoints() []types.EndpointConfig {
+	var endpoints []types.EndpointConfig
+	var names []string
+	for _, endpoint := range tr.header.Endpoints {
+		endpoints = append(endpoints, types.EndpointConfig{Name: endpoint.Name, Url: endpoint.Url})
+		names = append(names, endpoint.Name)
+	}
+
+	var added []string
+	for key := range tr.records {
+		name, _, _ := strings.Cut(key, "\x00")
+		if !slices.Contains(names, name) && !slices.Contains(added, name) {
+			added = append(added, name)
+		}
+	}
+	slices.Sort(added)
+	for _, name := range added {
+		// The URL is not used for replay, the replay transport answers every request
+		endpoints = append(endpoints, types.EndpointConfig{Name: name, Url: "http://replay.invalid"})
+	}
+
+	return endpoints
+}
+
+// WrapTransport replaces the transport with one that answers from the archive
+func (tr *TrafficReplayer) WrapTransport(endpoint string, _ http.RoundTripper) http.RoundTripper {
+	return &replayTransport{
+		endpoint: endpoint,
+		replayer: tr,
+	}
+}
+
+// decodeRecordedHeader returns the block header of a successful header response and its root,
+// nil if the record holds none
+func decodeRecordedHeader(record *types.TrafficRecord) (*types.BlockHeader, [32]byte) {
+	if record.Status != http.StatusOK || !strings.HasPrefix(record.Path, headersPath) {
+		return nil, [32]byte{}
+	}
+
+	var blockHeader *types.BlockHeader
+	var err error
+	if mediaType, _, _ := mime.ParseMediaType(record.ContentType); mediaType == contentTypeSSZ {
+		blockHeader, err = decodeBlockHeaderSSZ(record.Body)
+	} else {
+		blockHeader, _, err = decodeBlockHeaderJSON(record.Body)
+	}
+	if err != nil {
+		return nil, [32]byte{}
+	}
+
+	root, err := blockHeader.HashTreeRoot()
+	if err != nil {
+		return nil, [32]byte{}
+	}
+	return blockHeader, root
+}
+
+// lookup returns the record answering a request at the given time, nil if none was recorded
+func (tr *TrafficReplayer) lookup(endpoint, path, accept string, at time.Time) *types.TrafficRecord {
+	list := tr.records[replayKey(endpoint, path, accept)]
+	if len(list) == 0 {
+		return nil
+	}
+
+	// First record starting after the time, the one before it is the latest at or before it
+	idx := sort.Search(len(list), func(i int) bool {
+		return list[i].StartedAt.After(at)
+	})
+	if idx == 0 {
+		return list[0]
+	}
+	return list[idx-1]
+}
+
+// replayTransport answers the requests of one endpoint from the archive
+type replayTransport struct {
+	endpoint string
+	replayer *TrafficReplayer
+}
+
+func (rt *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tr := rt.replayer
+	now := tr.clock.Now()
+	if now.After(tr.end) {
+		tr.endOnce.Do(func() {
+			tr.logger.Info("Replay reached the end of the archive, serving the last recorded responses")
+		})
+	}
+
+	accept := req.Header.Get("Accept")
+	record := tr.lookup(rt.endpoint, req.URL.Path, accept, now)
+	if record == nil {
+		return nil, fmt.Errorf("%w for %s %s accepting %q", ErrNotRecorded, rt.endpoint, req.URL.Path, accept)
+	}
+
+	select {
+	case <-tr.clock.After(record.Duration):
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	if record.Error != "" {
+		return nil, fmt.Errorf("replayed: %s", record.Error)
+	}
+
+	header := make(http.Header)
+	if record.ContentType != "" {
+		header.Set("Content-Type", record.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", record.Status, http.StatusText(record.Status)),
+		StatusCode:    record.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(record.Body)),
+		ContentLength: int64(len(record.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestReplayServesOnlyRecordedRequests(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	header := &types.BlockHeader{
+		Slot:       7,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+	body, err := json.Marshal(header)
+	if err != nil {
+		t.Fatalf("encoding header: %v", err)
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("hashing header: %v", err)
+	}
+
+	records := []*types.TrafficRecord{{
+		Endpoint:    "zeam-0",
+		Method:      http.MethodGet,
+		Path:        headersPath + "head",
+		Accept:      contentTypeJSON,
+		StartedAt:   start,
+		Status:      http.StatusOK,
+		ContentType: contentTypeJSON,
+		Body:        body,
+	}}
+	archive := &types.TrafficArchiveHeader{
+		Version:   types.TrafficArchiveVersion,
+		StartedAt: start,
+		Endpoints: []types.TrafficArchiveEndpoint{{Name: "zeam-0"}, {Name: "ream-0"}},
+	}
+	replayer := NewTrafficReplayer(archive, records, NewVirtualClock(start, 1), logrus.New())
+
+	tests := []struct {
+		name     string
+		endpoint string
+		path     string
+		accept   string
+		recorded bool
+	}{
+		{"recorded request", "zeam-0", headersPath + "head", contentTypeJSON, true},
+		{"head by slot", "zeam-0", headersPath + "7", contentTypeJSON, true},
+		{"head by root", "zeam-0", fmt.Sprintf("%s0x%x", headersPath, root), contentTypeJSON, true},
+		{"other endpoint", "ream-0", headersPath + "head", contentTypeJSON, false},
+		{"other endpoint by slot", "ream-0", headersPath + "7", contentTypeJSON, false},
+		{"other content type", "zeam-0", headersPath + "head", contentTypeSSZ, false},
+		{"other path", "zeam-0", headersPath + "8", contentTypeJSON, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "http://replay.invalid"+test.path, nil)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			req.Header.Set("Accept", test.accept)
+
+			resp, err := replayer.WrapTransport(test.endpoint, nil).RoundTrip(req)
+			if !test.recorded {
+				if !errors.Is(err, ErrNotRecorded) {
+					t.Errorf("got response %v, error %v, want ErrNotRecorded", resp, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("replaying recorded request: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("got status %d, want 200", resp.StatusCode)
+			}
+		})
+	}
+}
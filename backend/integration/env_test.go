@@ -0,0 +1,252 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/mocknode"
+	"github.com/syjn99/leanView/backend/types"
+	"github.com/syjn99/leanView/backend/utils"
+)
+
+// slotDuration keeps the simulated chains fast enough for a test run
+const slotDuration = 250 * time.Millisecond
+
+// testEnv is an indexer following a set of mock nodes
+type testEnv struct {
+	indexer *indexer.Indexer
+	nodes   map[string]*mocknode.Node
+	genesis time.Time
+
+	// stop stops the indexer, it is also stopped when the test ends
+	stop func()
+
+	// Config and directory of the running indexer, for restarts
+	config     *types.Config
+	dir        string
+	configYAML string
+}
+
+// mockEndpoint is a mock node and the config of its endpoint in the indexer config
+type mockEndpoint struct {
+	name   string
+	config mocknode.Config
+
+	// options is appended to the endpoint in the indexer config, e.g. "timeout: 100ms"
+	options []string
+}
+
+// newTestEnv starts a mock node per endpoint and an indexer with a fresh database following
+// them, verifying indexed blocks against the state transition of the first node's chain.
+// The chain starts a few slots before the indexer so it has to catch up. Everything is
+// stopped when the test ends.
+func newTestEnv(t *testing.T, endpoints ...mockEndpoint) *testEnv {
+	t.Helper()
+	return newTestEnvWithOptions(t, nil, endpoints...)
+}
+
+// newTestEnvWithOptions is newTestEnv with options appended to the indexer config, e.g.
+// "quorumSize: 3". State verification is on unless a verifyStateTransition option is given.
+func newTestEnvWithOptions(t *testing.T, indexerOptions []string, endpoints ...mockEndpoint) *testEnv {
+	t.Helper()
+	return newTestEnvWithConfig(t, nil, indexerOptions, endpoints...)
+}
+
+// newTestEnvWithConfig is newTestEnvWithOptions with options appended to the chain config,
+// e.g. "fork: devnet1". A validatorCount option replaces the validator count of the nodes.
+func newTestEnvWithConfig(t *testing.T, chainOptions, indexerOptions []string, endpoints ...mockEndpoint) *testEnv {
+	t.Helper()
+
+	// The indexer slot clock has second precision
+	genesis := time.Now().Truncate(time.Second).Add(-2 * time.Second)
+
+	env := &testEnv{
+		nodes:   make(map[string]*mocknode.Node, len(endpoints)),
+		genesis: genesis,
+	}
+
+	var endpointsYAML strings.Builder
+	var validators uint64
+	for _, endpoint := range endpoints {
+		config := endpoint.config
+		config.GenesisTime = genesis
+		config.SlotDuration = slotDuration
+		if config.Seed == 0 {
+			config.Seed = 1
+		}
+
+		node := mocknode.New(config)
+		server := httptest.NewServer(node.Handler())
+		t.Cleanup(server.Close)
+		env.nodes[endpoint.name] = node
+		if validators == 0 {
+			validators = node.Config().Validators
+		}
+
+		fmt.Fprintf(&endpointsYAML, "    - name: %q\n      url: %q\n", endpoint.name, server.URL)
+		for _, option := range endpoint.options {
+			fmt.Fprintf(&endpointsYAML, "      %s\n", option)
+		}
+	}
+
+	var chainYAML strings.Builder
+	for _, option := range chainOptions {
+		fmt.Fprintf(&chainYAML, "  %s\n", option)
+	}
+	if !slices.ContainsFunc(chainOptions, func(option string) bool { return strings.HasPrefix(option, "validatorCount:") }) {
+		fmt.Fprintf(&chainYAML, "  validatorCount: %d\n", validators)
+	}
+	var indexerYAML strings.Builder
+	for _, option := range indexerOptions {
+		fmt.Fprintf(&indexerYAML, "  %s\n", option)
+	}
+	if !slices.ContainsFunc(indexerOptions, func(option string) bool { return strings.HasPrefix(option, "verifyStateTransition:") }) {
+		indexerYAML.WriteString("  verifyStateTransition: true\n")
+	}
+
+	dir := t.TempDir()
+	configYAML := fmt.Sprintf(`leanapi:
+  endpoints:
+%s
+chain:
+  genesisTime: %d
+  slotDuration: %q
+%sindexer:
+  retryDelay: "50ms"
+  httpTimeout: "1s"
+  healthTimeout: "500ms"
+  healthCheckInterval: %q
+  breakerFailureThreshold: 3
+  breakerBaseBackoff: "200ms"
+  breakerMaxBackoff: "1s"
+%sdatabase:
+  file: %q
+`, endpointsYAML.String(), genesis.Unix(), slotDuration, chainYAML.String(), slotDuration, indexerYAML.String(), filepath.Join(dir, "indexer.sqlite"))
+
+	env.start(t, dir, configYAML)
+	return env
+}
+
+// newReplayEnv starts an indexer with a fresh database replaying a traffic archive recorded
+// by an env with the given genesis and validator count
+func newReplayEnv(t *testing.T, archive string, genesis time.Time, validators uint64) *testEnv {
+	t.Helper()
+
+	env := &testEnv{
+		nodes:   map[string]*mocknode.Node{},
+		genesis: genesis,
+	}
+
+	dir := t.TempDir()
+	configYAML := fmt.Sprintf(`chain:
+  genesisTime: %d
+  slotDuration: %q
+  validatorCount: %d
+indexer:
+  retryDelay: "50ms"
+  httpTimeout: "1s"
+  healthTimeout: "500ms"
+  healthCheckInterval: %q
+  breakerFailureThreshold: 3
+  breakerBaseBackoff: "200ms"
+  breakerMaxBackoff: "1s"
+  verifyStateTransition: true
+  replayFile: %q
+database:
+  file: %q
+`, genesis.Unix(), slotDuration, validators, slotDuration, archive, filepath.Join(dir, "indexer.sqlite"))
+
+	env.start(t, dir, configYAML)
+	return env
+}
+
+// restart stops the indexer and starts a new one with the same config and database
+func (env *testEnv) restart(t *testing.T) {
+	t.Helper()
+	env.stop()
+	env.start(t, env.dir, env.configYAML)
+}
+
+// start writes the config to the directory and runs an indexer on it, the database is
+// created unless it exists
+func (env *testEnv) start(t *testing.T, dir, configYAML string) {
+	t.Helper()
+	env.dir = dir
+	env.configYAML = configYAML
+
+	configPath := filepath.Join(dir, "config.yml")
+	if err := os.WriteFile(configPath, []byte(configYAML), 0o600); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	cfg := &types.Config{}
+	if err := utils.ReadConfig(cfg, configPath); err != nil {
+		t.Fatalf("reading config: %v", err)
+	}
+
+	// The database package logs through the standard logger
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	if testing.Verbose() {
+		logger.SetLevel(logrus.InfoLevel)
+	}
+
+	// The database is a package global, so tests in this package must not run in parallel
+	db.InitDB(&cfg.Database)
+	t.Cleanup(func() { db.ReaderDb.Close() })
+
+	indexerInstance, err := indexer.NewIndexer(cfg, logger)
+	if err != nil {
+		t.Fatalf("creating indexer: %v", err)
+	}
+	env.indexer = indexerInstance
+	env.config = cfg
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := env.indexer.Start(ctx); err != nil {
+			t.Errorf("indexer stopped with error: %v", err)
+		}
+	}()
+	env.stop = func() {
+		cancel()
+		<-done
+	}
+	t.Cleanup(env.stop)
+}
+
+// waitFor polls the condition until it holds, failing the test after the timeout
+func waitFor(t *testing.T, timeout time.Duration, description string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out after %v waiting for %s", timeout, description)
+		}
+		time.Sleep(slotDuration / 5)
+	}
+}
+
+// clientStatus returns the status the indexer assigned to the named endpoint
+func (env *testEnv) clientStatus(name string) string {
+	client := env.indexer.GetClientPool().GetClientByName(name)
+	if client == nil {
+		return ""
+	}
+	status, _ := client.GetStatus()
+	return status
+}
@@ -0,0 +1,1490 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/mocknode"
+	"github.com/syjn99/leanView/backend/server"
+	"github.com/syjn99/leanView/backend/services/block"
+	"github.com/syjn99/leanView/backend/services/convert"
+	"github.com/syjn99/leanView/backend/services/equivocation"
+	"github.com/syjn99/leanView/backend/services/justification"
+	"github.com/syjn99/leanView/backend/services/network"
+	"github.com/syjn99/leanView/backend/services/proof"
+	"github.com/syjn99/leanView/backend/services/search"
+	"github.com/syjn99/leanView/backend/services/signature"
+	"github.com/syjn99/leanView/backend/services/state"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestIndexesChainWithMissedSlots(t *testing.T) {
+	chain := mocknode.Config{Validators: 5, MissedSlotProbability: 0.25}
+	sszChain := chain
+	sszChain.ServeSSZ = true
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "ream-0", config: chain},
+		mockEndpoint{name: "qlean-0", config: sszChain},
+	)
+	node := env.nodes["zeam-0"]
+
+	// Start well past the slots that existed before the indexer started, so catchup ran
+	const targetSlot = 16
+	waitFor(t, 10*time.Second, "indexer to reach the target slot", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= targetSlot
+	})
+	waitFor(t, 5*time.Second, "catchup to finish", func() bool {
+		return !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	missed := 0
+	for slot := uint64(1); slot <= targetSlot; slot++ {
+		expected := node.BlockBySlot(slot)
+		stored, err := db.GetBlockHeaderBySlot(slot)
+		if err != nil {
+			t.Fatalf("reading slot %d: %v", slot, err)
+		}
+
+		if expected == nil {
+			missed++
+			if stored != nil {
+				t.Errorf("slot %d was missed but a header is stored", slot)
+			}
+			continue
+		}
+		if stored == nil {
+			t.Errorf("slot %d was not indexed", slot)
+			continue
+		}
+
+		expectedRoot, _ := expected.HashTreeRoot()
+		storedRoot, _ := stored.HashTreeRoot()
+		if expectedRoot != storedRoot {
+			t.Errorf("slot %d stored root 0x%x, chain has 0x%x", slot, storedRoot, expectedRoot)
+		}
+		if stored.ProposerIndex != slot%5 {
+			t.Errorf("slot %d stored proposer %d, expected %d", slot, stored.ProposerIndex, slot%5)
+		}
+	}
+	if missed == 0 {
+		t.Errorf("expected the chain to miss some of the first %d slots", targetSlot)
+	}
+
+	// Every indexed block passes the local state transition with the state root it claims
+	waitFor(t, 5*time.Second, "state verification to reach the target slot", func() bool {
+		return env.indexer.GetStateVerifier().GetLastVerifiedSlot() >= targetSlot
+	})
+	invalidBlocks, err := db.GetInvalidBlocks(10)
+	if err != nil {
+		t.Fatalf("reading invalid blocks: %v", err)
+	}
+	for _, invalidBlock := range invalidBlocks {
+		t.Errorf("slot %d flagged as %s: %s", invalidBlock.Slot, invalidBlock.Reason, invalidBlock.Error)
+	}
+
+	for name, expected := range map[string]string{"zeam-0": "json", "qlean-0": "ssz"} {
+		waitFor(t, 2*time.Second, name+" encoding detection", func() bool {
+			return env.indexer.GetClientPool().GetClientByName(name).GetEncoding() == expected
+		})
+	}
+}
+
+func TestReportsJustificationProgress(t *testing.T) {
+	chain := mocknode.Config{Validators: 5, MissedSlotProbability: 0.25}
+
+	for _, verify := range []bool{true, false} {
+		t.Run(fmt.Sprintf("verifyStateTransition %v", verify), func(t *testing.T) {
+			env := newTestEnvWithOptions(t, []string{fmt.Sprintf("verifyStateTransition: %v", verify)},
+				mockEndpoint{name: "zeam-0", config: chain},
+				mockEndpoint{name: "ream-0", config: chain},
+			)
+			justificationService := justification.NewJustificationService(env.indexer, logrus.StandardLogger())
+			ctx := context.Background()
+
+			// Without state verification the progress is read from a state snapshot
+			if !verify {
+				_, err := justificationService.GetJustificationProgress(ctx, connect.NewRequest(&apiv1.GetJustificationProgressRequest{}))
+				if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+					t.Fatalf("justification progress without a snapshot returned %v, want failed precondition", err)
+				}
+			}
+			waitFor(t, 10*time.Second, "indexer to reach slot 16", func() bool {
+				if verify {
+					return env.indexer.GetStateVerifier().GetLastVerifiedSlot() >= 16
+				}
+				return env.indexer.GetPoller().GetLastProcessedSlot() >= 16
+			})
+			if !verify {
+				if _, err := env.indexer.GetStateSnapshotter().Snapshot(ctx, "zeam-0"); err != nil {
+					t.Fatalf("taking snapshot: %v", err)
+				}
+			}
+
+			progress, err := justificationService.GetJustificationProgress(ctx, connect.NewRequest(&apiv1.GetJustificationProgressRequest{}))
+			if err != nil {
+				t.Fatalf("getting justification progress: %v", err)
+			}
+			if progress.Msg.Threshold != 4 || progress.Msg.JustifiedSlot < progress.Msg.FinalizedSlot || progress.Msg.FinalizedSlot == 0 {
+				t.Errorf("unexpected justification progress: threshold %d, justified slot %d, finalized slot %d",
+					progress.Msg.Threshold, progress.Msg.JustifiedSlot, progress.Msg.FinalizedSlot)
+			}
+			expectedClient := ""
+			if !verify {
+				expectedClient = "zeam-0"
+			}
+			if progress.Msg.StateClient != expectedClient {
+				t.Errorf("progress is taken from the state of %q, expected %q", progress.Msg.StateClient, expectedClient)
+			}
+
+			// The state drops the votes of justified targets, only the verifier knows the block that justified them
+			latestJustifiedFound := progress.Msg.JustifiedSlot == progress.Msg.FinalizedSlot
+			for _, target := range progress.Msg.Targets {
+				if target.Slot <= progress.Msg.FinalizedSlot {
+					t.Errorf("target slot %d is not after finalized slot %d", target.Slot, progress.Msg.FinalizedSlot)
+				}
+				if target.Justified && (target.Votes != 0 || (target.JustifiedAtSlot > target.Slot) != verify) {
+					t.Errorf("target slot %d justified at slot %d reports %d votes", target.Slot, target.JustifiedAtSlot, target.Votes)
+				}
+				if !target.Justified && target.Votes >= progress.Msg.Threshold {
+					t.Errorf("target slot %d has %d votes but is not justified", target.Slot, target.Votes)
+				}
+				if target.Slot == progress.Msg.JustifiedSlot {
+					latestJustifiedFound = target.Justified && target.Root == progress.Msg.JustifiedRoot
+				}
+			}
+			if !latestJustifiedFound {
+				t.Errorf("latest justified slot %d is not a justified target", progress.Msg.JustifiedSlot)
+			}
+		})
+	}
+}
+
+func TestAnswersChainQueries(t *testing.T) {
+	chain := mocknode.Config{MissedSlotProbability: 0.25}
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "ream-0", config: chain},
+	)
+	node := env.nodes["zeam-0"]
+
+	const targetSlot = 16
+	waitFor(t, 10*time.Second, "indexer to reach the target slot", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= targetSlot && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// Chain queries answer from the block tree and fall back to the indexed chain before finalization
+	chainQuery := env.indexer.GetChainQuery()
+	var headBlock *types.BlockHeader
+	for slot := uint64(targetSlot); headBlock == nil; slot-- {
+		headBlock = node.BlockBySlot(slot)
+	}
+	headRoot, _ := headBlock.HashTreeRoot()
+	var first, latest *types.BlockHeader
+	for slot := uint64(1); slot <= headBlock.Slot; slot++ {
+		if block := node.BlockBySlot(slot); block != nil {
+			latest = block
+		}
+		if latest == nil {
+			continue
+		}
+		if first == nil {
+			first = latest
+		}
+
+		ancestor, err := chainQuery.AncestorAtSlot(headRoot[:], slot)
+		if err != nil {
+			t.Fatalf("ancestor at slot %d: %v", slot, err)
+		}
+		if !bytes.Equal(ancestor.BodyRoot, latest.BodyRoot) {
+			t.Errorf("ancestor at slot %d is the block at slot %d, expected slot %d", slot, ancestor.Slot, latest.Slot)
+		}
+	}
+	firstRoot, _ := first.HashTreeRoot()
+	if isAncestor, err := chainQuery.IsAncestor(firstRoot[:], headRoot[:]); err != nil || !isAncestor {
+		t.Errorf("first indexed block is not an ancestor of the head: %v", err)
+	}
+	if isAncestor, err := chainQuery.IsAncestor(headRoot[:], firstRoot[:]); err != nil || isAncestor {
+		t.Errorf("head is an ancestor of the first indexed block: %v", err)
+	}
+	if common, err := chainQuery.CommonAncestor(headRoot[:], firstRoot[:]); err != nil || common.Slot != first.Slot {
+		t.Errorf("common ancestor of head and first indexed block is %v: %v", common, err)
+	}
+}
+
+func TestVerifiesStoredChain(t *testing.T) {
+	chain := mocknode.Config{Validators: 5, MissedSlotProbability: 0.25}
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "ream-0", config: chain},
+	)
+	node := env.nodes["zeam-0"]
+
+	const targetSlot = 16
+	waitFor(t, 10*time.Second, "indexer to reach the target slot", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= targetSlot && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// The stored chain is consistent, a corrupted parent root is found and refetched
+	var headBlock *types.BlockHeader
+	for slot := uint64(targetSlot); headBlock == nil; slot-- {
+		headBlock = node.BlockBySlot(slot)
+	}
+	verifier := env.indexer.GetChainVerifier()
+	report, err := verifier.Verify(context.Background(), 0, false)
+	if err != nil {
+		t.Fatalf("verifying stored chain: %v", err)
+	}
+	for _, issue := range report.Issues {
+		t.Errorf("slot %d has integrity issue %s: %s", issue.Slot, issue.Kind, issue.Detail)
+	}
+
+	corrupted := *headBlock
+	corrupted.ParentRoot = bytes.Repeat([]byte{0xff}, 32)
+	if err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.UpdateBlockHeader(&corrupted, tx)
+	}); err != nil {
+		t.Fatalf("corrupting slot %d: %v", corrupted.Slot, err)
+	}
+	report, err = verifier.Verify(context.Background(), 0, false)
+	if err != nil {
+		t.Fatalf("verifying corrupted chain: %v", err)
+	}
+	if len(report.Issues) == 0 || report.Issues[0].Slot != corrupted.Slot || report.Issues[0].Kind != types.IntegrityIssueParentMismatch {
+		t.Fatalf("corrupted parent root at slot %d not reported, got %d issues", corrupted.Slot, len(report.Issues))
+	}
+	if stored, err := db.GetIntegrityIssues(10); err != nil || len(stored) != len(report.Issues) {
+		t.Errorf("stored %d integrity issues, reported %d: %v", len(stored), len(report.Issues), err)
+	}
+
+	report, err = verifier.Verify(context.Background(), 0, true)
+	if err != nil {
+		t.Fatalf("verifying with refetch: %v", err)
+	}
+	if len(report.Refetched) == 0 || len(report.Issues) != 0 {
+		t.Errorf("refetched %d ranges, %d issues left", len(report.Refetched), len(report.Issues))
+	}
+	if stored, err := db.GetIntegrityIssues(10); err != nil || len(stored) != 0 {
+		t.Errorf("%d integrity issues stored after refetch: %v", len(stored), err)
+	}
+}
+
+func TestComputesForkChoiceHead(t *testing.T) {
+	chain := mocknode.Config{Validators: 5, MissedSlotProbability: 0.25}
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "ream-0", config: chain},
+	)
+	node := env.nodes["zeam-0"]
+
+	// Checkpoints advance as the votes of every block justify and finalize its ancestors
+	waitFor(t, 5*time.Second, "checkpoints", func() bool {
+		headCache := env.indexer.GetHeadCache()
+		justified := headCache.GetJustifiedCheckpoint()
+		finalized := headCache.GetFinalizedCheckpoint()
+		return justified != nil && finalized != nil && finalized.Slot > 0 && finalized.Slot < justified.Slot
+	})
+	justified := env.indexer.GetHeadCache().GetJustifiedCheckpoint()
+	if node.BlockByRoot([32]byte(justified.Root)) == nil {
+		t.Errorf("justified checkpoint 0x%x is not a block of the chain", justified.Root)
+	}
+
+	// Fork choice runs on the blocks since finalization with every validator's latest vote
+	forkChoice := env.indexer.GetHeadCache().GetForkChoiceTree()
+	if forkChoice.LatestVotes != 5 {
+		t.Errorf("fork choice counted %d latest votes, expected one per validator", forkChoice.LatestVotes)
+	}
+	if finalized := env.indexer.GetHeadCache().GetFinalizedCheckpoint(); forkChoice.Nodes[0].Header.Slot < finalized.Slot {
+		t.Errorf("block tree starts at slot %d, before finalized slot %d", forkChoice.Nodes[0].Header.Slot, finalized.Slot)
+	}
+	if node.BlockByRoot([32]byte(forkChoice.Head)) == nil {
+		t.Errorf("fork choice head 0x%x is not a block of the chain", forkChoice.Head)
+	}
+}
+
+func TestFailsOverFromBrokenNodes(t *testing.T) {
+	chain := mocknode.Config{}
+	failing := chain
+	failing.ErrorRate = 1
+	slow := chain
+	slow.Latency = 300 * time.Millisecond
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "failing", config: failing},
+		mockEndpoint{name: "slow", config: slow, options: []string{`timeout: "100ms"`, `healthTimeout: "100ms"`}},
+		mockEndpoint{name: "healthy", config: chain},
+	)
+
+	waitFor(t, 5*time.Second, "broken nodes to be unreachable", func() bool {
+		return env.clientStatus("failing") == indexer.StatusUnreachable &&
+			env.clientStatus("slow") == indexer.StatusUnreachable
+	})
+	waitFor(t, 5*time.Second, "circuit breaker of the failing node to open", func() bool {
+		return env.indexer.GetClientPool().GetClientByName("failing").GetBreakerStatus().State != indexer.BreakerClosed
+	})
+	if status := env.clientStatus("healthy"); status != indexer.StatusHealthy {
+		t.Errorf("healthy node has status %s", status)
+	}
+
+	// The failing node is primary, indexing continues through the healthy node
+	start := env.indexer.GetPoller().GetLastProcessedSlot()
+	waitFor(t, 5*time.Second, "indexing to continue", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= start+4
+	})
+	if client := env.indexer.GetPoller().GetLastPollClient(); client != "healthy" {
+		t.Errorf("head was polled from %q, expected the healthy node", client)
+	}
+
+	// The API reports the client each stored header was fetched from
+	resp, err := block.NewBlockService(env.indexer, logrus.StandardLogger()).GetBlockHeaders(context.Background(),
+		connect.NewRequest(&apiv1.GetBlockHeadersRequest{Limit: 4}))
+	if err != nil || len(resp.Msg.Headers) == 0 {
+		t.Fatalf("getting block headers: %v", err)
+	}
+	for _, header := range resp.Msg.Headers {
+		if header.SourceClient != "healthy" {
+			t.Errorf("header at slot %d was fetched from %q, expected the healthy node", header.Header.Slot, header.SourceClient)
+		}
+	}
+
+	// Once the node recovers a breaker probe lets it back in
+	env.nodes["failing"].SetErrorRate(0)
+	waitFor(t, 5*time.Second, "failing node to recover", func() bool {
+		return env.clientStatus("failing") == indexer.StatusHealthy
+	})
+}
+
+func TestReportsReadiness(t *testing.T) {
+	env := newTestEnv(t, mockEndpoint{name: "zeam-0", config: mocknode.Config{}})
+	node := env.nodes["zeam-0"]
+
+	cfg := *env.config
+	cfg.Health.MaxPollAge = time.Second
+	cfg.Health.MaxHeadLagSlots = 4
+	handler := server.NewServer(&cfg, env.indexer, logrus.StandardLogger().WithField("component", "server")).Handler()
+
+	// readyz returns the status code and the status of each check with its details
+	type check struct {
+		Status  string         `json:"status"`
+		Details map[string]any `json:"details"`
+	}
+	readyz := func() (int, map[string]check) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var body struct {
+			Checks map[string]check `json:"checks"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+			t.Fatalf("decoding readyz response %q: %v", recorder.Body.String(), err)
+		}
+		return recorder.Code, body.Checks
+	}
+	// headLag checks the lag details add up and returns the lag
+	headLag := func(checks map[string]check) float64 {
+		details := checks["head_lag"].Details
+		lag := details["lag_slots"].(float64)
+		if lag != details["wall_clock_slot"].(float64)-details["head_slot"].(float64) {
+			t.Errorf("head lag details %v do not add up", details)
+		}
+		return lag
+	}
+
+	waitFor(t, 5*time.Second, "indexer to be ready", func() bool {
+		code, _ := readyz()
+		return code == http.StatusOK
+	})
+	_, checks := readyz()
+	if lag := headLag(checks); lag > 4 {
+		t.Errorf("ready with a head lag of %v slots", lag)
+	}
+
+	// Without a healthy client the indexer is not ready
+	node.SetErrorRate(1)
+	waitFor(t, 5*time.Second, "node to be unreachable", func() bool {
+		return env.clientStatus("zeam-0") == indexer.StatusUnreachable
+	})
+	code, checks := readyz()
+	if code != http.StatusServiceUnavailable || checks["clients"].Status != "fail" || checks["clients"].Details["healthy"] != float64(0) {
+		t.Errorf("readyz returned %d with clients check %+v, expected 503 without healthy clients", code, checks["clients"])
+	}
+
+	// With the node back but no poll since, the last poll and then the head lag go stale
+	node.SetErrorRate(0)
+	waitFor(t, 5*time.Second, "node to recover", func() bool {
+		return env.clientStatus("zeam-0") == indexer.StatusHealthy
+	})
+	if err := env.indexer.GetPoller().Stop(); err != nil {
+		t.Fatalf("stopping poller: %v", err)
+	}
+	waitFor(t, 5*time.Second, "head to lag behind the wall clock", func() bool {
+		_, checks := readyz()
+		return checks["head_lag"].Status == "fail"
+	})
+	code, checks = readyz()
+	if code != http.StatusServiceUnavailable || checks["clients"].Status != "pass" || checks["last_poll"].Status != "fail" {
+		t.Errorf("readyz returned %d with checks %+v, expected 503 for a stale poll only", code, checks)
+	}
+	if age := checks["last_poll"].Details["age_ms"].(float64); age <= float64(time.Second.Milliseconds()) {
+		t.Errorf("stale poll is %vms old, expected over the 1s maximum", age)
+	}
+	if lag := headLag(checks); lag <= 4 {
+		t.Errorf("head lag check failed with a lag of %v slots", lag)
+	}
+}
+
+func TestClassifiesStalledAndForkedNodes(t *testing.T) {
+	chain := mocknode.Config{}
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "canonical", config: chain},
+		mockEndpoint{name: "stalling", config: chain},
+		mockEndpoint{name: "forking", config: chain},
+	)
+
+	// Heads are only compared once the nodes reported one and the indexer follows the chain
+	waitFor(t, 5*time.Second, "all nodes to be healthy", func() bool {
+		for _, name := range []string{"canonical", "stalling", "forking"} {
+			if head, _ := env.indexer.GetClientPool().GetClientByName(name).GetHead(); head == nil {
+				return false
+			}
+		}
+		return env.indexer.GetPoller().GetLastProcessedSlot() > 0 &&
+			env.clientStatus("canonical") == indexer.StatusHealthy &&
+			env.clientStatus("stalling") == indexer.StatusHealthy &&
+			env.clientStatus("forking") == indexer.StatusHealthy
+	})
+
+	env.nodes["stalling"].Stall()
+	if err := env.nodes["forking"].InjectFork(2); err != nil {
+		t.Fatalf("injecting fork: %v", err)
+	}
+
+	waitFor(t, 5*time.Second, "forked node to be detected", func() bool {
+		return env.clientStatus("forking") == indexer.StatusForked
+	})
+	waitFor(t, 8*time.Second, "stalled node to be detected", func() bool {
+		return env.clientStatus("stalling") == indexer.StatusStalled
+	})
+	if status := env.clientStatus("canonical"); status != indexer.StatusHealthy {
+		t.Errorf("canonical node has status %s", status)
+	}
+
+	// The indexed chain follows the canonical node
+	head := env.indexer.GetHeadCache().GetCurrentHead()
+	canonicalBlock := env.nodes["canonical"].BlockBySlot(head.Slot)
+	if canonicalBlock == nil || !bytes.Equal(canonicalBlock.BodyRoot, head.BodyRoot) {
+		t.Errorf("indexed head at slot %d is not the canonical block", head.Slot)
+	}
+
+	env.nodes["stalling"].Resume()
+	waitFor(t, 5*time.Second, "resumed node to be healthy", func() bool {
+		return env.clientStatus("stalling") == indexer.StatusHealthy
+	})
+}
+
+func TestDetectsEquivocatingVotes(t *testing.T) {
+	chain := mocknode.Config{Validators: 5}
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "ream-0", config: chain},
+	)
+	waitFor(t, 5*time.Second, "indexer to ingest votes", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= 12 && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// Nodes following the same chain never equivocate
+	if equivocations, err := db.GetEquivocations("", 10); err != nil || len(equivocations) != 0 {
+		t.Fatalf("%d equivocations stored for honest nodes: %v", len(equivocations), err)
+	}
+
+	// Conflicting votes of a validator are each stored once
+	checkpoint := func(slot uint64) *types.Checkpoint {
+		return &types.Checkpoint{Root: bytes.Repeat([]byte{byte(slot)}, 32), Slot: slot}
+	}
+	const validator = 1000
+	surrounding := &types.Vote{ValidatorId: validator, Slot: 20, Head: checkpoint(19), Target: checkpoint(19), Source: checkpoint(2)}
+	surrounded := &types.Vote{ValidatorId: validator, Slot: 12, Head: checkpoint(11), Target: checkpoint(11), Source: checkpoint(6)}
+	double := &types.Vote{ValidatorId: validator, Slot: 12, Head: checkpoint(10), Target: checkpoint(10), Source: checkpoint(6)}
+	detector := env.indexer.GetEquivocationDetector()
+	detector.ObserveVotes("zeam-0", []*types.Vote{surrounding, surrounded})
+	detector.ObserveVotes("ream-0", []*types.Vote{double, surrounded})
+
+	equivocationService := equivocation.NewEquivocationService(env.indexer, logrus.StandardLogger())
+	for kind, expected := range map[string]int{types.EquivocationDoubleVote: 1, types.EquivocationSurroundVote: 2} {
+		response, err := equivocationService.GetEquivocations(context.Background(), connect.NewRequest(&apiv1.GetEquivocationsRequest{Kind: kind}))
+		if err != nil {
+			t.Fatalf("getting %s equivocations: %v", kind, err)
+		}
+		if len(response.Msg.Equivocations) != expected {
+			t.Errorf("found %d %s equivocations, expected %d", len(response.Msg.Equivocations), kind, expected)
+		}
+		for _, found := range response.Msg.Equivocations {
+			if found.ValidatorId != validator || found.A.Vote == nil || found.B.Vote == nil {
+				t.Errorf("%s equivocation has no vote evidence of validator %d", kind, uint64(validator))
+				continue
+			}
+			if kind == types.EquivocationSurroundVote && found.A.Vote.SourceSlot != 2 {
+				t.Errorf("surround vote evidence a is not the surrounding vote: %+v", found.A.Vote)
+			}
+		}
+	}
+}
+
+func TestDetectsDoubleProposals(t *testing.T) {
+	chain := mocknode.Config{}
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "canonical", config: chain},
+		mockEndpoint{name: "forking", config: chain},
+	)
+	waitFor(t, 5*time.Second, "nodes to pass slot 3", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= 3
+	})
+	if err := env.nodes["forking"].InjectFork(2); err != nil {
+		t.Fatalf("injecting fork: %v", err)
+	}
+
+	// Both nodes propose at every slot after the fork, with the same round robin proposer
+	var stored []*types.Equivocation
+	waitFor(t, 5*time.Second, "double proposal to be detected", func() bool {
+		var err error
+		stored, err = db.GetEquivocations(types.EquivocationDoubleProposal, 10)
+		return err == nil && len(stored) > 0
+	})
+	slot := stored[0].Slot
+
+	// The evidence is the block of each branch
+	canonicalRoot, _ := env.nodes["canonical"].BlockBySlot(slot).HashTreeRoot()
+	forkedRoot, _ := env.nodes["forking"].BlockBySlot(slot).HashTreeRoot()
+	forkRoots := []string{convert.HexRoot(canonicalRoot[:]), convert.HexRoot(forkedRoot[:])}
+	equivocationService := equivocation.NewEquivocationService(env.indexer, logrus.StandardLogger())
+	proposals, err := equivocationService.GetEquivocations(context.Background(), connect.NewRequest(&apiv1.GetEquivocationsRequest{
+		Kind: types.EquivocationDoubleProposal,
+	}))
+	if err != nil {
+		t.Fatalf("getting double proposals: %v", err)
+	}
+	found := false
+	for _, proposal := range proposals.Msg.Equivocations {
+		if proposal.Slot != slot {
+			continue
+		}
+		found = true
+		roots := []string{proposal.A.Root, proposal.B.Root}
+		if !slices.Contains(roots, forkRoots[0]) || !slices.Contains(roots, forkRoots[1]) {
+			t.Errorf("double proposal at slot %d has roots %v, expected %v", slot, roots, forkRoots)
+		}
+		if proposal.A.Block == nil || proposal.B.Block == nil || proposal.A.Block.Header.ProposerIndex != proposal.ValidatorId {
+			t.Errorf("double proposal at slot %d has no header evidence of proposer %d", slot, proposal.ValidatorId)
+		}
+	}
+	if !found {
+		t.Errorf("no double proposal found at slot %d", slot)
+	}
+}
+
+func TestProvesDifferingHeaderFields(t *testing.T) {
+	chain := mocknode.Config{}
+
+	env := newTestEnv(t,
+		mockEndpoint{name: "canonical", config: chain},
+		mockEndpoint{name: "forking", config: chain},
+	)
+
+	// The fork replaces blocks after slot 1
+	waitFor(t, 5*time.Second, "nodes to pass slot 3", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= 3
+	})
+	if err := env.nodes["forking"].InjectFork(2); err != nil {
+		t.Fatalf("injecting fork: %v", err)
+	}
+
+	// Header proofs show which fields of the forked block differ from the canonical one
+	slot := min(env.nodes["canonical"].Head().Slot, env.nodes["forking"].Head().Slot)
+	proofService := proof.NewProofService(env.indexer, logrus.StandardLogger())
+	diff, err := proofService.DiffHeaders(context.Background(), connect.NewRequest(&apiv1.DiffHeadersRequest{
+		A: &apiv1.HeaderSource{Client: "canonical", Slot: slot},
+		B: &apiv1.HeaderSource{Client: "forking", Slot: slot},
+	}))
+	if err != nil {
+		t.Fatalf("diffing headers at slot %d: %v", slot, err)
+	}
+	if diff.Msg.RootsMatch || len(diff.Msg.Differences) == 0 {
+		t.Fatalf("headers at slot %d of the canonical and forked node do not differ", slot)
+	}
+	for _, difference := range diff.Msg.Differences {
+		if difference.ValueA == difference.ValueB {
+			t.Errorf("field %s differs with equal values %s", difference.Field, difference.ValueA)
+		}
+		verifyFieldProof(t, diff.Msg.BlockA.BlockRoot, difference.ProofA)
+		verifyFieldProof(t, diff.Msg.BlockB.BlockRoot, difference.ProofB)
+	}
+
+	// Blocks before the fork have no differing fields
+	same, err := proofService.DiffHeaders(context.Background(), connect.NewRequest(&apiv1.DiffHeadersRequest{
+		A: &apiv1.HeaderSource{Client: "canonical", Slot: 1},
+		B: &apiv1.HeaderSource{Client: "forking", Slot: 1},
+	}))
+	if err != nil {
+		t.Fatalf("diffing headers at slot 1: %v", err)
+	}
+	if !same.Msg.RootsMatch || len(same.Msg.Differences) != 0 {
+		t.Errorf("headers at slot 1 before the fork differ in %d fields", len(same.Msg.Differences))
+	}
+}
+
+// verifyFieldProof checks a field proof returned by the API against the block root
+func verifyFieldProof(t *testing.T, blockRoot string, fieldProof *apiv1.FieldProof) {
+	t.Helper()
+
+	decode := func(value string) []byte {
+		decoded, err := convert.ParseRoot(value)
+		if err != nil {
+			t.Fatalf("decoding proof of %s: %v", fieldProof.Field, err)
+		}
+		return decoded
+	}
+	verified := &types.FieldProof{
+		Field:            fieldProof.Field,
+		GeneralizedIndex: int(fieldProof.GeneralizedIndex),
+		Leaf:             decode(fieldProof.Leaf),
+	}
+	for _, hash := range fieldProof.Branch {
+		verified.Branch = append(verified.Branch, decode(hash))
+	}
+	if ok, err := verified.Verify(decode(blockRoot)); err != nil || !ok {
+		t.Errorf("proof of %s does not verify against block root %s: %v", fieldProof.Field, blockRoot, err)
+	}
+}
+
+func TestStoresQuorumMajority(t *testing.T) {
+	chain := mocknode.Config{}
+	buggy := chain
+	buggy.CorruptSlots = []uint64{3}
+
+	env := newTestEnvWithOptions(t, []string{"quorumSize: 3"},
+		mockEndpoint{name: "canonical", config: chain},
+		mockEndpoint{name: "buggy", config: buggy},
+		mockEndpoint{name: "agreeing", config: chain},
+	)
+
+	waitFor(t, 10*time.Second, "indexer to catch up past the corrupted slot", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() > 4 && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// The majority header is stored, not the buggy client's one
+	expected := env.nodes["canonical"].BlockBySlot(3)
+	stored, err := db.GetBlockHeaderBySlot(3)
+	if err != nil {
+		t.Fatalf("reading slot 3: %v", err)
+	}
+	if stored == nil || !bytes.Equal(stored.StateRoot, expected.StateRoot) {
+		t.Fatalf("slot 3 stores %+v, expected the majority header", stored)
+	}
+
+	divergences, err := db.GetDivergences(10)
+	if err != nil {
+		t.Fatalf("reading divergences: %v", err)
+	}
+	if len(divergences) != 1 {
+		t.Fatalf("recorded %d divergences, expected the buggy client at slot 3", len(divergences))
+	}
+	divergence := divergences[0]
+	if divergence.Slot != 3 || divergence.Client != "buggy" || divergence.Agreeing != 2 || divergence.Queried != 3 {
+		t.Errorf("unexpected divergence %+v", divergence)
+	}
+
+	// Both versions are kept
+	var header, majorityHeader types.BlockHeader
+	if err := header.UnmarshalSSZ(divergence.Header); err != nil {
+		t.Fatalf("decoding diverging header: %v", err)
+	}
+	if err := majorityHeader.UnmarshalSSZ(divergence.MajorityHeader); err != nil {
+		t.Fatalf("decoding majority header: %v", err)
+	}
+	if bytes.Equal(header.StateRoot, expected.StateRoot) || !bytes.Equal(majorityHeader.StateRoot, expected.StateRoot) {
+		t.Errorf("divergence does not keep the buggy and majority headers")
+	}
+	if root, _ := majorityHeader.HashTreeRoot(); !bytes.Equal(root[:], divergence.MajorityRoot) {
+		t.Errorf("majority root 0x%x is not the root of the majority header", divergence.MajorityRoot)
+	}
+}
+
+func TestRetriesCatchupWithoutQuorum(t *testing.T) {
+	chain := mocknode.Config{}
+	flaky := chain
+	flaky.ErrorRate = 1
+
+	env := newTestEnvWithOptions(t, []string{"quorumSize: 3"},
+		mockEndpoint{name: "canonical", config: chain},
+		mockEndpoint{name: "agreeing", config: chain},
+		mockEndpoint{name: "flaky", config: flaky},
+	)
+
+	// Two clients cannot form a quorum of three, so the catchup stops without storing the gap
+	waitFor(t, 10*time.Second, "indexer to follow the head", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= 12
+	})
+	for _, slot := range []uint64{1, 2} {
+		if stored, err := db.GetBlockHeaderBySlot(slot); err != nil || stored != nil {
+			t.Fatalf("slot %d stores %+v (error %v) without a quorum", slot, stored, err)
+		}
+	}
+
+	// Once the third client answers, the next poll retries the catchup and fills the gap
+	env.nodes["flaky"].SetErrorRate(0)
+	lastSlot := env.indexer.GetPoller().GetLastProcessedSlot()
+	waitFor(t, 10*time.Second, "retried catchup to fill the gap", func() bool {
+		for slot := uint64(1); slot <= lastSlot; slot++ {
+			if stored, err := db.GetBlockHeaderBySlot(slot); err != nil || stored == nil {
+				return false
+			}
+		}
+		return true
+	})
+	for slot := uint64(1); slot <= lastSlot; slot++ {
+		stored, _ := db.GetBlockHeaderBySlot(slot)
+		if expected := env.nodes["canonical"].BlockBySlot(slot); !bytes.Equal(stored.StateRoot, expected.StateRoot) {
+			t.Errorf("slot %d stores a header that is not the canonical one", slot)
+		}
+	}
+}
+
+func TestStoresStateSnapshots(t *testing.T) {
+	chain := mocknode.Config{}
+	sszChain := chain
+	sszChain.ServeSSZ = true
+
+	env := newTestEnvWithOptions(t, []string{"stateSnapshotKeep: 2"},
+		mockEndpoint{name: "canonical", config: chain},
+		mockEndpoint{name: "ssz", config: sszChain},
+		mockEndpoint{name: "forking", config: chain},
+	)
+	snapshotter := env.indexer.GetStateSnapshotter()
+	stateService := state.NewStateService(env.indexer, logrus.StandardLogger())
+	ctx := context.Background()
+
+	waitFor(t, 10*time.Second, "indexer to reach slot 8", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= 8 && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// The head state of a client on the indexed chain agrees with every indexed block
+	snapshot, err := snapshotter.Snapshot(ctx, "canonical")
+	if err != nil {
+		t.Fatalf("taking snapshot: %v", err)
+	}
+	if snapshot.CheckedHashes < 7 || snapshot.MismatchedHashes != 0 {
+		t.Errorf("snapshot at slot %d checked %d hashes with %d mismatches, expected at least 7 without mismatches",
+			snapshot.Slot, snapshot.CheckedHashes, snapshot.MismatchedHashes)
+	}
+	if block := env.nodes["canonical"].BlockBySlot(snapshot.Slot); block == nil || !bytes.Equal(block.StateRoot, snapshot.StateRoot) {
+		t.Errorf("snapshot state root 0x%x is not the state root of the block at slot %d", snapshot.StateRoot, snapshot.Slot)
+	}
+
+	// The stored snapshot shows the checkpoints and justification bitfields
+	stored, err := stateService.GetState(ctx, connect.NewRequest(&apiv1.GetStateRequest{Root: convert.HexRoot(snapshot.StateRoot)}))
+	if err != nil {
+		t.Fatalf("getting stored state: %v", err)
+	}
+	response := stored.Msg
+	if response.Snapshot.Slot != snapshot.Slot || response.Snapshot.JustifiedSlot != snapshot.JustifiedSlot || response.NumValidators != 4 {
+		t.Errorf("stored state at slot %d has snapshot %+v", snapshot.Slot, response.Snapshot)
+	}
+	justifiedBits, err := hex.DecodeString(strings.TrimPrefix(response.JustifiedSlots, "0x"))
+	if err != nil {
+		t.Fatalf("decoding justified slots: %v", err)
+	}
+	justifiedSlot := response.Snapshot.JustifiedSlot
+	if justifiedSlot >= response.JustifiedSlotsLength || justifiedBits[justifiedSlot/8]&(1<<(justifiedSlot%8)) == 0 {
+		t.Errorf("latest justified slot %d is not set in the justified slots bitfield %s", justifiedSlot, response.JustifiedSlots)
+	}
+	for _, justification := range response.Justifications {
+		if justification.Votes > response.NumValidators || len(justification.Validators) != len("0x00") {
+			t.Errorf("justification of %s has %d votes and validators %s", justification.Root, justification.Votes, justification.Validators)
+		}
+	}
+
+	// SSZ and JSON clients serve the same state
+	slot := snapshot.Slot
+	fromJSON, err := stateService.GetState(ctx, connect.NewRequest(&apiv1.GetStateRequest{Client: "canonical", Slot: slot}))
+	if err != nil {
+		t.Fatalf("getting state at slot %d from JSON client: %v", slot, err)
+	}
+	fromSSZ, err := stateService.GetState(ctx, connect.NewRequest(&apiv1.GetStateRequest{Client: "ssz", Slot: slot}))
+	if err != nil {
+		t.Fatalf("getting state at slot %d from SSZ client: %v", slot, err)
+	}
+	if encoding := env.indexer.GetClientPool().GetClientByName("ssz").GetEncoding(); encoding != "ssz" {
+		t.Errorf("ssz client served %q", encoding)
+	}
+	if fromJSON.Msg.Snapshot.StateRoot != fromSSZ.Msg.Snapshot.StateRoot || fromJSON.Msg.Snapshot.StateRoot != response.Snapshot.StateRoot {
+		t.Errorf("state roots at slot %d differ: JSON %s, SSZ %s, stored %s",
+			slot, fromJSON.Msg.Snapshot.StateRoot, fromSSZ.Msg.Snapshot.StateRoot, response.Snapshot.StateRoot)
+	}
+
+	// A client that forked after the indexed blocks has block hashes that differ from them
+	forking := env.nodes["forking"]
+	waitFor(t, 5*time.Second, "indexer to reach the forking node's head", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= forking.Head().Slot
+	})
+	if err := forking.InjectFork(2); err != nil {
+		t.Fatalf("injecting fork: %v", err)
+	}
+	forkSlot := forking.Head().Slot - 1
+
+	forked, err := snapshotter.Snapshot(ctx, "forking")
+	if err != nil {
+		t.Fatalf("taking snapshot of the forked client: %v", err)
+	}
+	forkedState, err := stateService.GetState(ctx, connect.NewRequest(&apiv1.GetStateRequest{Root: convert.HexRoot(forked.StateRoot)}))
+	if err != nil {
+		t.Fatalf("getting forked state: %v", err)
+	}
+	mismatches := forkedState.Msg.Mismatches
+	if forked.MismatchedHashes == 0 || len(mismatches) == 0 || mismatches[0].Slot != forkSlot {
+		t.Errorf("forked state has mismatches %v, expected the first at slot %d", mismatches, forkSlot)
+	}
+	for _, mismatch := range mismatches {
+		if mismatch.StateBlockRoot == mismatch.IndexedBlockRoot {
+			t.Errorf("mismatch at slot %d has equal roots", mismatch.Slot)
+		}
+	}
+
+	// Only the two latest snapshots are kept
+	if _, err := snapshotter.Snapshot(ctx, "ssz"); err != nil {
+		t.Fatalf("taking snapshot: %v", err)
+	}
+	snapshots, err := stateService.GetStateSnapshots(ctx, connect.NewRequest(&apiv1.GetStateSnapshotsRequest{}))
+	if err != nil {
+		t.Fatalf("listing snapshots: %v", err)
+	}
+	if len(snapshots.Msg.Snapshots) != 2 {
+		t.Errorf("listed %d snapshots, expected 2", len(snapshots.Msg.Snapshots))
+	}
+	for _, listed := range snapshots.Msg.Snapshots {
+		if listed.SizeBytes == 0 {
+			t.Errorf("snapshot %s has no size", listed.StateRoot)
+		}
+	}
+}
+
+func TestTracksSignatureSizesAcrossForks(t *testing.T) {
+	const activationSlot = 12
+	forks, err := types.NewForkSchedule(types.ForkDevnet0, []types.ForkActivation{{Fork: types.ForkDevnet1, Slot: activationSlot}})
+	if err != nil {
+		t.Fatalf("creating fork schedule: %v", err)
+	}
+	chain := mocknode.Config{Forks: forks}
+	sszChain := chain
+	sszChain.ServeSSZ = true
+
+	env := newTestEnvWithConfig(t, []string{`forkActivations: [{fork: "devnet1", slot: 12}]`}, nil,
+		mockEndpoint{name: "ssz", config: sszChain},
+		mockEndpoint{name: "json", config: chain},
+	)
+	signatureService := signature.NewSignatureService(env.indexer, logrus.StandardLogger())
+	ctx := context.Background()
+
+	waitFor(t, 10*time.Second, "indexer to reach slot 16", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= activationSlot+4 && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// SSZ blocks of both layouts decode, so the client keeps serving SSZ
+	if encoding := env.indexer.GetClientPool().GetClientByName("ssz").GetEncoding(); encoding != "ssz" {
+		t.Errorf("ssz client served %q", encoding)
+	}
+
+	// Blocks are stored with the signature of their fork, fetched from either client
+	for _, slot := range []uint64{activationSlot - 1, activationSlot, activationSlot + 2} {
+		response, err := signatureService.GetBlockSignatures(ctx, connect.NewRequest(&apiv1.GetBlockSignaturesRequest{Slot: slot}))
+		if err != nil {
+			t.Fatalf("getting signatures at slot %d: %v", slot, err)
+		}
+		if len(response.Msg.Signatures) != 1 {
+			t.Fatalf("expected one signature at slot %d, got %d", slot, len(response.Msg.Signatures))
+		}
+		stored := response.Msg.Signatures[0]
+
+		fork := forks.ForkAt(slot)
+		blockRoot, _ := env.nodes["ssz"].BlockBySlot(slot).HashTreeRoot()
+		signed := env.nodes["ssz"].SignedBlockByRoot(blockRoot)
+		encoded, err := fork.MarshalSignedBlock(signed)
+		if err != nil {
+			t.Fatalf("encoding block at slot %d: %v", slot, err)
+		}
+		if stored.Fork != fork.Name || stored.Signature != convert.HexRoot(signed.Signature) ||
+			stored.SignatureSize != uint64(len(signed.Signature)) || stored.BlockSize != uint64(len(encoded)) {
+			t.Errorf("slot %d stored as %s with %d byte signature and %d byte block, expected %s with %d and %d",
+				slot, stored.Fork, stored.SignatureSize, stored.BlockSize, fork.Name, len(signed.Signature), len(encoded))
+		}
+	}
+
+	// Buckets split at the activation, Devnet 1 signatures dominate the block size
+	trend, err := signatureService.GetSizeTrend(ctx, connect.NewRequest(&apiv1.GetSizeTrendRequest{BucketSlots: 4}))
+	if err != nil {
+		t.Fatalf("getting size trend: %v", err)
+	}
+	for _, bucket := range trend.Msg.Buckets {
+		stats := bucket.Stats
+		if bucket.StartTime != env.genesis.Add(time.Duration(bucket.StartSlot)*slotDuration).UnixMilli() {
+			t.Errorf("bucket at slot %d starts at %d", bucket.StartSlot, bucket.StartTime)
+		}
+		if stats.FirstSlot < bucket.StartSlot || stats.LastSlot > bucket.EndSlot {
+			t.Errorf("bucket %d-%d has blocks %d-%d", bucket.StartSlot, bucket.EndSlot, stats.FirstSlot, stats.LastSlot)
+		}
+		if (bucket.Fork == types.ForkDevnet1) != (stats.FirstSlot >= activationSlot) {
+			t.Errorf("bucket of %s has blocks %d-%d", bucket.Fork, stats.FirstSlot, stats.LastSlot)
+		}
+	}
+	if len(trend.Msg.Forks) != 2 {
+		t.Fatalf("expected stats of 2 forks, got %+v", trend.Msg.Forks)
+	}
+	devnet0, devnet1 := trend.Msg.Forks[0], trend.Msg.Forks[1]
+	if devnet0.Fork != types.ForkDevnet0 || devnet1.Fork != types.ForkDevnet1 || devnet1.ActivationSlot != activationSlot {
+		t.Errorf("unexpected forks %+v", trend.Msg.Forks)
+	}
+	if devnet0.Stats.MaxSignatureSize != 32 || devnet0.Stats.LastSlot >= activationSlot {
+		t.Errorf("devnet0 stats %+v", devnet0.Stats)
+	}
+	if devnet1.Stats.MinSignatureSize < 3000 || devnet1.Stats.AvgBlockSize <= devnet1.Stats.AvgSignatureSize ||
+		devnet1.Stats.AvgBlockSize <= devnet0.Stats.AvgBlockSize+3000 {
+		t.Errorf("devnet1 stats %+v, devnet0 stats %+v", devnet1.Stats, devnet0.Stats)
+	}
+}
+
+func TestSummarizesMissedSlotsByClient(t *testing.T) {
+	validatorsPath := filepath.Join(t.TempDir(), "validators.yml")
+	validatorsYAML := `validators:
+  - client: "zeam-0"
+    startIndex: 0
+    endIndex: 1
+  - client: "ream-0"
+    startIndex: 2
+    endIndex: 4
+`
+	if err := os.WriteFile(validatorsPath, []byte(validatorsYAML), 0o600); err != nil {
+		t.Fatalf("writing validator config: %v", err)
+	}
+
+	chain := mocknode.Config{Validators: 5, MissedSlotProbability: 0.25}
+	env := newTestEnvWithConfig(t, []string{fmt.Sprintf("validatorConfig: %q", validatorsPath)}, nil,
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "ream-0", config: chain},
+	)
+	networkService := network.NewNetworkService(env.indexer, logrus.StandardLogger())
+	node := env.nodes["zeam-0"]
+
+	waitFor(t, 10*time.Second, "indexer to reach slot 16", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= 16 && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// Concurrent requests share one summary build
+	const requests = 4
+	summaries := make(chan *apiv1.NetworkSummary, requests)
+	for range requests {
+		go func() {
+			response, err := networkService.GetNetworkSummary(context.Background(), connect.NewRequest(&apiv1.GetNetworkSummaryRequest{MissedSlotWindow: 16}))
+			if err != nil {
+				t.Errorf("getting network summary: %v", err)
+				summaries <- nil
+				return
+			}
+			summaries <- response.Msg.Summary
+		}()
+	}
+	var summary *apiv1.NetworkSummary
+	for range requests {
+		if received := <-summaries; received != nil {
+			summary = received
+		}
+	}
+	if summary == nil {
+		t.FailNow()
+	}
+
+	// Every slot without a block in the window is listed with its round robin proposer's client
+	startSlot := summary.HeadSlot - summary.MissedSlotWindow + 1
+	var expected []uint64
+	for slot := summary.HeadSlot; slot >= startSlot; slot-- {
+		if node.BlockBySlot(slot) == nil {
+			expected = append(expected, slot)
+		}
+	}
+	if len(expected) == 0 {
+		t.Fatalf("no missed slots between %d and %d to attribute", startSlot, summary.HeadSlot)
+	}
+	if len(summary.Missed) != len(expected) || summary.MissedSlots != uint64(len(expected)) {
+		t.Fatalf("expected missed slots %v, got %d: %+v", expected, summary.MissedSlots, summary.Missed)
+	}
+	for i, missed := range summary.Missed {
+		client := "ream-0"
+		if missed.Slot%5 <= 1 {
+			client = "zeam-0"
+		}
+		if missed.Slot != expected[i] || !missed.ProposerKnown || missed.ProposerIndex != missed.Slot%5 || missed.Client != client {
+			t.Errorf("missed slot %d attributed to proposer %d of %q, expected slot %d of %q", missed.Slot, missed.ProposerIndex, missed.Client, expected[i], client)
+		}
+	}
+	if summary.MissedSlotWindow != 16 || summary.MissedSlotRate != float64(len(expected))/16 {
+		t.Errorf("missed %d slots at rate %v over a window of %d, expected %d at %v over 16",
+			summary.MissedSlots, summary.MissedSlotRate, summary.MissedSlotWindow, len(expected), float64(len(expected))/16)
+	}
+
+	// The head lag is the wall clock slot at generation time minus the head slot
+	generatedAt := time.UnixMilli(summary.GeneratedAtMs)
+	wallClockSlot := uint64(generatedAt.Sub(env.genesis) / slotDuration)
+	if summary.WallClockSlot != wallClockSlot || summary.HeadLagSlots != int64(wallClockSlot)-int64(summary.HeadSlot) {
+		t.Errorf("wall clock slot %d with head lag %d at head %d, expected wall clock slot %d",
+			summary.WallClockSlot, summary.HeadLagSlots, summary.HeadSlot, wallClockSlot)
+	}
+	if summary.HeadLagSlots < 0 {
+		t.Errorf("head is %d slots ahead of the wall clock", -summary.HeadLagSlots)
+	}
+
+	// A window beyond the head covers every slot after genesis, capped at the maximum window
+	response, err := networkService.GetNetworkSummary(context.Background(), connect.NewRequest(&apiv1.GetNetworkSummaryRequest{MissedSlotWindow: 100_000}))
+	if err != nil {
+		t.Fatalf("getting network summary: %v", err)
+	}
+	full := response.Msg.Summary
+	var missedSinceGenesis uint64
+	for slot := uint64(1); slot <= full.HeadSlot; slot++ {
+		if node.BlockBySlot(slot) == nil {
+			missedSinceGenesis++
+		}
+	}
+	if full.MissedSlotWindow != full.HeadSlot || full.MissedSlots != missedSinceGenesis ||
+		full.MissedSlotRate != float64(missedSinceGenesis)/float64(full.HeadSlot) {
+		t.Errorf("missed %d slots at rate %v over a window of %d at head %d, expected %d over the whole chain",
+			full.MissedSlots, full.MissedSlotRate, full.MissedSlotWindow, full.HeadSlot, missedSinceGenesis)
+	}
+}
+
+func TestSearchesChain(t *testing.T) {
+	validatorsPath := filepath.Join(t.TempDir(), "validators.yml")
+	validatorsYAML := `validators:
+  - client: "zeam-0"
+    startIndex: 0
+    endIndex: 1
+  - client: "ream-0"
+    startIndex: 2
+    endIndex: 4
+`
+	if err := os.WriteFile(validatorsPath, []byte(validatorsYAML), 0o600); err != nil {
+		t.Fatalf("writing validator config: %v", err)
+	}
+
+	chain := mocknode.Config{Validators: 5}
+	env := newTestEnvWithConfig(t, []string{fmt.Sprintf("validatorConfig: %q", validatorsPath)}, nil,
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "zeam", config: chain},
+		mockEndpoint{name: "ream-0", config: chain},
+	)
+	searchService := search.NewSearchService(env.indexer, logrus.StandardLogger())
+	node := env.nodes["zeam-0"]
+
+	waitFor(t, 10*time.Second, "indexer to reach slot 8", func() bool {
+		return env.indexer.GetPoller().GetLastProcessedSlot() >= 8 && !env.indexer.GetPoller().IsCatchupInProgress()
+	})
+
+	// A stored block without children that is not in the block tree, like the old tip of an orphaned fork
+	orphan := &types.BlockHeader{
+		Slot:          1000,
+		ProposerIndex: 0,
+		ParentRoot:    bytes.Repeat([]byte{0xee}, 32),
+		StateRoot:     bytes.Repeat([]byte{0xef}, 32),
+		BodyRoot:      bytes.Repeat([]byte{0xf0}, 32),
+	}
+	if err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlockHeader(orphan, "", tx)
+	}); err != nil {
+		t.Fatalf("storing orphaned block: %v", err)
+	}
+
+	block := node.BlockBySlot(3)
+	blockRoot, _ := block.HashTreeRoot()
+	orphanRoot, _ := orphan.HashTreeRoot()
+	proposed, err := db.CountBlockHeadersByProposer(3)
+	if err != nil {
+		t.Fatalf("counting blocks of proposer 3: %v", err)
+	}
+
+	// Results are described by type and slot, proposer index or client
+	describe := func(result *apiv1.SearchResult) string {
+		switch result.Type {
+		case apiv1.SearchResult_PROPOSER:
+			return fmt.Sprintf("PROPOSER %d of %s, %d blocks", result.ProposerIndex, result.ClientLabel, result.BlockCount)
+		case apiv1.SearchResult_CLIENT:
+			return "CLIENT " + result.ClientLabel
+		}
+		return fmt.Sprintf("%s %d", result.Type, result.Block.Header.Slot)
+	}
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{"slot and proposer", "3", []string{"SLOT 3", fmt.Sprintf("PROPOSER 3 of ream-0, %d blocks", proposed)}},
+		{"block root", fmt.Sprintf("0X%X", blockRoot), []string{"BLOCK_ROOT 3", "PARENT_ROOT 4"}},
+		{"state root", convert.HexRoot(block.StateRoot), []string{"STATE_ROOT 3"}},
+		{"stored block outside the block tree", convert.HexRoot(orphanRoot[:]), []string{"BLOCK_ROOT 1000"}},
+		{"unknown parent with a stored child", convert.HexRoot(orphan.ParentRoot), []string{"PARENT_ROOT 1000"}},
+		{"exact client before partial", "zeam", []string{"CLIENT zeam", "CLIENT zeam-0"}},
+		{"client", " REAM ", []string{"CLIENT ream-0"}},
+		{"unknown slot", "999", nil},
+	}
+	for _, test := range tests {
+		response, err := searchService.Search(context.Background(), connect.NewRequest(&apiv1.SearchRequest{Query: test.query}))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		var found []string
+		for _, result := range response.Msg.Results {
+			found = append(found, describe(result))
+		}
+		if !slices.Equal(found, test.expected) {
+			t.Errorf("%s: searching %q found %v, want %v", test.name, test.query, found, test.expected)
+		}
+	}
+
+	// Body roots repeat across blocks, the matches are listed highest slot first
+	response, err := searchService.Search(context.Background(), connect.NewRequest(&apiv1.SearchRequest{Query: convert.HexRoot(block.BodyRoot)}))
+	if err != nil {
+		t.Fatalf("searching body root: %v", err)
+	}
+	var bodySlots []uint64
+	for _, result := range response.Msg.Results {
+		if result.Type != apiv1.SearchResult_BODY_ROOT {
+			t.Errorf("body root search found %s", describe(result))
+			continue
+		}
+		bodySlots = append(bodySlots, result.Block.Header.Slot)
+	}
+	if !slices.Contains(bodySlots, 3) || !slices.IsSortedFunc(bodySlots, func(a, b uint64) int { return int(b) - int(a) }) {
+		t.Errorf("body root search found slots %v, want slot 3 among slots in descending order", bodySlots)
+	}
+
+	if _, err := searchService.Search(context.Background(), connect.NewRequest(&apiv1.SearchRequest{Query: " "})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("empty query returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestFailedEndpointAddIsNotPersisted(t *testing.T) {
+	env := newTestEnv(t, mockEndpoint{name: "zeam-0", config: mocknode.Config{}})
+
+	// A client cannot be created without its CA file
+	missingCA := types.EndpointConfig{
+		Name: "broken",
+		Url:  "https://localhost:1",
+		TLS:  types.EndpointTLSConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+	}
+	if err := env.indexer.AddEndpoint(missingCA); err == nil {
+		t.Fatal("adding an endpoint with a missing CA file succeeded")
+	}
+	if client := env.indexer.GetClientPool().GetClientByName("broken"); client != nil {
+		t.Error("failed endpoint is in the client pool")
+	}
+	record, err := db.GetEndpointRecord("broken")
+	if err != nil {
+		t.Fatalf("reading endpoint record: %v", err)
+	}
+	if record != nil {
+		t.Errorf("failed endpoint was stored: %+v", record)
+	}
+
+	// A duplicate name neither replaces the client nor its record
+	if err := env.indexer.AddEndpoint(types.EndpointConfig{Name: "zeam-0", Url: "http://localhost:1"}); !errors.Is(err, indexer.ErrEndpointExists) {
+		t.Errorf("adding a duplicate endpoint returned %v", err)
+	}
+	if record, err := db.GetEndpointRecord("zeam-0"); err != nil || record != nil {
+		t.Errorf("duplicate endpoint stored %+v, error %v", record, err)
+	}
+}
+
+func TestRestoresRuntimeEndpointOptions(t *testing.T) {
+	env := newTestEnv(t, mockEndpoint{name: "zeam-0", config: mocknode.Config{}})
+	pool := env.indexer.GetClientPool()
+	configured := *pool.GetClientByName("zeam-0").GetConfig()
+
+	t.Setenv("RUNTIME_TOKEN", "first-token")
+	t.Setenv("RUNTIME_API_KEY", "first-key")
+	endpoint := types.EndpointConfig{
+		Name:      "runtime-0",
+		Url:       configured.Url,
+		HeaderEnv: map[string]string{"X-Api-Key": "RUNTIME_API_KEY"},
+		Auth:      types.EndpointAuthConfig{BearerTokenEnv: "RUNTIME_TOKEN"},
+		Proxy:     "none",
+		Timeout:   700 * time.Millisecond,
+	}
+	if err := env.indexer.AddEndpoint(endpoint); err != nil {
+		t.Fatalf("adding endpoint: %v", err)
+	}
+
+	// Only the references to the secrets are stored
+	record, err := db.GetEndpointRecord("runtime-0")
+	if err != nil || record == nil {
+		t.Fatalf("reading endpoint record: %+v, error %v", record, err)
+	}
+	if strings.Contains(record.Options, "first-token") || strings.Contains(record.Options, "first-key") {
+		t.Errorf("stored options contain a secret: %q", record.Options)
+	}
+	if !strings.Contains(record.Options, "RUNTIME_TOKEN") || !strings.Contains(record.Options, "RUNTIME_API_KEY") {
+		t.Errorf("stored options lack the secret references: %q", record.Options)
+	}
+
+	// Reloading rebuilds the endpoint from its record, loading the secrets again
+	t.Setenv("RUNTIME_TOKEN", "second-token")
+	t.Setenv("RUNTIME_API_KEY", "second-key")
+	if err := env.indexer.ReloadEndpoints([]types.EndpointConfig{configured}); err != nil {
+		t.Fatalf("reloading endpoints: %v", err)
+	}
+	client := pool.GetClientByName("runtime-0")
+	if client == nil {
+		t.Fatal("runtime endpoint was not restored")
+	}
+	restored := client.GetConfig()
+	if restored.Auth.BearerToken != "second-token" || restored.Auth.BearerTokenEnv != "RUNTIME_TOKEN" {
+		t.Errorf("restored auth %+v, want the token loaded from RUNTIME_TOKEN", restored.Auth)
+	}
+	if restored.Headers["X-Api-Key"] != "second-key" || restored.Proxy != "none" || restored.Timeout != 700*time.Millisecond {
+		t.Errorf("restored endpoint %+v lost its options", restored)
+	}
+
+	// Inline secrets cannot be stored
+	for _, inline := range []types.EndpointConfig{
+		{Name: "inline-0", Url: configured.Url, Auth: types.EndpointAuthConfig{BearerToken: "inline-token"}},
+		{Name: "inline-1", Url: configured.Url, Headers: map[string]string{"X-Api-Key": "inline-key"}},
+	} {
+		if err := env.indexer.AddEndpoint(inline); !errors.Is(err, indexer.ErrInlineSecret) {
+			t.Errorf("adding endpoint %s with an inline secret returned %v", inline.Name, err)
+		}
+		if client := pool.GetClientByName(inline.Name); client != nil {
+			t.Errorf("endpoint %s with an inline secret is in the client pool", inline.Name)
+		}
+	}
+}
+
+func TestReplaysRecordedTraffic(t *testing.T) {
+	chain := mocknode.Config{Validators: 5, MissedSlotProbability: 0.25}
+	sszChain := chain
+	sszChain.ServeSSZ = true
+
+	archive := filepath.Join(t.TempDir(), "traffic.jsonl")
+	recording := newTestEnvWithOptions(t, []string{fmt.Sprintf("recordFile: %q", archive)},
+		mockEndpoint{name: "zeam-0", config: chain},
+		mockEndpoint{name: "qlean-0", config: sszChain},
+	)
+
+	const targetSlot = 12
+	waitFor(t, 10*time.Second, "recording to reach the target slot", func() bool {
+		return recording.indexer.GetPoller().GetLastProcessedSlot() >= targetSlot
+	})
+	recording.stop()
+
+	lastSlot := recording.indexer.GetPoller().GetLastProcessedSlot()
+	recorded := indexedHeaders(t, recording, lastSlot)
+	if len(recorded.Headers) == 0 {
+		t.Fatal("recording indexed no headers")
+	}
+
+	// A fresh database fed only from the archive indexes the same chain
+	replay := newReplayEnv(t, archive, recording.genesis, chain.Validators)
+	waitFor(t, 15*time.Second, "replay to reach the recorded slot", func() bool {
+		return replay.indexer.GetPoller().GetLastProcessedSlot() >= lastSlot
+	})
+	replay.stop()
+
+	replayed := indexedHeaders(t, replay, lastSlot)
+	if !proto.Equal(recorded, replayed) {
+		t.Errorf("replay indexed a different chain up to slot %d:\nrecorded %v\nreplayed %v", lastSlot, recorded, replayed)
+	}
+}
+
+// indexedHeaders returns the block headers API output for the headers stored up to a slot
+func indexedHeaders(t *testing.T, env *testEnv, lastSlot uint64) *apiv1.GetBlockHeadersResponse {
+	t.Helper()
+
+	service := block.NewBlockService(env.indexer, logrus.StandardLogger())
+	resp, err := service.GetBlockHeaders(context.Background(), connect.NewRequest(&apiv1.GetBlockHeadersRequest{
+		Limit:     100,
+		SortOrder: apiv1.GetBlockHeadersRequest_SLOT_ASC,
+	}))
+	if err != nil {
+		t.Fatalf("getting block headers: %v", err)
+	}
+
+	headers := resp.Msg.Headers
+	for i, header := range headers {
+		if header.Header.Slot > lastSlot {
+			headers = headers[:i]
+			break
+		}
+	}
+	return &apiv1.GetBlockHeadersResponse{Headers: headers}
+}
+
+func TestFlagsBlockWithWrongStateRoot(t *testing.T) {
+	const wrongSlot = 3
+	chain := mocknode.Config{Validators: 4}
+	buggy := chain
+	buggy.WrongStateRootSlots = []uint64{wrongSlot}
+
+	env := newTestEnv(t, mockEndpoint{name: "zeam-0", config: buggy})
+
+	// An honest node of the same chain has the post-state root the verifier computes
+	honestConfig := chain
+	honestConfig.GenesisTime = env.genesis
+	honestConfig.SlotDuration = slotDuration
+	honestConfig.Seed = 1
+	honest := mocknode.New(honestConfig)
+
+	var flagged *types.InvalidBlock
+	waitFor(t, 10*time.Second, "the wrong state root to be flagged", func() bool {
+		invalidBlocks, err := db.GetInvalidBlocks(10)
+		if err != nil {
+			t.Fatalf("reading invalid blocks: %v", err)
+		}
+		for _, invalidBlock := range invalidBlocks {
+			if invalidBlock.Slot == wrongSlot {
+				flagged = invalidBlock
+			}
+		}
+		return flagged != nil
+	})
+
+	wrongBlock := env.nodes["zeam-0"].BlockBySlot(wrongSlot)
+	wrongRoot, _ := wrongBlock.HashTreeRoot()
+	if flagged.Reason != types.InvalidBlockStateRootMismatch {
+		t.Errorf("slot %d flagged as %s (%s), want %s", wrongSlot, flagged.Reason, flagged.Error, types.InvalidBlockStateRootMismatch)
+	}
+	if !bytes.Equal(flagged.Root, wrongRoot[:]) || !bytes.Equal(flagged.StateRoot, wrongBlock.StateRoot) {
+		t.Errorf("flagged block 0x%x with state root 0x%x, served block is 0x%x with 0x%x", flagged.Root, flagged.StateRoot, wrongRoot, wrongBlock.StateRoot)
+	}
+	if expected := honest.BlockBySlot(wrongSlot).StateRoot; !bytes.Equal(flagged.ComputedStateRoot, expected) {
+		t.Errorf("computed state root 0x%x, the honest chain has 0x%x", flagged.ComputedStateRoot, expected)
+	}
+	if flagged.Client != "zeam-0" {
+		t.Errorf("flagged block attributed to %q, want zeam-0", flagged.Client)
+	}
+
+	// Blocks before it pass
+	invalidBlocks, err := db.GetInvalidBlocks(10)
+	if err != nil {
+		t.Fatalf("reading invalid blocks: %v", err)
+	}
+	for _, invalidBlock := range invalidBlocks {
+		if invalidBlock.Slot < wrongSlot {
+			t.Errorf("slot %d before the wrong state root flagged as %s: %s", invalidBlock.Slot, invalidBlock.Reason, invalidBlock.Error)
+		}
+	}
+}
+
+func TestFlagsGenesisMismatch(t *testing.T) {
+	// The nodes run 4 validators, the indexer builds its genesis for 5
+	env := newTestEnvWithConfig(t, []string{"validatorCount: 5"}, nil,
+		mockEndpoint{name: "zeam-0", config: mocknode.Config{Validators: 4}},
+	)
+
+	var flagged *types.InvalidBlock
+	waitFor(t, 10*time.Second, "the genesis mismatch to be flagged", func() bool {
+		invalidBlocks, err := db.GetInvalidBlocks(10)
+		if err != nil {
+			t.Fatalf("reading invalid blocks: %v", err)
+		}
+		for _, invalidBlock := range invalidBlocks {
+			if invalidBlock.Reason == types.InvalidBlockGenesisMismatch {
+				flagged = invalidBlock
+			}
+		}
+		return flagged != nil
+	})
+
+	node := env.nodes["zeam-0"]
+	genesisRoot, _ := node.Genesis().HashTreeRoot()
+	if first := node.BlockBySlot(flagged.Slot); first == nil || !bytes.Equal(first.ParentRoot, genesisRoot[:]) {
+		t.Errorf("flagged slot %d is not the first block after the node's genesis", flagged.Slot)
+	}
+	if !strings.Contains(flagged.Error, "5 validators") {
+		t.Errorf("finding %q does not name the local validator count", flagged.Error)
+	}
+
+	// Nothing is verified against the wrong genesis, and nothing else is flagged
+	time.Sleep(4 * slotDuration)
+	if slot := env.indexer.GetStateVerifier().GetLastVerifiedSlot(); slot != 0 {
+		t.Errorf("verified up to slot %d against the wrong genesis", slot)
+	}
+	invalidBlocks, err := db.GetInvalidBlocks(10)
+	if err != nil {
+		t.Fatalf("reading invalid blocks: %v", err)
+	}
+	if len(invalidBlocks) != 1 {
+		t.Errorf("%d blocks flagged, want only the genesis mismatch", len(invalidBlocks))
+	}
+}
+
+func TestResumesStateVerificationAfterRestart(t *testing.T) {
+	env := newTestEnv(t, mockEndpoint{name: "zeam-0", config: mocknode.Config{Validators: 4}})
+	node := env.nodes["zeam-0"]
+
+	waitFor(t, 10*time.Second, "state verification to reach slot 6", func() bool {
+		return env.indexer.GetStateVerifier().GetLastVerifiedSlot() >= 6
+	})
+	env.stop()
+
+	progress, err := db.GetVerifierProgress()
+	if err != nil || progress == nil {
+		t.Fatalf("reading verifier progress: %+v, error %v", progress, err)
+	}
+	verifiedRoot, _ := node.BlockBySlot(progress.Slot).HashTreeRoot()
+	if progress.Slot < 6 || !bytes.Equal(progress.BlockRoot, verifiedRoot[:]) {
+		t.Fatalf("stored progress at slot %d root 0x%x, want the chain's block at slot 6 or later", progress.Slot, progress.BlockRoot)
+	}
+
+	// With every request failing no block can be fetched, so the tip can only come from the
+	// stored progress
+	node.SetErrorRate(1)
+	env.restart(t)
+	time.Sleep(4 * slotDuration)
+	if slot := env.indexer.GetStateVerifier().GetLastVerifiedSlot(); slot != progress.Slot {
+		t.Fatalf("restarted verifier is at slot %d, want the stored slot %d", slot, progress.Slot)
+	}
+
+	// Verification continues on top of the restored state
+	node.SetErrorRate(0)
+	waitFor(t, 10*time.Second, "state verification to continue after the restart", func() bool {
+		return env.indexer.GetStateVerifier().GetLastVerifiedSlot() > progress.Slot+2
+	})
+	invalidBlocks, err := db.GetInvalidBlocks(10)
+	if err != nil {
+		t.Fatalf("reading invalid blocks: %v", err)
+	}
+	for _, invalidBlock := range invalidBlocks {
+		t.Errorf("slot %d flagged as %s after the restart: %s", invalidBlock.Slot, invalidBlock.Reason, invalidBlock.Error)
+	}
+}
@@ -0,0 +1,252 @@
+package mocknode
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Handler serves the lean API headers, blocks and states endpoints from the simulated
+// chain, along with control endpoints under /mock/ that change the simulation at runtime:
+//
+//	GET  /lean/v0/headers/{head|finalized|justified|genesis|<slot>|<0x root>}
+//	GET  /lean/v0/blocks/{block_id}, the signed block with the same block ids
+//	GET  /lean/v0/states/{state_id}, the post-state with the same ids and state roots
+//	GET  /mock/status
+//	POST /mock/stall, /mock/resume
+//	POST /mock/fork?depth=N
+//	POST /mock/latency?value=250ms
+//	POST /mock/errors?rate=0.5
+func (n *Node) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /lean/v0/headers/{block_id}", func(w http.ResponseWriter, r *http.Request) {
+		n.handleBlock(w, r, func(b *block) sszObject { return b.header })
+	})
+	mux.HandleFunc("GET /lean/v0/blocks/{block_id}", func(w http.ResponseWriter, r *http.Request) {
+		n.handleBlock(w, r, func(b *block) sszObject { return &signedBlockResponse{fork: b.fork, signed: b.signed} })
+	})
+	mux.HandleFunc("GET /lean/v0/states/{state_id}", n.handleState)
+
+	mux.HandleFunc("GET /mock/status", n.handleStatus)
+	mux.HandleFunc("POST /mock/stall", func(w http.ResponseWriter, r *http.Request) {
+		n.Stall()
+		n.handleStatus(w, r)
+	})
+	mux.HandleFunc("POST /mock/resume", func(w http.ResponseWriter, r *http.Request) {
+		n.Resume()
+		n.handleStatus(w, r)
+	})
+	mux.HandleFunc("POST /mock/fork", func(w http.ResponseWriter, r *http.Request) {
+		depth, err := strconv.ParseUint(r.URL.Query().Get("depth"), 10, 64)
+		if err != nil {
+			depth = 1
+		}
+		if err := n.InjectFork(depth); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		n.handleStatus(w, r)
+	})
+	mux.HandleFunc("POST /mock/latency", func(w http.ResponseWriter, r *http.Request) {
+		latency, err := time.ParseDuration(r.URL.Query().Get("value"))
+		if err != nil || latency < 0 {
+			http.Error(w, "value must be a non-negative duration such as 250ms", http.StatusBadRequest)
+			return
+		}
+		n.SetLatency(latency)
+		n.handleStatus(w, r)
+	})
+	mux.HandleFunc("POST /mock/errors", func(w http.ResponseWriter, r *http.Request) {
+		rate, err := strconv.ParseFloat(r.URL.Query().Get("rate"), 64)
+		if err != nil || rate < 0 || rate > 1 {
+			http.Error(w, "rate must be between 0 and 1", http.StatusBadRequest)
+			return
+		}
+		n.SetErrorRate(rate)
+		n.handleStatus(w, r)
+	})
+
+	return mux
+}
+
+// sszObject is a response that can be served as SSZ or JSON
+type sszObject interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// signedBlockResponse serves a signed block in the SSZ layout of its fork
+type signedBlockResponse struct {
+	fork   *types.ForkVersion
+	signed *types.SignedBlock
+}
+
+// MarshalSSZ encodes the block in its fork's layout
+func (sr *signedBlockResponse) MarshalSSZ() ([]byte, error) {
+	return sr.fork.MarshalSignedBlock(sr.signed)
+}
+
+// MarshalJSON encodes the block like any signed block, the layouts only differ in SSZ
+func (sr *signedBlockResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sr.signed)
+}
+
+// handleBlock serves the header or full block selected by the block_id, applying the
+// configured latency and errors
+func (n *Node) handleBlock(w http.ResponseWriter, r *http.Request, view func(*block) sszObject) {
+	if !n.delayOrFail(w, r) {
+		return
+	}
+
+	block, ok := n.lookup(r.PathValue("block_id"))
+	if !ok {
+		http.Error(w, "invalid block_id", http.StatusBadRequest)
+		return
+	}
+	if block == nil {
+		http.Error(w, "block not found", http.StatusNotFound)
+		return
+	}
+
+	n.writeObject(w, r, view(block))
+}
+
+// handleState serves the post-state selected by the state_id, only the genesis state and
+// the states of the latest blocks are kept
+func (n *Node) handleState(w http.ResponseWriter, r *http.Request) {
+	if !n.delayOrFail(w, r) {
+		return
+	}
+
+	state, ok := n.lookupState(r.PathValue("state_id"))
+	if !ok {
+		http.Error(w, "invalid state_id", http.StatusBadRequest)
+		return
+	}
+	if state == nil {
+		http.Error(w, "state not found", http.StatusNotFound)
+		return
+	}
+
+	n.writeObject(w, r, state)
+}
+
+// delayOrFail applies the configured latency and errors, it reports whether the request
+// should be answered
+func (n *Node) delayOrFail(w http.ResponseWriter, r *http.Request) bool {
+	latency, fail := n.requestOutcome()
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return false
+		}
+	}
+	if fail {
+		http.Error(w, "injected error", http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+// writeObject writes the response as SSZ if the node serves SSZ and the request accepts it,
+// as JSON otherwise
+func (n *Node) writeObject(w http.ResponseWriter, r *http.Request, response sszObject) {
+	if n.config.ServeSSZ && strings.Contains(r.Header.Get("Accept"), "application/octet-stream") {
+		data, err := response.MarshalSSZ()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write(data)
+		return
+	}
+
+	writeJSON(w, response)
+}
+
+// lookup resolves a block_id, the block is nil if none matches and ok is false if the id is invalid
+func (n *Node) lookup(blockId string) (*block, bool) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	switch blockId {
+	case "head":
+		return n.head(), true
+	case "finalized":
+		return n.checkpoint(n.head().state.LatestFinalized), true
+	case "justified":
+		return n.checkpoint(n.head().state.LatestJustified), true
+	case "genesis":
+		return n.canonical[0], true
+	}
+
+	if rootHex, isRoot := strings.CutPrefix(blockId, "0x"); isRoot {
+		decoded, err := hex.DecodeString(rootHex)
+		if err != nil || len(decoded) != 32 {
+			return nil, false
+		}
+		return n.blocks[[32]byte(decoded)], true
+	}
+
+	slot, err := strconv.ParseUint(blockId, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	found := n.blockBySlot(slot)
+	if found != nil && slices.Contains(n.config.CorruptSlots, slot) {
+		header := *found.header
+		header.StateRoot = bytes.Repeat([]byte{0xba}, 32)
+		return &block{header: &header, signed: found.signed, state: found.state}, true
+	}
+	return found, true
+}
+
+// lookupState resolves a state_id, a block id or a state root. The state is nil if none
+// matches or it was dropped, ok is false if the id is invalid.
+func (n *Node) lookupState(stateId string) (*types.State, bool) {
+	if rootHex, isRoot := strings.CutPrefix(stateId, "0x"); isRoot {
+		stateRoot, err := hex.DecodeString(rootHex)
+		if err != nil || len(stateRoot) != 32 {
+			return nil, false
+		}
+
+		n.mutex.Lock()
+		defer n.mutex.Unlock()
+		n.advance()
+		for _, block := range n.blocks {
+			if block.state != nil && bytes.Equal(block.header.StateRoot, stateRoot) {
+				return block.state, true
+			}
+		}
+		return nil, true
+	}
+
+	block, ok := n.lookup(stateId)
+	if block == nil {
+		return nil, ok
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return block.state, true
+}
+
+func (n *Node) handleStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, n.GetStatus())
+}
+
+func writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,474 @@
+package mocknode
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/syjn99/leanView/backend/stf"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// Defaults for settings left unset in Config
+const (
+	defaultSlotDuration = 4 * time.Second
+	defaultValidators   = 4
+)
+
+// devnet1SignatureSize is the size of the signatures of Devnet 1 blocks, around the size of
+// a post-quantum signature
+const devnet1SignatureSize = 3112
+
+// keptStates is how many of the latest blocks keep their post-state, which bounds the
+// depth of injected forks
+const keptStates = 64
+
+// Config describes the simulated chain and how the node serves it. Nodes created with the
+// same GenesisTime, SlotDuration, Validators, MissedSlotProbability and Seed build the same
+// chain until a fork is injected.
+type Config struct {
+	GenesisTime  time.Time
+	SlotDuration time.Duration
+
+	// Validators propose in round robin order
+	Validators uint64
+
+	// MissedSlotProbability is the chance that a slot has no block
+	MissedSlotProbability float64
+
+	// Latency delays every response, ErrorRate is the chance a request fails with status 500
+	Latency   time.Duration
+	ErrorRate float64
+
+	// ServeSSZ answers requests accepting application/octet-stream with SSZ
+	ServeSSZ bool
+
+	// Seed drives missed slots and injected errors
+	Seed uint64
+
+	// Forks selects the block layout by slot, Devnet 1 blocks carry post-quantum sized
+	// signatures. Nil serves Devnet 0 blocks.
+	Forks *types.ForkSchedule
+
+	// CorruptSlots are served by slot number with a wrong state root, as a buggy client would
+	CorruptSlots []uint64
+
+	// WrongStateRootSlots are built with a wrong state root, as by a client with a buggy
+	// state transition. Later blocks of the node build on them.
+	WrongStateRootSlots []uint64
+
+	// Now returns the current time, defaults to time.Now
+	Now func() time.Time
+}
+
+// Node simulates a lean node whose chain advances every slot. Blocks are built lazily up
+// to the current slot whenever the chain is read, through the Devnet 0 state transition:
+// every block carries the votes of all validators for its parent, so checkpoints advance
+// as 3SF-mini justifies and finalizes them.
+type Node struct {
+	config Config
+
+	// canonical holds the block of every slot up to the head, nil for missed slots
+	canonical []*block
+	blocks    map[[32]byte]*block
+
+	// Blocks of a forked branch leave out the votes of one validator, so they differ from
+	// the blocks they replace
+	forks   int
+	stalled bool
+
+	latency   time.Duration
+	errorRate float64
+	rng       *rand.Rand
+
+	mutex sync.Mutex
+}
+
+// block is a block built by the node, the post-state is kept for genesis and the latest blocks only
+type block struct {
+	header *types.BlockHeader
+	signed *types.SignedBlock
+	fork   *types.ForkVersion // Layout the signed block is served in
+	state  *types.State
+}
+
+// Status is a snapshot of the node's simulation state
+type Status struct {
+	HeadSlot  uint64  `json:"head_slot"`
+	Stalled   bool    `json:"stalled"`
+	Forks     int     `json:"forks"`
+	Latency   string  `json:"latency"`
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// New creates a node with the genesis block, defaults fill unset config fields
+func New(config Config) *Node {
+	if config.SlotDuration == 0 {
+		config.SlotDuration = defaultSlotDuration
+	}
+	if config.Validators == 0 {
+		config.Validators = defaultValidators
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	if config.GenesisTime.IsZero() {
+		config.GenesisTime = config.Now()
+	}
+
+	node := &Node{
+		config:    config,
+		blocks:    make(map[[32]byte]*block),
+		latency:   config.Latency,
+		errorRate: config.ErrorRate,
+		rng:       rand.New(rand.NewPCG(config.Seed, config.Seed^0x9e3779b97f4a7c15)),
+	}
+	node.appendGenesis()
+	return node
+}
+
+// Config returns the node's config with defaults filled in
+func (n *Node) Config() Config {
+	return n.config
+}
+
+// Head returns the latest block on the node's chain
+func (n *Node) Head() *types.BlockHeader {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	return n.head().header
+}
+
+// Justified returns the latest justified block of the head state
+func (n *Node) Justified() *types.BlockHeader {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	return n.checkpoint(n.head().state.LatestJustified).header
+}
+
+// Finalized returns the latest finalized block of the head state
+func (n *Node) Finalized() *types.BlockHeader {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	return n.checkpoint(n.head().state.LatestFinalized).header
+}
+
+// Genesis returns the slot 0 block
+func (n *Node) Genesis() *types.BlockHeader {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.canonical[0].header
+}
+
+// BlockBySlot returns the canonical block at the slot, nil if the slot was missed or is in the future
+func (n *Node) BlockBySlot(slot uint64) *types.BlockHeader {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if block := n.blockBySlot(slot); block != nil {
+		return block.header
+	}
+	return nil
+}
+
+// BlockByRoot returns any block the node has built, including blocks of abandoned forks
+func (n *Node) BlockByRoot(root [32]byte) *types.BlockHeader {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	if block := n.blocks[root]; block != nil {
+		return block.header
+	}
+	return nil
+}
+
+// SignedBlockByRoot returns the full block of any block the node has built
+func (n *Node) SignedBlockByRoot(root [32]byte) *types.SignedBlock {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	if block := n.blocks[root]; block != nil {
+		return block.signed
+	}
+	return nil
+}
+
+// Stall stops the chain from advancing until Resume is called
+func (n *Node) Stall() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	n.stalled = true
+}
+
+// Resume lets a stalled chain advance again, it catches up to the current slot on the next read
+func (n *Node) Resume() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.stalled = false
+}
+
+// InjectFork replaces the last depth slots with blocks of a new branch, the chain keeps
+// building on the new branch. Blocks of the old branch stay available by root.
+func (n *Node) InjectFork(depth uint64) error {
+	if depth == 0 {
+		return errors.New("fork depth must be at least 1")
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	length := uint64(len(n.canonical))
+	if depth >= length {
+		return errors.New("fork depth reaches genesis")
+	}
+	// The new branch is built on the post-state of the block before it
+	previous := n.canonical
+	n.canonical = n.canonical[:length-depth]
+	if n.head().state == nil {
+		n.canonical = previous
+		return fmt.Errorf("fork depth must be less than %d slots", keptStates)
+	}
+
+	n.forks++
+	for slot := length - depth; slot < length; slot++ {
+		n.appendBlock(slot)
+	}
+	return nil
+}
+
+// SetLatency changes the delay of every response
+func (n *Node) SetLatency(latency time.Duration) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.latency = latency
+}
+
+// SetErrorRate changes the chance that a request fails with status 500
+func (n *Node) SetErrorRate(rate float64) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.errorRate = rate
+}
+
+// GetStatus returns a snapshot of the node's simulation state
+func (n *Node) GetStatus() Status {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.advance()
+	return Status{
+		HeadSlot:  n.head().header.Slot,
+		Stalled:   n.stalled,
+		Forks:     n.forks,
+		Latency:   n.latency.String(),
+		ErrorRate: n.errorRate,
+	}
+}
+
+// requestOutcome returns the latency of the next request and whether it should fail
+func (n *Node) requestOutcome() (time.Duration, bool) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.latency, n.errorRate > 0 && n.rng.Float64() < n.errorRate
+}
+
+// currentSlot returns the wall clock slot
+func (n *Node) currentSlot() uint64 {
+	now := n.config.Now()
+	if now.Before(n.config.GenesisTime) {
+		return 0
+	}
+	return uint64(now.Sub(n.config.GenesisTime) / n.config.SlotDuration)
+}
+
+// advance builds the blocks up to the current slot unless the node is stalled
+func (n *Node) advance() {
+	if n.stalled {
+		return
+	}
+	for slot := uint64(len(n.canonical)); slot <= n.currentSlot(); slot++ {
+		n.appendBlock(slot)
+	}
+}
+
+// appendGenesis builds the genesis state and block
+func (n *Node) appendGenesis() {
+	genesisState, err := stf.GenerateGenesisState(uint64(n.config.GenesisTime.Unix()), n.config.Validators)
+	if err != nil {
+		panic(err)
+	}
+	genesisBlock, err := stf.GenesisBlock(genesisState)
+	if err != nil {
+		panic(err)
+	}
+	n.addBlock(genesisBlock, genesisState)
+}
+
+// appendBlock builds the block of the next slot on top of the head, or records a missed slot
+func (n *Node) appendBlock(slot uint64) {
+	if n.isMissed(slot) {
+		n.canonical = append(n.canonical, nil)
+		return
+	}
+
+	// The blocks are valid by construction, an error is a bug of the node or the transition
+	parent := n.head()
+	state, err := stf.Copy(parent.state)
+	if err != nil {
+		panic(err)
+	}
+	if err := stf.ProcessSlots(state, slot); err != nil {
+		panic(err)
+	}
+
+	parentRoot, _ := parent.header.HashTreeRoot()
+	newBlock := &types.Block{
+		Slot:          slot,
+		ProposerIndex: slot % n.config.Validators,
+		ParentRoot:    parentRoot[:],
+		Body:          &types.BlockBody{Votes: n.votes(parent, slot)},
+	}
+	if err := stf.ProcessBlock(state, newBlock); err != nil {
+		panic(err)
+	}
+	stateRoot, _ := state.HashTreeRoot()
+	newBlock.StateRoot = stateRoot[:]
+	if slices.Contains(n.config.WrongStateRootSlots, slot) {
+		// Cached as the post-state root too, so the children's parent root is this block
+		newBlock.StateRoot = bytes.Repeat([]byte{0xbd}, 32)
+		state.LatestBlockHeader.StateRoot = newBlock.StateRoot
+	}
+
+	n.addBlock(newBlock, state)
+}
+
+// addBlock makes the block the new head and drops post-states that are no longer needed
+func (n *Node) addBlock(newBlock *types.Block, state *types.State) {
+	header, err := newBlock.Header()
+	if err != nil {
+		panic(err)
+	}
+	root, _ := header.HashTreeRoot()
+
+	fork := n.config.Forks.ForkAt(newBlock.Slot)
+	built := &block{
+		header: header,
+		signed: &types.SignedBlock{Message: newBlock, Signature: signature(fork, root)},
+		fork:   fork,
+		state:  state,
+	}
+	n.blocks[root] = built
+	n.canonical = append(n.canonical, built)
+
+	if len(n.canonical) > keptStates {
+		if old := n.canonical[len(n.canonical)-keptStates-1]; old != nil && old.header.Slot > 0 {
+			old.state = nil
+		}
+	}
+}
+
+// signature returns the signature of a block in the fork's layout: zero bytes for a fixed
+// size placeholder, otherwise bytes derived from the block root
+func signature(fork *types.ForkVersion, root [32]byte) []byte {
+	if fork.FixedSignatureSize {
+		return make([]byte, fork.MaxSignatureSize)
+	}
+
+	sig := make([]byte, 0, devnet1SignatureSize+sha256.Size)
+	for counter := uint64(0); len(sig) < devnet1SignatureSize; counter++ {
+		chunk := sha256.Sum256(binary.LittleEndian.AppendUint64(root[:], counter))
+		sig = append(sig, chunk[:]...)
+	}
+	return sig[:devnet1SignatureSize]
+}
+
+// votes returns the votes of a block on top of parent: every validator votes for the parent
+// as head and target, with the latest justified checkpoint as source. 3SF-mini ignores the
+// votes whose target is not after the source or cannot be justified yet.
+func (n *Node) votes(parent *block, slot uint64) []*types.Vote {
+	parentRoot, _ := parent.header.HashTreeRoot()
+	target := &types.Checkpoint{Root: parentRoot[:], Slot: parent.header.Slot}
+	source := parent.state.LatestJustified
+
+	// A forked branch leaves out a different validator than the branch it replaced
+	skipped := int64(-1)
+	if n.forks > 0 {
+		skipped = int64(uint64(n.forks-1) % n.config.Validators)
+	}
+
+	var votes []*types.Vote
+	for validator := uint64(0); validator < n.config.Validators; validator++ {
+		if int64(validator) == skipped {
+			continue
+		}
+		votes = append(votes, &types.Vote{
+			ValidatorId: validator,
+			Slot:        slot,
+			Head:        target,
+			Target:      target,
+			Source:      source,
+		})
+	}
+	return votes
+}
+
+// head returns the latest non-missed block
+func (n *Node) head() *block {
+	for i := len(n.canonical) - 1; i > 0; i-- {
+		if n.canonical[i] != nil {
+			return n.canonical[i]
+		}
+	}
+	return n.canonical[0]
+}
+
+// checkpoint returns the block of a checkpoint, the genesis block before the first justification
+func (n *Node) checkpoint(checkpoint *types.Checkpoint) *block {
+	if block := n.blocks[[32]byte(checkpoint.Root)]; block != nil {
+		return block
+	}
+	return n.canonical[0]
+}
+
+// blockBySlot returns the canonical block at the slot, nil if the slot was missed or is in the future
+func (n *Node) blockBySlot(slot uint64) *block {
+	n.advance()
+	if slot >= uint64(len(n.canonical)) {
+		return nil
+	}
+	return n.canonical[slot]
+}
+
+// isMissed decides from the seed whether a slot has no block, so nodes sharing a seed agree
+func (n *Node) isMissed(slot uint64) bool {
+	if n.config.MissedSlotProbability <= 0 {
+		return false
+	}
+	hash := n.hash("missed", slot)
+	sample := float64(binary.BigEndian.Uint64(hash[:8])>>11) / (1 << 53)
+	return sample < n.config.MissedSlotProbability
+}
+
+func (n *Node) hash(label string, slot uint64) [32]byte {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[0:], n.config.Seed)
+	binary.BigEndian.PutUint64(buf[8:], slot)
+	return sha256.Sum256(append([]byte(label), buf[:]...))
+}
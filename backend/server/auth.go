@@ -0,0 +1,23 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// newBearerAuthInterceptor rejects requests without the expected bearer token
+func newBearerAuthInterceptor(token string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			provided, ok := strings.CutPrefix(req.Header().Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or missing admin token"))
+			}
+			return next(ctx, req)
+		}
+	}
+}
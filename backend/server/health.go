@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+const dbPingTimeout = 2 * time.Second
+
+// Check result statuses
+const (
+	checkPass = "pass"
+	checkFail = "fail"
+	checkSkip = "skip"
+)
+
+// checkResult is the outcome of a single probe check
+type checkResult struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+	Details any    `json:"details,omitempty"`
+}
+
+// probeResponse is the JSON body returned by /livez and /readyz
+type probeResponse struct {
+	Status  string                  `json:"status"`
+	Service string                  `json:"service"`
+	Checks  map[string]*checkResult `json:"checks"`
+}
+
+// healthChecker evaluates liveness and readiness from the indexer state
+type healthChecker struct {
+	indexer *indexer.Indexer
+	config  types.HealthConfig
+	logger  logrus.FieldLogger
+}
+
+// newHealthChecker creates a health checker with the configured thresholds
+func newHealthChecker(indexer *indexer.Indexer, config types.HealthConfig, logger logrus.FieldLogger) *healthChecker {
+	return &healthChecker{
+		indexer: indexer,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// livezHandler reports whether the process is alive and the poller has not exited
+func (hc *healthChecker) livezHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		checks := map[string]*checkResult{
+			"poller": hc.checkPollerRunning(),
+		}
+		hc.writeProbe(w, "alive", "dead", checks)
+	}
+}
+
+// readyzHandler reports whether the indexer is serving fresh data
+func (hc *healthChecker) readyzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		checks := map[string]*checkResult{
+			"database":  hc.checkDatabase(r.Context()),
+			"clients":   hc.checkClients(),
+			"last_poll": hc.checkLastPoll(),
+			"catchup":   hc.checkCatchup(),
+			"head_lag":  hc.checkHeadLag(),
+		}
+		hc.writeProbe(w, "ready", "not_ready", checks)
+	}
+}
+
+// writeProbe writes the checks as JSON, with a 503 if any check failed
+func (hc *healthChecker) writeProbe(w http.ResponseWriter, okStatus, failStatus string, checks map[string]*checkResult) {
+	response := probeResponse{
+		Status:  okStatus,
+		Service: "PQ Devnet Visualizer",
+		Checks:  checks,
+	}
+
+	statusCode := http.StatusOK
+	for _, check := range checks {
+		if check.Status == checkFail {
+			response.Status = failStatus
+			statusCode = http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		hc.logger.Errorf("Error writing probe response: %v", err)
+	}
+}
+
+// checkPollerRunning fails if the block poller has stopped
+func (hc *healthChecker) checkPollerRunning() *checkResult {
+	if !hc.indexer.GetPoller().IsRunning() {
+		return &checkResult{Status: checkFail, Message: "block poller is not running"}
+	}
+	return &checkResult{Status: checkPass}
+}
+
+// checkDatabase pings the database and reports the migration version
+func (hc *healthChecker) checkDatabase(ctx context.Context) *checkResult {
+	pingCtx, cancel := context.WithTimeout(ctx, dbPingTimeout)
+	defer cancel()
+
+	if err := db.Ping(pingCtx); err != nil {
+		return &checkResult{Status: checkFail, Message: fmt.Sprintf("ping failed: %v", err)}
+	}
+
+	version, err := db.GetDbVersion()
+	if err != nil {
+		return &checkResult{Status: checkFail, Message: fmt.Sprintf("failed to read migration version: %v", err)}
+	}
+
+	return &checkResult{
+		Status:  checkPass,
+		Details: map[string]any{"migration_version": version},
+	}
+}
+
+// checkClients fails if no lean node is healthy
+func (hc *healthChecker) checkClients() *checkResult {
+	clientPool := hc.indexer.GetClientPool()
+	healthy := clientPool.GetHealthyClientCount()
+	details := map[string]any{
+		"healthy": healthy,
+		"total":   clientPool.GetClientCount(),
+	}
+
+	if healthy == 0 {
+		return &checkResult{Status: checkFail, Message: "no healthy clients", Details: details}
+	}
+	return &checkResult{Status: checkPass, Details: details}
+}
+
+// checkLastPoll fails if the poller has not fetched a head recently
+func (hc *healthChecker) checkLastPoll() *checkResult {
+	lastPoll := hc.indexer.GetPoller().GetLastSuccessfulPoll()
+	if lastPoll.IsZero() {
+		return &checkResult{Status: checkFail, Message: "no successful poll yet"}
+	}
+
+	age := hc.indexer.GetClock().Now().Sub(lastPoll)
+	details := map[string]any{
+		"age_ms":     age.Milliseconds(),
+		"max_age_ms": hc.config.MaxPollAge.Milliseconds(),
+	}
+
+	if age > hc.config.MaxPollAge {
+		return &checkResult{Status: checkFail, Message: "last successful poll is too old", Details: details}
+	}
+	return &checkResult{Status: checkPass, Details: details}
+}
+
+// checkCatchup reports whether a catchup is running, which does not affect readiness
+func (hc *healthChecker) checkCatchup() *checkResult {
+	return &checkResult{
+		Status:  checkPass,
+		Details: map[string]any{"in_progress": hc.indexer.GetPoller().IsCatchupInProgress()},
+	}
+}
+
+// checkHeadLag fails if the head trails the wall clock slot by more than the threshold
+func (hc *healthChecker) checkHeadLag() *checkResult {
+	wallClockSlot, ok := hc.indexer.GetSlotClock().CurrentSlot()
+	if !ok {
+		return &checkResult{Status: checkSkip, Message: "genesis time not configured"}
+	}
+
+	var headSlot uint64
+	if head := hc.indexer.GetHeadCache().GetCurrentHead(); head != nil {
+		headSlot = head.Slot
+	}
+
+	var lag uint64
+	if wallClockSlot > headSlot {
+		lag = wallClockSlot - headSlot
+	}
+	details := map[string]any{
+		"head_slot":       headSlot,
+		"wall_clock_slot": wallClockSlot,
+		"lag_slots":       lag,
+		"max_lag_slots":   hc.config.MaxHeadLagSlots,
+	}
+
+	if lag > hc.config.MaxHeadLagSlots {
+		return &checkResult{Status: checkFail, Message: "head lags behind the wall clock", Details: details}
+	}
+	return &checkResult{Status: checkPass, Details: details}
+}
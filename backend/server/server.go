@@ -3,7 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"slices"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
@@ -12,16 +16,20 @@ import (
 
 	"github.com/syjn99/leanView/backend/gen/proto/api/v1/apiv1connect"
 	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/admin"
 	"github.com/syjn99/leanView/backend/services/block"
+	"github.com/syjn99/leanView/backend/services/chainquery"
+	"github.com/syjn99/leanView/backend/services/equivocation"
+	"github.com/syjn99/leanView/backend/services/forkchoice"
+	"github.com/syjn99/leanView/backend/services/justification"
 	"github.com/syjn99/leanView/backend/services/monitoring"
-)
-
-const (
-	defaultPort     = "8080"
-	readTimeout     = 15 * time.Second
-	writeTimeout    = 15 * time.Second
-	idleTimeout     = 60 * time.Second
-	shutdownTimeout = 30 * time.Second
+	"github.com/syjn99/leanView/backend/services/network"
+	"github.com/syjn99/leanView/backend/services/proof"
+	"github.com/syjn99/leanView/backend/services/proposer"
+	"github.com/syjn99/leanView/backend/services/search"
+	"github.com/syjn99/leanView/backend/services/signature"
+	"github.com/syjn99/leanView/backend/services/state"
+	"github.com/syjn99/leanView/backend/types"
 )
 
 // Server represents the HTTP server with Connect RPC support
@@ -29,9 +37,21 @@ type Server struct {
 	logger     logrus.FieldLogger
 	indexer    *indexer.Indexer
 	httpServer *http.Server
+
+	shutdownTimeout time.Duration
+
+	// Allowed CORS origins, swapped on config reload
+	corsOrigins atomic.Pointer[[]string]
 }
 
-func NewServer(indexer *indexer.Indexer, logger logrus.FieldLogger) *Server {
+func NewServer(config *types.Config, indexer *indexer.Indexer, logger logrus.FieldLogger) *Server {
+	server := &Server{
+		indexer:         indexer,
+		logger:          logger,
+		shutdownTimeout: config.Server.ShutdownTimeout,
+	}
+	server.SetCorsOrigins(config.Server.CorsOrigins)
+
 	mux := http.NewServeMux()
 
 	// Root handler for basic info
@@ -40,6 +60,11 @@ func NewServer(indexer *indexer.Indexer, logger logrus.FieldLogger) *Server {
 	// Health check endpoint for container orchestration
 	mux.HandleFunc("/health", healthHandler(logger))
 
+	// Liveness and readiness probes reflecting the indexer state
+	checker := newHealthChecker(indexer, config.Health, logger)
+	mux.HandleFunc("/livez", checker.livezHandler())
+	mux.HandleFunc("/readyz", checker.readyzHandler())
+
 	// Create Block service
 	blockService := block.NewBlockService(indexer, logger.(*logrus.Entry).Logger)
 
@@ -64,27 +89,201 @@ func NewServer(indexer *indexer.Indexer, logger logrus.FieldLogger) *Server {
 	)
 	mux.Handle(monitoringPath, monitoringHandler)
 
-	// Add CORS for frontend access (Vite dev server)
-	corsHandler := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:5173"},
-		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders:   []string{"*"},
-		AllowCredentials: true,
-	}).Handler(mux)
+	// Create Search service
+	searchService := search.NewSearchService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register Search service Connect RPC handler
+	searchPath, searchHandler := apiv1connect.NewSearchServiceHandler(
+		searchService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(searchPath, searchHandler)
+
+	// Create Proposer service
+	proposerService := proposer.NewProposerService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register Proposer service Connect RPC handler
+	proposerPath, proposerHandler := apiv1connect.NewProposerServiceHandler(
+		proposerService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(proposerPath, proposerHandler)
+
+	// Create Network service
+	networkService := network.NewNetworkService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register Network service Connect RPC handler
+	networkPath, networkHandler := apiv1connect.NewNetworkServiceHandler(
+		networkService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(networkPath, networkHandler)
+
+	// Create ForkChoice service
+	forkChoiceService := forkchoice.NewForkChoiceService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register ForkChoice service Connect RPC handler
+	forkChoicePath, forkChoiceHandler := apiv1connect.NewForkChoiceServiceHandler(
+		forkChoiceService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(forkChoicePath, forkChoiceHandler)
+
+	// Create ChainQuery service
+	chainQueryService := chainquery.NewChainQueryService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register ChainQuery service Connect RPC handler
+	chainQueryPath, chainQueryHandler := apiv1connect.NewChainQueryServiceHandler(
+		chainQueryService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(chainQueryPath, chainQueryHandler)
+
+	// Create Proof service
+	proofService := proof.NewProofService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register Proof service Connect RPC handler
+	proofPath, proofHandler := apiv1connect.NewProofServiceHandler(
+		proofService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(proofPath, proofHandler)
+
+	// Create Equivocation service
+	equivocationService := equivocation.NewEquivocationService(indexer, logger.(*logrus.Entry).Logger)
 
-	httpServer := &http.Server{
-		Addr:         ":" + defaultPort,
+	// Register Equivocation service Connect RPC handler
+	equivocationPath, equivocationHandler := apiv1connect.NewEquivocationServiceHandler(
+		equivocationService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(equivocationPath, equivocationHandler)
+
+	// Create Justification service
+	justificationService := justification.NewJustificationService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register Justification service Connect RPC handler
+	justificationPath, justificationHandler := apiv1connect.NewJustificationServiceHandler(
+		justificationService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(justificationPath, justificationHandler)
+
+	// Create State service
+	stateService := state.NewStateService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register State service Connect RPC handler
+	statePath, stateHandler := apiv1connect.NewStateServiceHandler(
+		stateService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(statePath, stateHandler)
+
+	// Create Signature service
+	signatureService := signature.NewSignatureService(indexer, logger.(*logrus.Entry).Logger)
+
+	// Register Signature service Connect RPC handler
+	signaturePath, signatureHandler := apiv1connect.NewSignatureServiceHandler(
+		signatureService,
+		connect.WithInterceptors(
+			newLoggingInterceptor(logger),
+		),
+	)
+	mux.Handle(signaturePath, signatureHandler)
+
+	// Register Admin service only when a token protects it
+	if config.Server.AdminToken != "" {
+		adminService := admin.NewAdminService(indexer, logger.(*logrus.Entry).Logger)
+
+		adminPath, adminHandler := apiv1connect.NewAdminServiceHandler(
+			adminService,
+			connect.WithInterceptors(
+				newLoggingInterceptor(logger),
+				newBearerAuthInterceptor(config.Server.AdminToken),
+			),
+		)
+		mux.Handle(adminPath, adminHandler)
+	} else {
+		logger.Info("Admin service disabled, set server.adminToken to enable it")
+	}
+
+	// Add CORS for frontend access, origins are looked up per request so they can be reloaded
+	corsHandler := server.corsHandler(mux)
+
+	server.httpServer = &http.Server{
+		Addr:         net.JoinHostPort(config.Server.Host, strconv.Itoa(config.Server.Port)),
 		Handler:      corsHandler,
-		ReadTimeout:  readTimeout,
-		WriteTimeout: writeTimeout,
-		IdleTimeout:  idleTimeout,
+		ReadTimeout:  config.Server.ReadTimeout,
+		WriteTimeout: config.Server.WriteTimeout,
+		IdleTimeout:  config.Server.IdleTimeout,
 	}
 
-	return &Server{
-		httpServer: httpServer,
-		indexer:    indexer,
-		logger:     logger,
+	return server
+}
+
+// SetCorsOrigins replaces the allowed CORS origins without restarting the server
+func (s *Server) SetCorsOrigins(origins []string) {
+	origins = slices.Clone(origins)
+	s.corsOrigins.Store(&origins)
+}
+
+// corsHandler allows credentialed requests from the listed origins. With "*" any other origin
+// is allowed without credentials, so no site can make requests with the user's cookies or auth.
+func (s *Server) corsHandler(next http.Handler) http.Handler {
+	options := cors.Options{
+		AllowedMethods: []string{"GET", "POST", "OPTIONS"},
+		AllowedHeaders: []string{"*"},
 	}
+
+	listedOptions := options
+	listedOptions.AllowOriginFunc = s.isListedOrigin
+	listedOptions.AllowCredentials = true
+	listed := cors.New(listedOptions).Handler(next)
+
+	anyOptions := options
+	anyOptions.AllowedOrigins = []string{"*"}
+	anyOrigin := cors.New(anyOptions).Handler(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.allowsAnyOrigin() && !s.isListedOrigin(r.Header.Get("Origin")) {
+			anyOrigin.ServeHTTP(w, r)
+			return
+		}
+		listed.ServeHTTP(w, r)
+	})
+}
+
+// isListedOrigin reports whether the origin is listed in the allowed CORS origins
+func (s *Server) isListedOrigin(origin string) bool {
+	return origin != "*" && slices.Contains(*s.corsOrigins.Load(), origin)
+}
+
+// allowsAnyOrigin reports whether the allowed CORS origins include "*"
+func (s *Server) allowsAnyOrigin() bool {
+	return slices.Contains(*s.corsOrigins.Load(), "*")
+}
+
+// Handler returns the handler serving the API and probes, with CORS applied
+func (s *Server) Handler() http.Handler {
+	return s.httpServer.Handler
 }
 
 // Start begins serving HTTP requests
@@ -115,7 +314,7 @@ func (s *Server) shutdown() error {
 	s.logger.Infof("Shutting down server gracefully...")
 
 	// Create shutdown context with timeout
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
 	defer cancel()
 
 	// Shutdown server
@@ -157,7 +356,7 @@ func rootHandler(logger logrus.FieldLogger) http.HandlerFunc {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
-		response := `{"service":"PQ Devnet Visualizer","version":"0.1.0","endpoints":["/health","/api.v1.BlockService/GetLatestBlockHeader","/api.v1.MonitoringService/GetAllClientsHeads"]}`
+		response := `{"service":"PQ Devnet Visualizer","version":"0.1.0","endpoints":["/health","/livez","/readyz","/api.v1.BlockService/GetLatestBlockHeader","/api.v1.MonitoringService/GetAllClientsHeads","/api.v1.SearchService/Search","/api.v1.ProposerService/GetProposerLeaderboard","/api.v1.NetworkService/GetNetworkSummary","/api.v1.ForkChoiceService/GetForkChoiceTree","/api.v1.ChainQueryService/GetBranch","/api.v1.ProofService/GetHeaderProof","/api.v1.EquivocationService/GetEquivocations","/api.v1.JustificationService/GetJustificationProgress","/api.v1.StateService/GetState","/api.v1.SignatureService/GetSizeTrend","/api.v1.AdminService/ListEndpoints"]}`
 		if _, err := w.Write([]byte(response)); err != nil {
 			logger.Errorf("Error writing root response: %v", err)
 		}
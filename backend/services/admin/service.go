@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/types"
+	"github.com/syjn99/leanView/backend/utils"
+)
+
+// AdminService handles API requests for managing lean node endpoints at runtime
+type AdminService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewAdminService creates a new Admin service instance
+func NewAdminService(indexer *indexer.Indexer, logger *logrus.Logger) *AdminService {
+	return &AdminService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "admin_service"),
+	}
+}
+
+// ListEndpoints returns all endpoints in the client pool
+func (s *AdminService) ListEndpoints(
+	ctx context.Context,
+	req *connect.Request[apiv1.ListEndpointsRequest],
+) (*connect.Response[apiv1.ListEndpointsResponse], error) {
+	return connect.NewResponse(&apiv1.ListEndpointsResponse{
+		Endpoints: s.listEndpoints(),
+	}), nil
+}
+
+// AddEndpoint adds a new endpoint to the client pool
+func (s *AdminService) AddEndpoint(
+	ctx context.Context,
+	req *connect.Request[apiv1.AddEndpointRequest],
+) (*connect.Response[apiv1.AddEndpointResponse], error) {
+	msg := req.Msg
+	endpoint := types.EndpointConfig{
+		Name:        strings.TrimSpace(msg.Name),
+		Url:         strings.TrimSpace(msg.Url),
+		Headers:     msg.Headers,
+		HeaderFiles: msg.HeaderFiles,
+		HeaderEnv:   msg.HeaderEnv,
+		Auth: types.EndpointAuthConfig{
+			Username:        msg.Username,
+			PasswordFile:    msg.PasswordFile,
+			PasswordEnv:     msg.PasswordEnv,
+			BearerTokenFile: msg.BearerTokenFile,
+			BearerTokenEnv:  msg.BearerTokenEnv,
+		},
+		TLS: types.EndpointTLSConfig{
+			CAFile:             msg.TlsCaFile,
+			InsecureSkipVerify: msg.TlsInsecureSkipVerify,
+		},
+		Proxy:         strings.TrimSpace(msg.Proxy),
+		Timeout:       time.Duration(msg.TimeoutMs) * time.Millisecond,
+		HealthTimeout: time.Duration(msg.HealthTimeoutMs) * time.Millisecond,
+	}
+
+	// Validate with the secrets loaded, the indexer stores only their references
+	resolved := endpoint
+	if err := utils.ResolveEndpointSecrets(&resolved); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := utils.ValidateEndpoint(resolved); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.indexer.AddEndpoint(endpoint); err != nil {
+		return nil, s.toConnectError(err, "Failed to add endpoint")
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"endpoint": endpoint.Name,
+		"url":      endpoint.RedactedUrl(),
+	}).Info("Endpoint added")
+
+	return connect.NewResponse(&apiv1.AddEndpointResponse{
+		Endpoints: s.listEndpoints(),
+	}), nil
+}
+
+// RemoveEndpoint removes an endpoint from the client pool
+func (s *AdminService) RemoveEndpoint(
+	ctx context.Context,
+	req *connect.Request[apiv1.RemoveEndpointRequest],
+) (*connect.Response[apiv1.RemoveEndpointResponse], error) {
+	if err := s.indexer.RemoveEndpoint(req.Msg.Name); err != nil {
+		return nil, s.toConnectError(err, "Failed to remove endpoint")
+	}
+
+	s.logger.WithField("endpoint", req.Msg.Name).Info("Endpoint removed")
+
+	return connect.NewResponse(&apiv1.RemoveEndpointResponse{
+		Endpoints: s.listEndpoints(),
+	}), nil
+}
+
+// SetPrimaryEndpoint makes an endpoint the preferred client for polling
+func (s *AdminService) SetPrimaryEndpoint(
+	ctx context.Context,
+	req *connect.Request[apiv1.SetPrimaryEndpointRequest],
+) (*connect.Response[apiv1.SetPrimaryEndpointResponse], error) {
+	if err := s.indexer.SetPrimaryEndpoint(req.Msg.Name); err != nil {
+		return nil, s.toConnectError(err, "Failed to set primary endpoint")
+	}
+
+	s.logger.WithField("endpoint", req.Msg.Name).Info("Primary endpoint changed")
+
+	return connect.NewResponse(&apiv1.SetPrimaryEndpointResponse{
+		Endpoints: s.listEndpoints(),
+	}), nil
+}
+
+// listEndpoints converts the clients in the pool to their protobuf representation
+func (s *AdminService) listEndpoints() []*apiv1.Endpoint {
+	clientPool := s.indexer.GetClientPool()
+	primary := clientPool.GetPrimaryClient()
+
+	clients := clientPool.GetAllClients()
+	endpoints := make([]*apiv1.Endpoint, 0, len(clients))
+	for _, client := range clients {
+		config := client.GetConfig()
+		endpoint := &apiv1.Endpoint{
+			Name:          config.Name,
+			Url:           config.RedactedUrl(),
+			IsPrimary:     client == primary,
+			IsHealthy:     client.IsHealthy(),
+			FromConfig:    s.indexer.IsConfiguredEndpoint(config.Name),
+			LastCheckedMs: client.GetLastChecked().UnixMilli(),
+		}
+		if err := client.GetLastError(); err != nil {
+			endpoint.LastError = err.Error()
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints
+}
+
+// toConnectError maps client pool errors to connect error codes
+func (s *AdminService) toConnectError(err error, message string) error {
+	switch {
+	case errors.Is(err, indexer.ErrEndpointExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, indexer.ErrInlineSecret):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, indexer.ErrEndpointNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, indexer.ErrLastEndpoint), errors.Is(err, indexer.ErrReplaying):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	s.logger.WithError(err).Error(message)
+	return connect.NewError(connect.CodeInternal, err)
+}
@@ -73,14 +73,22 @@ func (s *BlockService) GetLatestBlockHeader(
 
 	blockRootHex := "0x" + hex.EncodeToString(blockRoot[:])
 
+	// The head is stored before it is cached, so its client is known unless it was replaced
+	sourceClients, err := db.GetBlockHeaderClients(currentHead.Slot, currentHead.Slot)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get the source client of the head block")
+	}
+
 	s.logger.WithFields(logrus.Fields{
 		"slot":       currentHead.Slot,
 		"block_root": blockRootHex[:8] + "...",
 	}).Debug("Serving latest block header")
 
 	return connect.NewResponse(&apiv1.GetLatestBlockHeaderResponse{
-		BlockHeader: protoHeader,
-		BlockRoot:   blockRootHex,
+		BlockHeader:    protoHeader,
+		BlockRoot:      blockRootHex,
+		ProposerClient: s.indexer.GetValidatorClient(currentHead.ProposerIndex),
+		SourceClient:   sourceClients[currentHead.Slot],
 	}), nil
 }
 
@@ -114,6 +122,16 @@ func (s *BlockService) GetBlockHeaders(
 		totalCount = uint32(len(headers))
 	}
 	
+	// Look up the clients the headers were fetched from
+	var sourceClients map[uint64]string
+	if len(headers) > 0 {
+		first, last := headers[0].Slot, headers[len(headers)-1].Slot
+		sourceClients, err = db.GetBlockHeaderClients(min(first, last), max(first, last))
+		if err != nil {
+			s.logger.WithError(err).Warn("Failed to get block header source clients")
+		}
+	}
+
 	// Convert to protobuf format
 	var protoHeaders []*apiv1.BlockHeaderWithRoot
 	for _, header := range headers {
@@ -132,7 +150,9 @@ func (s *BlockService) GetBlockHeaders(
 				StateRoot:     "0x" + hex.EncodeToString(header.StateRoot),
 				BodyRoot:      "0x" + hex.EncodeToString(header.BodyRoot),
 			},
-			BlockRoot: "0x" + hex.EncodeToString(blockRoot[:]),
+			BlockRoot:      "0x" + hex.EncodeToString(blockRoot[:]),
+			ProposerClient: s.indexer.GetValidatorClient(header.ProposerIndex),
+			SourceClient:   sourceClients[header.Slot],
 		}
 		protoHeaders = append(protoHeaders, protoHeader)
 	}
@@ -0,0 +1,178 @@
+package chainquery
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// ChainQueryService handles ancestry queries over the block tree and the indexed chain
+type ChainQueryService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewChainQueryService creates a new ChainQuery service instance
+func NewChainQueryService(indexer *indexer.Indexer, logger *logrus.Logger) *ChainQueryService {
+	return &ChainQueryService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "chainquery_service"),
+	}
+}
+
+// IsAncestor reports whether the ancestor block is the descendant block or one of its ancestors
+func (s *ChainQueryService) IsAncestor(
+	ctx context.Context,
+	req *connect.Request[apiv1.IsAncestorRequest],
+) (*connect.Response[apiv1.IsAncestorResponse], error) {
+	ancestorRoot, err := convert.ParseRoot(req.Msg.AncestorRoot)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	descendantRoot, err := convert.ParseRoot(req.Msg.DescendantRoot)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	isAncestor, err := s.indexer.GetChainQuery().IsAncestor(ancestorRoot, descendantRoot)
+	if err != nil {
+		return nil, s.toConnectError(err, "Failed to check ancestry")
+	}
+
+	return connect.NewResponse(&apiv1.IsAncestorResponse{
+		IsAncestor: isAncestor,
+	}), nil
+}
+
+// GetCommonAncestor returns the latest block both blocks descend from
+func (s *ChainQueryService) GetCommonAncestor(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetCommonAncestorRequest],
+) (*connect.Response[apiv1.GetCommonAncestorResponse], error) {
+	rootA, err := convert.ParseRoot(req.Msg.RootA)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	rootB, err := convert.ParseRoot(req.Msg.RootB)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	chainQuery := s.indexer.GetChainQuery()
+	ancestor, err := chainQuery.CommonAncestor(rootA, rootB)
+	if err != nil {
+		return nil, s.toConnectError(err, "Failed to find common ancestor")
+	}
+
+	blockA, err := chainQuery.Block(rootA)
+	if err != nil {
+		return nil, s.toConnectError(err, "Failed to look up block")
+	}
+	blockB, err := chainQuery.Block(rootB)
+	if err != nil {
+		return nil, s.toConnectError(err, "Failed to look up block")
+	}
+
+	block, err := s.convertBlock(ancestor)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&apiv1.GetCommonAncestorResponse{
+		Block:     block,
+		DistanceA: blockA.Slot - ancestor.Slot,
+		DistanceB: blockB.Slot - ancestor.Slot,
+	}), nil
+}
+
+// GetAncestorAtSlot returns the block at the slot on the chain of the head block
+func (s *ChainQueryService) GetAncestorAtSlot(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetAncestorAtSlotRequest],
+) (*connect.Response[apiv1.GetAncestorAtSlotResponse], error) {
+	headRoot, err := convert.ParseRoot(req.Msg.HeadRoot)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	ancestor, err := s.indexer.GetChainQuery().AncestorAtSlot(headRoot, req.Msg.Slot)
+	if err != nil {
+		return nil, s.toConnectError(err, "Failed to find ancestor at slot")
+	}
+
+	block, err := s.convertBlock(ancestor)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&apiv1.GetAncestorAtSlotResponse{
+		Block:       block,
+		IsEmptySlot: ancestor.Slot != req.Msg.Slot,
+	}), nil
+}
+
+// GetBranch returns the blocks of a block's branch from the indexed chain up to its tip
+func (s *ChainQueryService) GetBranch(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetBranchRequest],
+) (*connect.Response[apiv1.GetBranchResponse], error) {
+	root, err := convert.ParseRoot(req.Msg.Root)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	branch, err := s.indexer.GetChainQuery().BranchOf(root)
+	if err != nil {
+		return nil, s.toConnectError(err, "Failed to find branch")
+	}
+
+	response := &apiv1.GetBranchResponse{
+		IsCanonical: branch.Canonical,
+	}
+	if branch.Base != nil {
+		if response.Base, err = s.convertBlock(branch.Base); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	for _, header := range branch.Blocks {
+		block, err := s.convertBlock(header)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		response.Blocks = append(response.Blocks, block)
+	}
+	if tip := branch.Tip(); tip != nil {
+		if response.Tip, err = s.convertBlock(tip); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"root":   req.Msg.Root,
+		"blocks": len(response.Blocks),
+	}).Debug("Serving branch")
+
+	return connect.NewResponse(response), nil
+}
+
+// convertBlock converts a block header to protobuf with its root and proposer client
+func (s *ChainQueryService) convertBlock(header *types.BlockHeader) (*apiv1.BlockHeaderWithRoot, error) {
+	return convert.BlockHeaderWithRoot(header, s.indexer.GetValidatorClient(header.ProposerIndex))
+}
+
+// toConnectError maps chain query errors to connect error codes
+func (s *ChainQueryService) toConnectError(err error, message string) error {
+	if errors.Is(err, indexer.ErrUnknownBlock) || errors.Is(err, indexer.ErrUnknownAncestry) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	s.logger.WithError(err).Error(message)
+	return connect.NewError(connect.CodeInternal, err)
+}
@@ -0,0 +1,53 @@
+package convert
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// HexRoot encodes a root as a hex string with 0x prefix
+func HexRoot(root []byte) string {
+	return "0x" + hex.EncodeToString(root)
+}
+
+// ParseRoot decodes a 32-byte root from a hex string with 0x prefix
+func ParseRoot(value string) ([]byte, error) {
+	if len(value) != 2+64 || (!strings.HasPrefix(value, "0x") && !strings.HasPrefix(value, "0X")) {
+		return nil, fmt.Errorf("root %q must be 32 bytes hex encoded with 0x prefix", value)
+	}
+	root, err := hex.DecodeString(value[2:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid root %q: %w", value, err)
+	}
+	return root, nil
+}
+
+// BlockHeader converts a block header to its protobuf representation
+func BlockHeader(header *types.BlockHeader) *apiv1.BlockHeader {
+	return &apiv1.BlockHeader{
+		Slot:          header.Slot,
+		ProposerIndex: header.ProposerIndex,
+		ParentRoot:    HexRoot(header.ParentRoot),
+		StateRoot:     HexRoot(header.StateRoot),
+		BodyRoot:      HexRoot(header.BodyRoot),
+	}
+}
+
+// BlockHeaderWithRoot converts a block header to protobuf along with its computed block root
+// and the name of the client running its proposer
+func BlockHeaderWithRoot(header *types.BlockHeader, proposerClient string) (*apiv1.BlockHeaderWithRoot, error) {
+	blockRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate block root for slot %d: %w", header.Slot, err)
+	}
+
+	return &apiv1.BlockHeaderWithRoot{
+		Header:         BlockHeader(header),
+		BlockRoot:      HexRoot(blockRoot[:]),
+		ProposerClient: proposerClient,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package equivocation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// kinds are the equivocation kinds a request may filter by
+var kinds = []string{
+	types.EquivocationDoubleProposal,
+	types.EquivocationDoubleVote,
+	types.EquivocationSurroundVote,
+}
+
+// EquivocationService handles API requests for detected equivocations
+type EquivocationService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewEquivocationService creates a new Equivocation service instance
+func NewEquivocationService(indexer *indexer.Indexer, logger *logrus.Logger) *EquivocationService {
+	return &EquivocationService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "equivocation_service"),
+	}
+}
+
+// GetEquivocations returns the stored equivocations with the evidence of both messages
+func (s *EquivocationService) GetEquivocations(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetEquivocationsRequest],
+) (*connect.Response[apiv1.GetEquivocationsResponse], error) {
+	if req.Msg.Kind != "" && !slices.Contains(kinds, req.Msg.Kind) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown kind %q, expected one of %v", req.Msg.Kind, kinds))
+	}
+
+	limit := req.Msg.Limit
+	if limit == 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	equivocations, err := db.GetEquivocations(req.Msg.Kind, int(limit))
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to load equivocations")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response := &apiv1.GetEquivocationsResponse{
+		Equivocations: make([]*apiv1.Equivocation, 0, len(equivocations)),
+	}
+	for _, equivocation := range equivocations {
+		protoEquivocation := &apiv1.Equivocation{
+			Kind:            equivocation.Kind,
+			ValidatorId:     equivocation.ValidatorId,
+			ValidatorClient: s.indexer.GetValidatorClient(equivocation.ValidatorId),
+			Slot:            equivocation.Slot,
+			DetectedAt:      equivocation.DetectedAt,
+		}
+		if protoEquivocation.A, err = s.convertEvidence(equivocation.Kind, equivocation.RootA, equivocation.ClientA, equivocation.EvidenceA); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if protoEquivocation.B, err = s.convertEvidence(equivocation.Kind, equivocation.RootB, equivocation.ClientB, equivocation.EvidenceB); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		response.Equivocations = append(response.Equivocations, protoEquivocation)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// convertEvidence decodes the SSZ encoded header or vote of an equivocation
+func (s *EquivocationService) convertEvidence(kind string, root []byte, client string, evidence []byte) (*apiv1.EquivocationEvidence, error) {
+	protoEvidence := &apiv1.EquivocationEvidence{
+		Root:   convert.HexRoot(root),
+		Client: client,
+	}
+
+	if kind == types.EquivocationDoubleProposal {
+		header := &types.BlockHeader{}
+		if err := header.UnmarshalSSZ(evidence); err != nil {
+			return nil, fmt.Errorf("failed to decode header evidence: %w", err)
+		}
+		block, err := convert.BlockHeaderWithRoot(header, s.indexer.GetValidatorClient(header.ProposerIndex))
+		if err != nil {
+			return nil, err
+		}
+		protoEvidence.Block = block
+		return protoEvidence, nil
+	}
+
+	vote := &types.Vote{}
+	if err := vote.UnmarshalSSZ(evidence); err != nil {
+		return nil, fmt.Errorf("failed to decode vote evidence: %w", err)
+	}
+	protoEvidence.Vote = &apiv1.Vote{
+		ValidatorId: vote.ValidatorId,
+		Slot:        vote.Slot,
+		HeadRoot:    convert.HexRoot(vote.Head.Root),
+		HeadSlot:    vote.Head.Slot,
+		TargetRoot:  convert.HexRoot(vote.Target.Root),
+		TargetSlot:  vote.Target.Slot,
+		SourceRoot:  convert.HexRoot(vote.Source.Root),
+		SourceSlot:  vote.Source.Slot,
+	}
+	return protoEvidence, nil
+}
@@ -0,0 +1,145 @@
+package forkchoice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+)
+
+// ForkChoiceService handles API requests for the fork choice tree
+type ForkChoiceService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewForkChoiceService creates a new ForkChoice service instance
+func NewForkChoiceService(indexer *indexer.Indexer, logger *logrus.Logger) *ForkChoiceService {
+	return &ForkChoiceService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "forkchoice_service"),
+	}
+}
+
+// GetForkChoiceTree returns the block tree with LMD-GHOST weights, the indexed chain and client heads overlaid
+func (s *ForkChoiceService) GetForkChoiceTree(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetForkChoiceTreeRequest],
+) (*connect.Response[apiv1.GetForkChoiceTreeResponse], error) {
+	headCache := s.indexer.GetHeadCache()
+	tree := headCache.GetForkChoiceTree()
+
+	response := &apiv1.GetForkChoiceTreeResponse{
+		LatestVotes: uint32(tree.LatestVotes),
+	}
+
+	indexedRoots, err := s.loadIndexedRoots(tree)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to load indexed blocks")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	justified := headCache.GetJustifiedCheckpoint()
+	finalized := headCache.GetFinalizedCheckpoint()
+
+	nodes := make(map[string]*apiv1.ForkChoiceNode, len(tree.Nodes))
+	for _, node := range tree.Nodes {
+		protoNode := &apiv1.ForkChoiceNode{
+			Root:           convert.HexRoot(node.Root),
+			ParentRoot:     convert.HexRoot(node.Header.ParentRoot),
+			Slot:           node.Header.Slot,
+			ProposerIndex:  node.Header.ProposerIndex,
+			ProposerClient: s.indexer.GetValidatorClient(node.Header.ProposerIndex),
+			Weight:         node.Weight,
+			IsHead:         bytes.Equal(node.Root, tree.Head),
+			IsIndexed:      bytes.Equal(indexedRoots[node.Header.Slot], node.Root),
+		}
+		if justified != nil {
+			protoNode.IsJustified = bytes.Equal(justified.Root, node.Root)
+		}
+		if finalized != nil {
+			protoNode.IsFinalized = bytes.Equal(finalized.Root, node.Root)
+		}
+
+		nodes[protoNode.Root] = protoNode
+		response.Nodes = append(response.Nodes, protoNode)
+
+		if protoNode.IsHead {
+			response.HeadRoot = protoNode.Root
+			response.HeadSlot = protoNode.Slot
+		}
+		if bytes.Equal(node.Root, tree.Anchor) {
+			response.StartRoot = protoNode.Root
+			response.StartSlot = protoNode.Slot
+		}
+	}
+
+	if indexedHead := headCache.GetCurrentHead(); indexedHead != nil {
+		root, err := indexedHead.HashTreeRoot()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to compute head root: %w", err))
+		}
+		response.IndexedHeadRoot = convert.HexRoot(root[:])
+		response.IndexedHeadSlot = indexedHead.Slot
+	}
+
+	// Overlay the head each client reported on its last health check
+	for _, client := range s.indexer.GetClientPool().GetAllClients() {
+		head, _ := client.GetHead()
+		if head == nil {
+			continue
+		}
+		root, err := head.HashTreeRoot()
+		if err != nil {
+			continue
+		}
+
+		name := client.GetConfig().Name
+		clientHead := &apiv1.ForkChoiceClientHead{
+			Client:      name,
+			Root:        convert.HexRoot(root[:]),
+			Slot:        head.Slot,
+			MatchesHead: bytes.Equal(root[:], tree.Head),
+		}
+		if node, ok := nodes[clientHead.Root]; ok {
+			clientHead.InTree = true
+			node.ClientHeads = append(node.ClientHeads, name)
+		}
+		response.ClientHeads = append(response.ClientHeads, clientHead)
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"nodes":     len(response.Nodes),
+		"head_slot": response.HeadSlot,
+	}).Debug("Serving fork choice tree")
+
+	return connect.NewResponse(response), nil
+}
+
+// loadIndexedRoots returns the root of the indexed block at every slot the tree spans
+func (s *ForkChoiceService) loadIndexedRoots(tree *indexer.ForkChoiceTree) (map[uint64][]byte, error) {
+	roots := make(map[uint64][]byte)
+	if len(tree.Nodes) == 0 {
+		return roots, nil
+	}
+
+	headers, err := db.GetBlockHeadersInRange(tree.Nodes[0].Header.Slot, tree.Nodes[len(tree.Nodes)-1].Header.Slot)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range headers {
+		root, err := header.HashTreeRoot()
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute root of block at slot %d: %w", header.Slot, err)
+		}
+		roots[header.Slot] = root[:]
+	}
+	return roots, nil
+}
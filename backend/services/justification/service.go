@@ -0,0 +1,77 @@
+package justification
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+)
+
+// JustificationService handles API requests for justification progress
+type JustificationService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewJustificationService creates a new Justification service instance
+func NewJustificationService(indexer *indexer.Indexer, logger *logrus.Logger) *JustificationService {
+	return &JustificationService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "justification_service"),
+	}
+}
+
+// GetJustificationProgress returns the votes of each candidate target slot after the
+// finalized slot, taken from the state of the last verified block. Without state
+// verification it is taken from the latest stored state snapshot.
+func (s *JustificationService) GetJustificationProgress(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetJustificationProgressRequest],
+) (*connect.Response[apiv1.GetJustificationProgressResponse], error) {
+	var progress *indexer.JustificationProgress
+	var err error
+	if stateVerifier := s.indexer.GetStateVerifier(); stateVerifier != nil {
+		progress, err = stateVerifier.GetJustificationProgress()
+	} else {
+		progress, err = s.indexer.GetStateSnapshotter().GetJustificationProgress()
+	}
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to compute justification progress")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if progress == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("justification progress needs indexer.verifyStateTransition or a stored state snapshot"),
+		)
+	}
+
+	response := &apiv1.GetJustificationProgressResponse{
+		StateSlot:     progress.StateSlot,
+		StateClient:   progress.Client,
+		NumValidators: progress.NumValidators,
+		Threshold:     progress.Threshold,
+		JustifiedSlot: progress.Justified.Slot,
+		JustifiedRoot: convert.HexRoot(progress.Justified.Root),
+		FinalizedSlot: progress.Finalized.Slot,
+		FinalizedRoot: convert.HexRoot(progress.Finalized.Root),
+		Targets:       make([]*apiv1.JustificationTarget, 0, len(progress.Targets)),
+	}
+	for _, target := range progress.Targets {
+		response.Targets = append(response.Targets, &apiv1.JustificationTarget{
+			Slot:            target.Slot,
+			Root:            convert.HexRoot(target.Root),
+			Votes:           target.Votes,
+			Justifiable:     target.Justifiable,
+			Justified:       target.Justified,
+			JustifiedAtSlot: target.JustifiedAt,
+		})
+	}
+
+	return connect.NewResponse(response), nil
+}
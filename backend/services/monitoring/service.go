@@ -12,6 +12,22 @@ import (
 	"github.com/syjn99/leanView/backend/indexer"
 )
 
+// breakerStates maps circuit breaker states to their protobuf representation
+var breakerStates = map[string]apiv1.ClientHead_BreakerState{
+	indexer.BreakerClosed:   apiv1.ClientHead_CLOSED,
+	indexer.BreakerOpen:     apiv1.ClientHead_OPEN,
+	indexer.BreakerHalfOpen: apiv1.ClientHead_HALF_OPEN,
+}
+
+// clientStatuses maps client statuses to their protobuf representation
+var clientStatuses = map[string]apiv1.ClientHead_Status{
+	indexer.StatusHealthy:     apiv1.ClientHead_HEALTHY,
+	indexer.StatusSyncing:     apiv1.ClientHead_SYNCING,
+	indexer.StatusStalled:     apiv1.ClientHead_STALLED,
+	indexer.StatusForked:      apiv1.ClientHead_FORKED,
+	indexer.StatusUnreachable: apiv1.ClientHead_UNREACHABLE,
+}
+
 // MonitoringService handles monitoring API requests for all clients
 type MonitoringService struct {
 	indexer *indexer.Indexer
@@ -49,26 +65,28 @@ func (s *MonitoringService) GetAllClientsHeads(
 	// Fetch head from each client
 	for _, client := range clients {
 		config := client.GetConfig()
-		isHealthy := client.IsHealthy()
+		status, statusReason := client.GetStatus()
 		lastChecked := client.GetLastChecked()
 
 		clientHead := &apiv1.ClientHead{
 			ClientLabel:  config.Name,
-			EndpointUrl:  config.Url,
-			IsHealthy:    isHealthy,
+			EndpointUrl:  config.RedactedUrl(),
+			IsHealthy:    status == indexer.StatusHealthy,
 			LastUpdateMs: lastChecked.UnixMilli(),
+			Status:       clientStatuses[status],
+			StatusReason: statusReason,
 		}
 
-		if isHealthy {
-			healthyCount++
-
+		// Syncing, stalled and forked clients still report a head worth showing
+		if status != indexer.StatusUnreachable {
 			// Try to fetch the latest block from this client
 			block, err := client.GetLatestBlock(ctx)
 			if err != nil {
 				s.logger.WithError(err).WithField("client", config.Name).Warn("Failed to fetch head from client")
-				// Mark as unhealthy if we can't fetch the block
+				// Mark as unreachable if we can't fetch the block
 				clientHead.IsHealthy = false
-				healthyCount--
+				clientHead.Status = apiv1.ClientHead_UNREACHABLE
+				clientHead.StatusReason = err.Error()
 			} else {
 				// Calculate block root
 				blockRoot, err := block.HashTreeRoot()
@@ -86,9 +104,28 @@ func (s *MonitoringService) GetAllClientsHeads(
 					StateRoot:     "0x" + hex.EncodeToString(block.StateRoot),
 					BodyRoot:      "0x" + hex.EncodeToString(block.BodyRoot),
 				}
+				clientHead.HeadProposerClient = s.indexer.GetValidatorClient(block.ProposerIndex)
 			}
 		}
 
+		clientHead.LatencyMs = float64(client.GetLatency().Microseconds()) / 1000
+		clientHead.Encoding = client.GetEncoding()
+		clientHead.RequestCount, clientHead.RequestFailures = client.GetRequestStats()
+
+		breaker := client.GetBreakerStatus()
+		clientHead.BreakerState = breakerStates[breaker.State]
+		clientHead.ConsecutiveFailures = uint32(breaker.ConsecutiveFailures)
+		clientHead.LastFailure = breaker.LastFailure
+		if !breaker.LastFailureTime.IsZero() {
+			clientHead.LastFailureMs = breaker.LastFailureTime.UnixMilli()
+		}
+		if !breaker.NextProbe.IsZero() {
+			clientHead.NextProbeMs = breaker.NextProbe.UnixMilli()
+		}
+
+		if clientHead.IsHealthy {
+			healthyCount++
+		}
 		clientHeads = append(clientHeads, clientHead)
 	}
 
@@ -0,0 +1,234 @@
+package network
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+)
+
+const (
+	defaultMissedSlotWindow = 64
+	maxMissedSlotWindow     = 1024
+
+	// summaryCacheTTL keeps repeated dashboard polls from hitting every client
+	summaryCacheTTL = 2 * time.Second
+
+	// clientHeadTimeout bounds the per-client head request used for the head spread
+	clientHeadTimeout = 3 * time.Second
+)
+
+// cachedSummary is a computed summary with its creation time
+type cachedSummary struct {
+	summary   *apiv1.NetworkSummary
+	createdAt time.Time
+}
+
+// NetworkService handles API requests for the devnet wide summary
+type NetworkService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+
+	// Summaries keyed by missed slot window, the mutex only guards the map
+	cache      map[uint64]*cachedSummary
+	cacheMutex sync.Mutex
+
+	// Shares a summary being built among concurrent requests for the same window
+	builds singleflight.Group
+}
+
+// NewNetworkService creates a new Network service instance
+func NewNetworkService(indexer *indexer.Indexer, logger *logrus.Logger) *NetworkService {
+	return &NetworkService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "network_service"),
+		cache:   make(map[uint64]*cachedSummary),
+	}
+}
+
+// GetNetworkSummary returns chain progress, finality and client health in a single call
+func (s *NetworkService) GetNetworkSummary(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetNetworkSummaryRequest],
+) (*connect.Response[apiv1.GetNetworkSummaryResponse], error) {
+	window := req.Msg.MissedSlotWindow
+	if window == 0 {
+		window = defaultMissedSlotWindow
+	} else if window > maxMissedSlotWindow {
+		window = maxMissedSlotWindow
+	}
+
+	s.cacheMutex.Lock()
+	cached, ok := s.cache[window]
+	s.cacheMutex.Unlock()
+	if ok && time.Since(cached.createdAt) < summaryCacheTTL {
+		return connect.NewResponse(&apiv1.GetNetworkSummaryResponse{
+			Summary: cached.summary,
+		}), nil
+	}
+
+	// Built outside the cache lock, fetching client heads can take up to clientHeadTimeout.
+	// The build is detached from the request, so a cancelled request does not fail the others sharing it.
+	result, err, _ := s.builds.Do(strconv.FormatUint(window, 10), func() (any, error) {
+		summary, err := s.buildSummary(context.WithoutCancel(ctx), window)
+		if err != nil {
+			return nil, err
+		}
+
+		s.cacheMutex.Lock()
+		s.cache[window] = &cachedSummary{
+			summary:   summary,
+			createdAt: time.Now(),
+		}
+		s.cacheMutex.Unlock()
+		return summary, nil
+	})
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to build network summary")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	summary := result.(*apiv1.NetworkSummary)
+
+	s.logger.WithFields(logrus.Fields{
+		"head_slot":      summary.HeadSlot,
+		"finalized_slot": summary.FinalizedSlot,
+		"missed_slots":   summary.MissedSlots,
+	}).Debug("Serving network summary")
+
+	return connect.NewResponse(&apiv1.GetNetworkSummaryResponse{
+		Summary: summary,
+	}), nil
+}
+
+// buildSummary computes a fresh network summary from the head cache, client pool and database
+func (s *NetworkService) buildSummary(ctx context.Context, window uint64) (*apiv1.NetworkSummary, error) {
+	now := s.indexer.GetClock().Now()
+	headCache := s.indexer.GetHeadCache()
+	clientPool := s.indexer.GetClientPool()
+
+	summary := &apiv1.NetworkSummary{
+		ReorgCount:          headCache.GetReorgCount(),
+		UnlinkedHeadChanges: headCache.GetUnlinkedHeadChanges(),
+		HealthyClients:      int32(clientPool.GetHealthyClientCount()),
+		TotalClients:        int32(clientPool.GetClientCount()),
+		OpenCircuits:        int32(clientPool.GetOpenCircuitCount()),
+		GeneratedAtMs:       now.UnixMilli(),
+		HeadClient:          s.indexer.GetPoller().GetLastPollClient(),
+	}
+
+	if head := headCache.GetCurrentHead(); head != nil {
+		summary.HeadSlot = head.Slot
+	}
+	if justified := headCache.GetJustifiedCheckpoint(); justified != nil {
+		summary.JustifiedSlot = justified.Slot
+	}
+	if finalized := headCache.GetFinalizedCheckpoint(); finalized != nil {
+		summary.FinalizedSlot = finalized.Slot
+	}
+	if summary.HeadSlot > summary.FinalizedSlot {
+		summary.FinalityLagSlots = summary.HeadSlot - summary.FinalizedSlot
+	}
+
+	if wallClockSlot, ok := s.indexer.GetSlotClock().SlotAt(now); ok {
+		summary.WallClockSlot = wallClockSlot
+		summary.HeadLagSlots = int64(wallClockSlot) - int64(summary.HeadSlot)
+	}
+
+	// Missed slots over the window ending at the head, genesis has no block to miss
+	if summary.HeadSlot > 0 {
+		startSlot := uint64(1)
+		if summary.HeadSlot >= window {
+			startSlot = summary.HeadSlot - window + 1
+		}
+
+		headers, err := db.GetBlockHeadersInRange(startSlot, summary.HeadSlot)
+		if err != nil {
+			return nil, err
+		}
+		stored := make(map[uint64]bool, len(headers))
+		for _, header := range headers {
+			stored[header.Slot] = true
+		}
+
+		validatorCount, err := s.indexer.GetValidatorCount()
+		if err != nil {
+			return nil, err
+		}
+
+		summary.MissedSlotWindow = summary.HeadSlot - startSlot + 1
+		for slot := summary.HeadSlot; slot >= startSlot; slot-- {
+			if stored[slot] {
+				continue
+			}
+			missed := &apiv1.MissedSlot{Slot: slot}
+			if validatorCount > 0 {
+				missed.ProposerIndex = slot % validatorCount
+				missed.ProposerKnown = true
+				missed.Client = s.indexer.GetValidatorClient(missed.ProposerIndex)
+			}
+			summary.Missed = append(summary.Missed, missed)
+		}
+		summary.MissedSlots = uint64(len(summary.Missed))
+		summary.MissedSlotRate = float64(summary.MissedSlots) / float64(summary.MissedSlotWindow)
+	}
+
+	if lastWrite := db.GetLastWriteTime(); !lastWrite.IsZero() {
+		summary.LastDbWriteMs = lastWrite.UnixMilli()
+	}
+
+	summary.ClientHeadSpread = s.getClientHeadSpread(ctx)
+
+	return summary, nil
+}
+
+// getClientHeadSpread fetches the head of every reachable client and returns the slot spread
+func (s *NetworkService) getClientHeadSpread(ctx context.Context) uint64 {
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		minSlot  uint64
+		maxSlot  uint64
+		observed bool
+	)
+
+	for _, client := range s.indexer.GetClientPool().GetAllClients() {
+		if !client.IsReachable() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(c *indexer.Client) {
+			defer wg.Done()
+
+			headCtx, cancel := context.WithTimeout(ctx, clientHeadTimeout)
+			defer cancel()
+
+			block, err := c.GetLatestBlock(headCtx)
+			if err != nil {
+				s.logger.WithError(err).WithField("client", c.GetConfig().Name).Debug("Failed to fetch head for spread")
+				return
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if !observed || block.Slot < minSlot {
+				minSlot = block.Slot
+			}
+			if !observed || block.Slot > maxSlot {
+				maxSlot = block.Slot
+			}
+			observed = true
+		}(client)
+	}
+	wg.Wait()
+
+	return maxSlot - minSlot
+}
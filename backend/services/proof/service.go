@@ -0,0 +1,212 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// ProofService handles API requests for Merkle proofs of block header fields
+type ProofService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewProofService creates a new Proof service instance
+func NewProofService(indexer *indexer.Indexer, logger *logrus.Logger) *ProofService {
+	return &ProofService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "proof_service"),
+	}
+}
+
+// GetHeaderProof returns Merkle proofs of the fields of a block header against its root
+func (s *ProofService) GetHeaderProof(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetHeaderProofRequest],
+) (*connect.Response[apiv1.GetHeaderProofResponse], error) {
+	header, err := s.loadHeader(ctx, req.Msg.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs, err := header.ProveFields()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	block, err := convert.BlockHeaderWithRoot(header, s.indexer.GetValidatorClient(header.ProposerIndex))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response := &apiv1.GetHeaderProofResponse{Block: block}
+	for _, proof := range proofs {
+		if req.Msg.Field == "" || req.Msg.Field == proof.Field {
+			response.Proofs = append(response.Proofs, convertProof(proof))
+		}
+	}
+	if len(response.Proofs) == 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unknown field %q, expected one of %v", req.Msg.Field, types.BlockHeaderFields),
+		)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// DiffHeaders compares two block headers and proves every field that differs
+func (s *ProofService) DiffHeaders(
+	ctx context.Context,
+	req *connect.Request[apiv1.DiffHeadersRequest],
+) (*connect.Response[apiv1.DiffHeadersResponse], error) {
+	headerA, err := s.loadHeader(ctx, req.Msg.A)
+	if err != nil {
+		return nil, err
+	}
+	headerB, err := s.loadHeader(ctx, req.Msg.B)
+	if err != nil {
+		return nil, err
+	}
+
+	proofsA, err := headerA.ProveFields()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	proofsB, err := headerB.ProveFields()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	diffs, err := types.DiffFieldProofs(proofsA, proofsB)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	blockA, err := convert.BlockHeaderWithRoot(headerA, s.indexer.GetValidatorClient(headerA.ProposerIndex))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	blockB, err := convert.BlockHeaderWithRoot(headerB, s.indexer.GetValidatorClient(headerB.ProposerIndex))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response := &apiv1.DiffHeadersResponse{
+		BlockA:     blockA,
+		BlockB:     blockB,
+		RootsMatch: blockA.BlockRoot == blockB.BlockRoot,
+	}
+	for _, diff := range diffs {
+		field := diff[0].Field
+		response.Differences = append(response.Differences, &apiv1.FieldDiff{
+			Field:  field,
+			ValueA: fieldValue(headerA, field),
+			ValueB: fieldValue(headerB, field),
+			ProofA: convertProof(diff[0]),
+			ProofB: convertProof(diff[1]),
+		})
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"root_a":      blockA.BlockRoot,
+		"root_b":      blockB.BlockRoot,
+		"differences": len(response.Differences),
+	}).Debug("Serving header diff")
+
+	return connect.NewResponse(response), nil
+}
+
+// loadHeader fetches the header the source selects, from a client or the indexed chain
+func (s *ProofService) loadHeader(ctx context.Context, source *apiv1.HeaderSource) (*types.BlockHeader, error) {
+	if source == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("header source must be set"))
+	}
+
+	var root []byte
+	if source.Root != "" {
+		var err error
+		if root, err = convert.ParseRoot(source.Root); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	var header *types.BlockHeader
+	var err error
+	if source.Client != "" {
+		client := s.indexer.GetClientPool().GetClientByName(source.Client)
+		if client == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("client %q not found", source.Client))
+		}
+		if root != nil {
+			header, err = client.GetBlockByRoot(ctx, root)
+		} else {
+			header, err = client.GetBlockBySlot(ctx, source.Slot)
+		}
+		if errors.Is(err, indexer.ErrBlockNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to fetch header from %s: %w", source.Client, err))
+		}
+	} else {
+		if root != nil {
+			header, err = s.indexer.GetChainQuery().Block(root)
+			if errors.Is(err, indexer.ErrUnknownBlock) {
+				return nil, connect.NewError(connect.CodeNotFound, err)
+			}
+		} else {
+			header, err = db.GetBlockHeaderBySlot(source.Slot)
+		}
+		if err != nil {
+			s.logger.WithError(err).Error("Failed to load block header")
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	if header == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no block indexed at slot %d", source.Slot))
+	}
+	return header, nil
+}
+
+// convertProof converts a field proof to its protobuf representation
+func convertProof(proof *types.FieldProof) *apiv1.FieldProof {
+	branch := make([]string, 0, len(proof.Branch))
+	for _, hash := range proof.Branch {
+		branch = append(branch, convert.HexRoot(hash))
+	}
+	return &apiv1.FieldProof{
+		Field:            proof.Field,
+		GeneralizedIndex: uint64(proof.GeneralizedIndex),
+		Leaf:             convert.HexRoot(proof.Leaf),
+		Branch:           branch,
+	}
+}
+
+// fieldValue formats a block header field for display
+func fieldValue(header *types.BlockHeader, field string) string {
+	switch field {
+	case "slot":
+		return strconv.FormatUint(header.Slot, 10)
+	case "proposer_index":
+		return strconv.FormatUint(header.ProposerIndex, 10)
+	case "parent_root":
+		return convert.HexRoot(header.ParentRoot)
+	case "state_root":
+		return convert.HexRoot(header.StateRoot)
+	case "body_root":
+		return convert.HexRoot(header.BodyRoot)
+	}
+	return ""
+}
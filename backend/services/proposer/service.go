@@ -0,0 +1,189 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+)
+
+const (
+	defaultWindow = 256
+	maxWindow     = 8192
+)
+
+// ProposerService handles API requests for proposer statistics
+type ProposerService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewProposerService creates a new Proposer service instance
+func NewProposerService(indexer *indexer.Indexer, logger *logrus.Logger) *ProposerService {
+	return &ProposerService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "proposer_service"),
+	}
+}
+
+// GetProposerStats returns round robin proposal statistics for a single proposer index
+func (s *ProposerService) GetProposerStats(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetProposerStatsRequest],
+) (*connect.Response[apiv1.GetProposerStatsResponse], error) {
+	window, err := s.loadWindow(req.Msg.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	proposerIndex := req.Msg.ProposerIndex
+	if window.validatorCount > 0 && proposerIndex >= window.validatorCount {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("proposer index %d out of range for %d validators", proposerIndex, window.validatorCount),
+		)
+	}
+
+	entry, ok := window.stats[proposerIndex]
+	if !ok {
+		entry = &apiv1.ProposerStats{
+			ProposerIndex: proposerIndex,
+			Client:        s.indexer.GetValidatorClient(proposerIndex),
+		}
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"proposer_index": proposerIndex,
+		"start_slot":     window.startSlot,
+		"end_slot":       window.endSlot,
+		"missed":         entry.MissedProposals,
+	}).Debug("Serving proposer stats")
+
+	return connect.NewResponse(&apiv1.GetProposerStatsResponse{
+		Stats:          entry,
+		StartSlot:      window.startSlot,
+		EndSlot:        window.endSlot,
+		ValidatorCount: window.validatorCount,
+	}), nil
+}
+
+// GetProposerLeaderboard returns all proposers sorted by miss rate over a slot window
+func (s *ProposerService) GetProposerLeaderboard(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetProposerLeaderboardRequest],
+) (*connect.Response[apiv1.GetProposerLeaderboardResponse], error) {
+	window, err := s.loadWindow(req.Msg.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	proposers := sortByMissRate(window.stats)
+	if limit := int(req.Msg.Limit); limit > 0 && limit < len(proposers) {
+		proposers = proposers[:limit]
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"start_slot": window.startSlot,
+		"end_slot":   window.endSlot,
+		"count":      len(proposers),
+	}).Debug("Serving proposer leaderboard")
+
+	return connect.NewResponse(&apiv1.GetProposerLeaderboardResponse{
+		Proposers:      proposers,
+		StartSlot:      window.startSlot,
+		EndSlot:        window.endSlot,
+		ValidatorCount: window.validatorCount,
+	}), nil
+}
+
+// slotWindow holds proposer statistics computed over a slot range
+type slotWindow struct {
+	startSlot      uint64
+	endSlot        uint64
+	validatorCount uint64
+	stats          map[uint64]*apiv1.ProposerStats
+}
+
+// loadWindow computes proposer statistics for the given number of slots ending at the head
+func (s *ProposerService) loadWindow(size uint64) (*slotWindow, error) {
+	if size == 0 {
+		size = defaultWindow
+	} else if size > maxWindow {
+		size = maxWindow
+	}
+
+	headSlot, err := s.getHeadSlot()
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to determine head slot")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if headSlot == 0 {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.New("no blocks available yet"),
+		)
+	}
+
+	validatorCount, err := s.indexer.GetValidatorCount()
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to determine validator count")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	startSlot := uint64(0)
+	if headSlot >= size {
+		startSlot = headSlot - size + 1
+	}
+
+	// Include the block after the window so the head of the window can be checked for orphaning
+	headers, err := db.GetBlockHeadersInRange(startSlot, headSlot+1)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to fetch block headers for proposer stats")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	stats, err := computeProposerStats(headers, startSlot, headSlot, validatorCount)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to compute proposer stats")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	lastSlots, err := db.GetLastProposedSlots()
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to fetch last proposed slots")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for proposerIndex, entry := range stats {
+		entry.LastProposedSlot = lastSlots[proposerIndex]
+		entry.Client = s.indexer.GetValidatorClient(proposerIndex)
+	}
+
+	return &slotWindow{
+		startSlot:      startSlot,
+		endSlot:        headSlot,
+		validatorCount: validatorCount,
+		stats:          stats,
+	}, nil
+}
+
+// getHeadSlot returns the head slot from the head cache, falling back to the database
+func (s *ProposerService) getHeadSlot() (uint64, error) {
+	if head := s.indexer.GetHeadCache().GetCurrentHead(); head != nil {
+		return head.Slot, nil
+	}
+
+	headers, err := db.GetLatestBlockHeaders(1)
+	if err != nil {
+		return 0, err
+	}
+	if len(headers) == 0 {
+		return 0, nil
+	}
+	return headers[0].Slot, nil
+}
@@ -0,0 +1,108 @@
+package proposer
+
+import (
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// computeProposerStats derives round robin proposal statistics for every validator
+// from the headers stored within [startSlot, endSlot]. A block is orphaned if the latest
+// header, which may follow endSlot, does not descend from it.
+func computeProposerStats(headers []*types.BlockHeader, startSlot, endSlot, validatorCount uint64) (map[uint64]*apiv1.ProposerStats, error) {
+	stats := make(map[uint64]*apiv1.ProposerStats, validatorCount)
+	getStats := func(proposerIndex uint64) *apiv1.ProposerStats {
+		entry, ok := stats[proposerIndex]
+		if !ok {
+			entry = &apiv1.ProposerStats{ProposerIndex: proposerIndex}
+			stats[proposerIndex] = entry
+		}
+		return entry
+	}
+
+	for index := uint64(0); index < validatorCount; index++ {
+		getStats(index)
+	}
+
+	bySlot := make(map[uint64]*types.BlockHeader, len(headers))
+	byRoot := make(map[[32]byte]*types.BlockHeader, len(headers))
+	for _, header := range headers {
+		bySlot[header.Slot] = header
+
+		blockRoot, err := header.HashTreeRoot()
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate block root for slot %d: %w", header.Slot, err)
+		}
+		byRoot[blockRoot] = header
+	}
+
+	// The chain is the ancestry of the latest header, headers are sorted by slot ascending.
+	// Ancestry ends at the first parent that is not stored, e.g. before the window or at an
+	// indexing gap, and blocks below that slot cannot be judged.
+	canonical := make(map[uint64]bool, len(headers))
+	var ancestryStart uint64
+	if len(headers) > 0 {
+		header := headers[len(headers)-1]
+		for header != nil {
+			canonical[header.Slot] = true
+			ancestryStart = header.Slot
+			header = byRoot[[32]byte(header.ParentRoot)]
+		}
+	}
+
+	// Blocks proposed and orphaned
+	for _, header := range headers {
+		if header.Slot < startSlot || header.Slot > endSlot {
+			continue
+		}
+
+		entry := getStats(header.ProposerIndex)
+		entry.BlocksProposed++
+		if header.Slot > ancestryStart && !canonical[header.Slot] {
+			entry.OrphanedProposals++
+		}
+	}
+
+	// Expected and missed proposals under round robin, genesis has no proposal
+	if validatorCount > 0 {
+		for slot := max(startSlot, 1); slot <= endSlot; slot++ {
+			entry := getStats(slot % validatorCount)
+			entry.ExpectedProposals++
+
+			if header, ok := bySlot[slot]; !ok || header.ProposerIndex != entry.ProposerIndex {
+				entry.MissedProposals++
+				entry.MissedSlots = append(entry.MissedSlots, slot)
+			}
+		}
+	}
+
+	for _, entry := range stats {
+		if entry.ExpectedProposals > 0 {
+			entry.MissRate = float64(entry.MissedProposals) / float64(entry.ExpectedProposals)
+		}
+	}
+
+	return stats, nil
+}
+
+// sortByMissRate orders proposer stats by miss rate, then missed proposals, then index
+func sortByMissRate(stats map[uint64]*apiv1.ProposerStats) []*apiv1.ProposerStats {
+	sorted := make([]*apiv1.ProposerStats, 0, len(stats))
+	for _, entry := range stats {
+		sorted = append(sorted, entry)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].MissRate != sorted[j].MissRate {
+			return sorted[i].MissRate > sorted[j].MissRate
+		}
+		if sorted[i].MissedProposals != sorted[j].MissedProposals {
+			return sorted[i].MissedProposals > sorted[j].MissedProposals
+		}
+		return sorted[i].ProposerIndex < sorted[j].ProposerIndex
+	})
+
+	return sorted
+}
@@ -0,0 +1,107 @@
+package proposer
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+func TestComputeProposerStats(t *testing.T) {
+	// block is a block at the slot by its round robin proposer of four validators, building on
+	// the parent or on an unknown block if the parent is nil
+	block := func(slot uint64, parent *types.BlockHeader) *types.BlockHeader {
+		header := &types.BlockHeader{
+			Slot:          slot,
+			ProposerIndex: slot % 4,
+			ParentRoot:    bytes.Repeat([]byte{0xee}, 32),
+			StateRoot:     bytes.Repeat([]byte{byte(slot)}, 32),
+			BodyRoot:      make([]byte, 32),
+		}
+		if parent != nil {
+			root, err := parent.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("hashing block at slot %d: %v", parent.Slot, err)
+			}
+			header.ParentRoot = root[:]
+		}
+		return header
+	}
+
+	// Slots 3, 6 and 9 are missed, and a fork of slots 4 and 5 is orphaned by slot 7
+	b1 := block(1, nil)
+	b2 := block(2, b1)
+	f4 := block(4, b2)
+	f5 := block(5, f4)
+	b7 := block(7, b2)
+	b8 := block(8, b7)
+	b10 := block(10, b8)
+
+	type proposerStats struct {
+		proposed, orphaned uint64
+		missed             []uint64
+	}
+	tests := []struct {
+		name               string
+		headers            []*types.BlockHeader
+		startSlot, endSlot uint64
+		expected           map[uint64]proposerStats
+	}{
+		{
+			name:      "fork and missed slots",
+			headers:   []*types.BlockHeader{b1, b2, f4, f5, b7, b8, b10},
+			startSlot: 1,
+			endSlot:   10,
+			expected: map[uint64]proposerStats{
+				0: {proposed: 2, orphaned: 1},
+				1: {proposed: 2, orphaned: 1, missed: []uint64{9}},
+				2: {proposed: 2, missed: []uint64{6}},
+				3: {proposed: 1, missed: []uint64{3}},
+			},
+		},
+		{
+			// The block after the window is the latest header
+			name:      "block after the window",
+			headers:   []*types.BlockHeader{b2, f4, f5, b7, b8, b10},
+			startSlot: 2,
+			endSlot:   9,
+			expected: map[uint64]proposerStats{
+				0: {proposed: 2, orphaned: 1},
+				1: {proposed: 1, orphaned: 1, missed: []uint64{9}},
+				2: {proposed: 1, missed: []uint64{6}},
+				3: {proposed: 1, missed: []uint64{3}},
+			},
+		},
+		{
+			// Without slot 2 the ancestry of slot 10 ends at slot 7 and the fork cannot be judged
+			name:      "indexing gap",
+			headers:   []*types.BlockHeader{b1, f4, f5, b7, b8, b10},
+			startSlot: 1,
+			endSlot:   10,
+			expected: map[uint64]proposerStats{
+				0: {proposed: 2},
+				1: {proposed: 2, missed: []uint64{9}},
+				2: {proposed: 1, missed: []uint64{2, 6}},
+				3: {proposed: 1, missed: []uint64{3}},
+			},
+		},
+	}
+	for _, test := range tests {
+		stats, err := computeProposerStats(test.headers, test.startSlot, test.endSlot, 4)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(stats) != len(test.expected) {
+			t.Errorf("%s: got stats of %d proposers, want 4", test.name, len(stats))
+		}
+		for index, expected := range test.expected {
+			entry := stats[index]
+			if entry.BlocksProposed != expected.proposed || entry.OrphanedProposals != expected.orphaned ||
+				entry.MissedProposals != uint64(len(expected.missed)) || !slices.Equal(entry.MissedSlots, expected.missed) {
+				t.Errorf("%s: proposer %d proposed %d, orphaned %d and missed %v, want %d, %d and %v", test.name, index,
+					entry.BlocksProposed, entry.OrphanedProposals, entry.MissedSlots, expected.proposed, expected.orphaned, expected.missed)
+			}
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package search
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+const (
+	// rootHexLength is the length of a 0x-prefixed 32-byte root
+	rootHexLength = 2 + 64
+)
+
+// SearchService handles free text search requests
+type SearchService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewSearchService creates a new Search service instance
+func NewSearchService(indexer *indexer.Indexer, logger *logrus.Logger) *SearchService {
+	return &SearchService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "search_service"),
+	}
+}
+
+// Search figures out what the query refers to and returns all typed matches
+func (s *SearchService) Search(
+	ctx context.Context,
+	req *connect.Request[apiv1.SearchRequest],
+) (*connect.Response[apiv1.SearchResponse], error) {
+	query := strings.TrimSpace(req.Msg.Query)
+	if query == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("query must not be empty"),
+		)
+	}
+
+	var results []*apiv1.SearchResult
+
+	switch {
+	case isDecimal(query):
+		number, err := strconv.ParseUint(query, 10, 64)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid number %q: %w", query, err))
+		}
+
+		slotResults, err := s.searchSlot(number)
+		if err != nil {
+			s.logger.WithError(err).WithField("slot", number).Error("Failed to search slot")
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		results = append(results, slotResults...)
+
+		proposerResults, err := s.searchProposer(number)
+		if err != nil {
+			s.logger.WithError(err).WithField("proposer_index", number).Error("Failed to search proposer")
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		results = append(results, proposerResults...)
+
+	case isRootHex(query):
+		query = strings.ToLower(query)
+		root, err := hex.DecodeString(query[2:])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid root %q: %w", query, err))
+		}
+
+		rootResults, err := s.searchRoot(root)
+		if err != nil {
+			s.logger.WithError(err).WithField("root", query).Error("Failed to search root")
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		results = append(results, rootResults...)
+	}
+
+	// Any query may also name a client
+	results = append(results, s.searchClients(query)...)
+
+	s.logger.WithFields(logrus.Fields{
+		"query":   query,
+		"results": len(results),
+	}).Debug("Serving search results")
+
+	return connect.NewResponse(&apiv1.SearchResponse{
+		Query:   query,
+		Results: results,
+	}), nil
+}
+
+// searchSlot looks up the block stored at the given slot
+func (s *SearchService) searchSlot(slot uint64) ([]*apiv1.SearchResult, error) {
+	header, err := db.GetBlockHeaderBySlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, nil
+	}
+
+	block, err := convert.BlockHeaderWithRoot(header, s.indexer.GetValidatorClient(header.ProposerIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	return []*apiv1.SearchResult{{
+		Type:  apiv1.SearchResult_SLOT,
+		Label: fmt.Sprintf("Slot %d", slot),
+		Link:  slotLink(slot),
+		Block: block,
+	}}, nil
+}
+
+// searchProposer looks up the blocks proposed by the given validator index
+func (s *SearchService) searchProposer(proposerIndex uint64) ([]*apiv1.SearchResult, error) {
+	headers, err := db.GetBlockHeadersByProposer(proposerIndex, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	count, err := db.CountBlockHeadersByProposer(proposerIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := convert.BlockHeaderWithRoot(headers[0], s.indexer.GetValidatorClient(proposerIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	return []*apiv1.SearchResult{{
+		Type:          apiv1.SearchResult_PROPOSER,
+		Label:         fmt.Sprintf("Proposer %d (%d blocks)", proposerIndex, count),
+		ClientLabel:   s.indexer.GetValidatorClient(proposerIndex),
+		Link:          fmt.Sprintf("/proposers/%d", proposerIndex),
+		Block:         latest,
+		ProposerIndex: proposerIndex,
+		BlockCount:    count,
+	}}, nil
+}
+
+// searchRoot matches the root against block, parent, state and body roots
+func (s *SearchService) searchRoot(root []byte) ([]*apiv1.SearchResult, error) {
+	var results []*apiv1.SearchResult
+
+	// The block tree covers blocks that are not stored yet, stored blocks are found by their root
+	block := s.indexer.GetHeadCache().GetBlock(root)
+	if block == nil {
+		var err error
+		if block, err = db.GetBlockHeaderByRoot(root); err != nil {
+			return nil, err
+		}
+	}
+	if block != nil {
+		result, err := s.newRootResult(apiv1.SearchResult_BLOCK_ROOT, root, block)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	// A root can match different fields of different headers, each field is searched on its own
+	stateHeaders, err := db.GetBlockHeadersByStateRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range stateHeaders {
+		result, err := s.newRootResult(apiv1.SearchResult_STATE_ROOT, root, header)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	bodyHeaders, err := db.GetBlockHeadersByBodyRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range bodyHeaders {
+		result, err := s.newRootResult(apiv1.SearchResult_BODY_ROOT, root, header)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	children, err := db.GetBlockHeadersByParentRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range children {
+		result, err := s.newRootResult(apiv1.SearchResult_PARENT_ROOT, root, header)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// searchClients matches the query against configured client labels
+func (s *SearchService) searchClients(query string) []*apiv1.SearchResult {
+	needle := strings.ToLower(query)
+
+	var exact, partial []*apiv1.SearchResult
+	for _, client := range s.indexer.GetClientPool().GetAllClients() {
+		config := client.GetConfig()
+		label := strings.ToLower(config.Name)
+		if !strings.Contains(label, needle) {
+			continue
+		}
+
+		result := &apiv1.SearchResult{
+			Type:        apiv1.SearchResult_CLIENT,
+			Label:       fmt.Sprintf("Client %s", config.Name),
+			Link:        fmt.Sprintf("/clients/%s", config.Name),
+			ClientLabel: config.Name,
+			EndpointUrl: config.RedactedUrl(),
+		}
+		if label == needle {
+			exact = append(exact, result)
+		} else {
+			partial = append(partial, result)
+		}
+	}
+
+	return append(exact, partial...)
+}
+
+// newRootResult builds a root search result for the given block
+func (s *SearchService) newRootResult(resultType apiv1.SearchResult_ResultType, root []byte, header *types.BlockHeader) (*apiv1.SearchResult, error) {
+	block, err := convert.BlockHeaderWithRoot(header, s.indexer.GetValidatorClient(header.ProposerIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	var label string
+	switch resultType {
+	case apiv1.SearchResult_BLOCK_ROOT:
+		label = fmt.Sprintf("Block at slot %d", header.Slot)
+	case apiv1.SearchResult_PARENT_ROOT:
+		label = fmt.Sprintf("Child block at slot %d", header.Slot)
+	case apiv1.SearchResult_STATE_ROOT:
+		label = fmt.Sprintf("State root of block at slot %d", header.Slot)
+	case apiv1.SearchResult_BODY_ROOT:
+		label = fmt.Sprintf("Body root of block at slot %d", header.Slot)
+	}
+
+	link := slotLink(header.Slot)
+	if resultType == apiv1.SearchResult_BLOCK_ROOT {
+		link = fmt.Sprintf("/blocks/%s", convert.HexRoot(root))
+	}
+
+	return &apiv1.SearchResult{
+		Type:  resultType,
+		Label: label,
+		Link:  link,
+		Block: block,
+	}, nil
+}
+
+// slotLink returns the UI path for a slot
+func slotLink(slot uint64) string {
+	return fmt.Sprintf("/slots/%d", slot)
+}
+
+// isDecimal reports whether the query consists only of decimal digits
+func isDecimal(query string) bool {
+	for _, r := range query {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isRootHex reports whether the query looks like a 0x-prefixed 32-byte hex root
+func isRootHex(query string) bool {
+	return len(query) == rootHexLength && (strings.HasPrefix(query, "0x") || strings.HasPrefix(query, "0X"))
+}
@@ -0,0 +1,180 @@
+package signature
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// defaultBucketSlots is the slot range of a size trend bucket when the request sets none
+const defaultBucketSlots = 32
+
+// SignatureService handles API requests for block signatures and their sizes
+type SignatureService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewSignatureService creates a new Signature service instance
+func NewSignatureService(indexer *indexer.Indexer, logger *logrus.Logger) *SignatureService {
+	return &SignatureService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "signature_service"),
+	}
+}
+
+// GetBlockSignatures returns the stored signatures of the blocks at a slot or of a block root
+func (s *SignatureService) GetBlockSignatures(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetBlockSignaturesRequest],
+) (*connect.Response[apiv1.GetBlockSignaturesResponse], error) {
+	var signatures []*types.BlockSignature
+	if req.Msg.Root != "" {
+		root, err := convert.ParseRoot(req.Msg.Root)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		signature, err := db.GetBlockSignatureByRoot(root)
+		if err != nil {
+			s.logger.WithError(err).Error("Failed to load block signature")
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if signature != nil {
+			signatures = append(signatures, signature)
+		}
+	} else {
+		var err error
+		signatures, err = db.GetBlockSignaturesBySlot(req.Msg.Slot)
+		if err != nil {
+			s.logger.WithError(err).Error("Failed to load block signatures")
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	response := &apiv1.GetBlockSignaturesResponse{
+		Signatures: make([]*apiv1.BlockSignature, 0, len(signatures)),
+	}
+	for _, signature := range signatures {
+		response.Signatures = append(response.Signatures, &apiv1.BlockSignature{
+			BlockRoot:     convert.HexRoot(signature.BlockRoot),
+			Slot:          signature.Slot,
+			ProposerIndex: signature.ProposerIndex,
+			Fork:          signature.Fork,
+			Signature:     convert.HexRoot(signature.Signature),
+			SignatureSize: signature.SignatureSize,
+			BlockSize:     signature.BlockSize,
+			Votes:         signature.Votes,
+			Client:        signature.Client,
+			ObservedAt:    signature.ObservedAt,
+		})
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// GetSizeTrend returns the signature and block sizes of the stored blocks in buckets of
+// slots, latest first, and per fork
+func (s *SignatureService) GetSizeTrend(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetSizeTrendRequest],
+) (*connect.Response[apiv1.GetSizeTrendResponse], error) {
+	bucketSlots := req.Msg.BucketSlots
+	if bucketSlots == 0 {
+		bucketSlots = defaultBucketSlots
+	}
+	limit := req.Msg.Limit
+	if limit == 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+	if req.Msg.Fork != "" && types.GetForkVersion(req.Msg.Fork) == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown fork %q, known forks are %v", req.Msg.Fork, types.ForkNames()))
+	}
+
+	buckets, err := db.GetSizeBuckets(bucketSlots, req.Msg.Fork, int(limit))
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to load block size buckets")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	forkStats, err := db.GetForkSizeStats()
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to load fork size stats")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slotClock := s.indexer.GetSlotClock()
+	response := &apiv1.GetSizeTrendResponse{
+		Buckets: make([]*apiv1.SizeBucket, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		converted := &apiv1.SizeBucket{
+			StartSlot: bucket.StartSlot,
+			EndSlot:   bucket.StartSlot + bucketSlots - 1,
+			Fork:      bucket.Fork,
+			Stats:     convertStats(&bucket.SizeStats),
+		}
+		if slotClock.IsEnabled() {
+			converted.StartTime = slotClock.SlotStartTime(bucket.StartSlot).UnixMilli()
+		}
+		response.Buckets = append(response.Buckets, converted)
+	}
+
+	// Scheduled forks first, then forks of stored blocks that are no longer scheduled
+	stored := make(map[string]*types.ForkSizeStats, len(forkStats))
+	for _, stats := range forkStats {
+		stored[stats.Fork] = stats
+	}
+	listed := make(map[string]bool)
+	for _, activation := range s.indexer.GetForkSchedule().Activations() {
+		if listed[activation.Fork] {
+			continue
+		}
+		listed[activation.Fork] = true
+
+		converted := &apiv1.ForkSizeStats{
+			Fork:           activation.Fork,
+			ActivationSlot: activation.Slot,
+			Scheduled:      true,
+			Stats:          &apiv1.SizeStats{},
+		}
+		if stats := stored[activation.Fork]; stats != nil {
+			converted.Stats = convertStats(&stats.SizeStats)
+		}
+		response.Forks = append(response.Forks, converted)
+	}
+	for _, stats := range forkStats {
+		if !listed[stats.Fork] {
+			response.Forks = append(response.Forks, &apiv1.ForkSizeStats{
+				Fork:  stats.Fork,
+				Stats: convertStats(&stats.SizeStats),
+			})
+		}
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// convertStats converts size statistics to their protobuf representation
+func convertStats(stats *types.SizeStats) *apiv1.SizeStats {
+	return &apiv1.SizeStats{
+		Blocks:           stats.Blocks,
+		FirstSlot:        stats.FirstSlot,
+		LastSlot:         stats.LastSlot,
+		MinSignatureSize: stats.MinSignatureSize,
+		MaxSignatureSize: stats.MaxSignatureSize,
+		AvgSignatureSize: stats.AvgSignatureSize,
+		MinBlockSize:     stats.MinBlockSize,
+		MaxBlockSize:     stats.MaxBlockSize,
+		AvgBlockSize:     stats.AvgBlockSize,
+		TotalBytes:       stats.TotalBytes,
+	}
+}
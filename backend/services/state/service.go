@@ -0,0 +1,220 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/db"
+	apiv1 "github.com/syjn99/leanView/backend/gen/proto/api/v1"
+	"github.com/syjn99/leanView/backend/indexer"
+	"github.com/syjn99/leanView/backend/services/convert"
+	"github.com/syjn99/leanView/backend/stf"
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// StateService handles API requests for stored and fetched states
+type StateService struct {
+	indexer *indexer.Indexer
+	logger  *logrus.Entry
+}
+
+// NewStateService creates a new State service instance
+func NewStateService(indexer *indexer.Indexer, logger *logrus.Logger) *StateService {
+	return &StateService{
+		indexer: indexer,
+		logger:  logger.WithField("component", "state_service"),
+	}
+}
+
+// GetState returns the checkpoints and justification bitfields of a stored snapshot or a
+// state fetched from a client, with its block hashes compared to the indexed chain
+func (s *StateService) GetState(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetStateRequest],
+) (*connect.Response[apiv1.GetStateResponse], error) {
+	snapshot, state, err := s.loadState(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := indexer.CheckBlockHashes(state)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to compare state block hashes")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if req.Msg.Client != "" {
+		snapshot.CheckedHashes = check.Checked
+		snapshot.MismatchedHashes = uint64(len(check.Mismatches))
+		snapshot.UnindexedHashes = uint64(len(check.Unindexed))
+	}
+
+	pending, err := stf.PendingJustifications(state)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode justifications: %w", err))
+	}
+
+	justifiedSlots := make([]bool, len(state.JustifiedSlots))
+	for i, justified := range state.JustifiedSlots {
+		justifiedSlots[i] = justified != 0
+	}
+
+	response := &apiv1.GetStateResponse{
+		Snapshot:              convertSnapshot(snapshot),
+		NumValidators:         state.Config.NumValidators,
+		GenesisTime:           state.Config.GenesisTime,
+		LatestBlockHeader:     convert.BlockHeader(state.LatestBlockHeader),
+		HistoricalBlockHashes: uint64(len(state.HistoricalBlockHashes)),
+		JustifiedSlots:        convert.HexRoot(packBits(justifiedSlots)),
+		JustifiedSlotsLength:  uint64(len(state.JustifiedSlots)),
+		Justifications:        make([]*apiv1.PendingJustification, 0, len(pending)),
+		Mismatches:            make([]*apiv1.HashMismatch, 0, len(check.Mismatches)),
+		UnindexedSlots:        check.Unindexed,
+	}
+	for _, justification := range pending {
+		votes := uint64(0)
+		for _, voted := range justification.Voters {
+			if voted {
+				votes++
+			}
+		}
+		response.Justifications = append(response.Justifications, &apiv1.PendingJustification{
+			Root:       convert.HexRoot(justification.Root),
+			Slot:       justification.Slot,
+			SlotKnown:  justification.Found,
+			Votes:      votes,
+			Validators: convert.HexRoot(packBits(justification.Voters)),
+		})
+	}
+	for _, mismatch := range check.Mismatches {
+		response.Mismatches = append(response.Mismatches, &apiv1.HashMismatch{
+			Slot:             mismatch.Slot,
+			StateBlockRoot:   convert.HexRoot(mismatch.StateRoot),
+			IndexedBlockRoot: convert.HexRoot(mismatch.IndexedRoot),
+		})
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// GetStateSnapshots returns the stored snapshots, highest slot first
+func (s *StateService) GetStateSnapshots(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetStateSnapshotsRequest],
+) (*connect.Response[apiv1.GetStateSnapshotsResponse], error) {
+	limit := req.Msg.Limit
+	if limit == 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	snapshots, err := db.GetLatestStateSnapshots(int(limit))
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to load state snapshots")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response := &apiv1.GetStateSnapshotsResponse{
+		Snapshots: make([]*apiv1.StateSnapshot, 0, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		response.Snapshots = append(response.Snapshots, convertSnapshot(snapshot))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// loadState loads the state the request selects, from a client or the stored snapshots
+func (s *StateService) loadState(ctx context.Context, req *apiv1.GetStateRequest) (*types.StateSnapshot, *types.State, error) {
+	var root []byte
+	if req.Root != "" {
+		var err error
+		if root, err = convert.ParseRoot(req.Root); err != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	if req.Client != "" {
+		client := s.indexer.GetClientPool().GetClientByName(req.Client)
+		if client == nil {
+			return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("client %q not found", req.Client))
+		}
+
+		stateId := strconv.FormatUint(req.Slot, 10)
+		if root != nil {
+			stateId = convert.HexRoot(root)
+		}
+		state, err := client.GetState(ctx, stateId)
+		if errors.Is(err, indexer.ErrStateNotFound) {
+			return nil, nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to fetch state from %s: %w", req.Client, err))
+		}
+
+		snapshot, err := indexer.NewStateSnapshot(state, req.Client, s.indexer.GetClock().Now())
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return snapshot, state, nil
+	}
+
+	var snapshot *types.StateSnapshot
+	var err error
+	if root != nil {
+		snapshot, err = db.GetStateSnapshotByRoot(root)
+	} else {
+		snapshot, err = db.GetStateSnapshotBySlot(req.Slot)
+	}
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to load state snapshot")
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if snapshot == nil {
+		if root != nil {
+			return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no snapshot of state %s", req.Root))
+		}
+		return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no snapshot at slot %d", req.Slot))
+	}
+
+	state := &types.State{}
+	if err := state.UnmarshalSSZ(snapshot.Data); err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode stored state: %w", err))
+	}
+	return snapshot, state, nil
+}
+
+// convertSnapshot converts a snapshot to its protobuf representation
+func convertSnapshot(snapshot *types.StateSnapshot) *apiv1.StateSnapshot {
+	return &apiv1.StateSnapshot{
+		StateRoot:        convert.HexRoot(snapshot.StateRoot),
+		Slot:             snapshot.Slot,
+		BlockRoot:        convert.HexRoot(snapshot.BlockRoot),
+		Client:           snapshot.Client,
+		JustifiedSlot:    snapshot.JustifiedSlot,
+		JustifiedRoot:    convert.HexRoot(snapshot.JustifiedRoot),
+		FinalizedSlot:    snapshot.FinalizedSlot,
+		FinalizedRoot:    convert.HexRoot(snapshot.FinalizedRoot),
+		SizeBytes:        snapshot.Size,
+		CheckedHashes:    snapshot.CheckedHashes,
+		MismatchedHashes: snapshot.MismatchedHashes,
+		UnindexedHashes:  snapshot.UnindexedHashes,
+		FetchedAt:        snapshot.FetchedAt,
+	}
+}
+
+// packBits packs bits into a bitfield, bit i is bit i%8 of byte i/8
+func packBits(bits []bool) []byte {
+	packed := make([]byte, (len(bits)+7)/8)
+	for i, bit := range bits {
+		if bit {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	return packed
+}
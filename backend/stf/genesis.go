@@ -0,0 +1,62 @@
+package stf
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// GenerateGenesisState creates the Devnet 0 genesis state for the genesis time and validator count
+func GenerateGenesisState(genesisTime, numValidators uint64) (*types.State, error) {
+	bodyRoot, err := (&types.BlockBody{}).HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash empty block body: %w", err)
+	}
+
+	return &types.State{
+		Config: &types.StateConfig{
+			NumValidators: numValidators,
+			GenesisTime:   genesisTime,
+		},
+		LatestBlockHeader: &types.BlockHeader{
+			ParentRoot: bytes.Clone(zeroHash),
+			StateRoot:  bytes.Clone(zeroHash),
+			BodyRoot:   bodyRoot[:],
+		},
+		LatestJustified:          &types.Checkpoint{Root: bytes.Clone(zeroHash)},
+		LatestFinalized:          &types.Checkpoint{Root: bytes.Clone(zeroHash)},
+		HistoricalBlockHashes:    [][]byte{},
+		JustifiedSlots:           []byte{},
+		JustificationsRoots:      [][]byte{},
+		JustificationsValidators: boolsToBitlist(nil),
+	}, nil
+}
+
+// GenesisBlock returns the genesis block, which commits to the genesis state
+func GenesisBlock(genesisState *types.State) (*types.Block, error) {
+	stateRoot, err := genesisState.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash genesis state: %w", err)
+	}
+
+	return &types.Block{
+		ParentRoot: bytes.Clone(zeroHash),
+		StateRoot:  stateRoot[:],
+		Body:       &types.BlockBody{},
+	}, nil
+}
+
+// Copy returns a deep copy of the state
+func Copy(state *types.State) (*types.State, error) {
+	encoded, err := state.MarshalSSZ()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state: %w", err)
+	}
+
+	copied := &types.State{}
+	if err := copied.UnmarshalSSZ(encoded); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %w", err)
+	}
+	return copied, nil
+}
@@ -0,0 +1,60 @@
+package stf
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// The expected roots are computed outside this package by merkleizing the containers of
+// lean-containers.md for generate_genesis_state, and Block(state_root=...) for the block
+func TestGenesisRoots(t *testing.T) {
+	tests := []struct {
+		genesisTime   uint64
+		numValidators uint64
+		stateRoot     string
+		blockRoot     string
+	}{
+		{
+			genesisTime:   1_700_000_000,
+			numValidators: 5,
+			stateRoot:     "83b0422c545d6ce46837f1ff5ebf322f69847bbc4fd62219f1eec10eb20426ee",
+			blockRoot:     "cc02349bb123d4c27258375d04c23b7787580253430afc5fa77d08916cd03f61",
+		},
+		{
+			genesisTime:   0,
+			numValidators: 3,
+			stateRoot:     "2fd4d9ea4e982a469d111cca1a223814b8860a90b6bf640d35c77d6a3b873ef8",
+			blockRoot:     "f97012ee73fa1afe1e78a248dbad5b7be1dbf3d28d34275afc9643af01702b09",
+		},
+	}
+
+	for _, test := range tests {
+		state, err := GenerateGenesisState(test.genesisTime, test.numValidators)
+		if err != nil {
+			t.Fatalf("generating genesis state: %v", err)
+		}
+		stateRoot, err := state.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("hashing genesis state: %v", err)
+		}
+		if got := hex.EncodeToString(stateRoot[:]); got != test.stateRoot {
+			t.Errorf("genesis state root for %d validators at %d is %s, want %s", test.numValidators, test.genesisTime, got, test.stateRoot)
+		}
+
+		block, err := GenesisBlock(state)
+		if err != nil {
+			t.Fatalf("building genesis block: %v", err)
+		}
+		header, err := block.Header()
+		if err != nil {
+			t.Fatalf("building genesis header: %v", err)
+		}
+		blockRoot, err := header.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("hashing genesis block: %v", err)
+		}
+		if got := hex.EncodeToString(blockRoot[:]); got != test.blockRoot {
+			t.Errorf("genesis block root for %d validators at %d is %s, want %s", test.numValidators, test.genesisTime, got, test.blockRoot)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package stf
+
+import (
+	"fmt"
+	"math"
+)
+
+// IsJustifiableSlot reports whether 3SF-mini allows justifying the candidate slot given the
+// finalized slot: within 5 slots of it, or a perfect square or pronic number of slots after it
+func IsJustifiableSlot(finalizedSlot, candidate uint64) (bool, error) {
+	if candidate < finalizedSlot {
+		return false, fmt.Errorf("%w: candidate slot %d is before finalized slot %d", ErrInvalidBlock, candidate, finalizedSlot)
+	}
+
+	delta := candidate - finalizedSlot
+	if delta <= 5 {
+		return true, nil
+	}
+	root := isqrt(delta)
+	return root*root == delta || root*(root+1) == delta, nil
+}
+
+// isqrt returns the largest integer whose square is at most n
+func isqrt(n uint64) uint64 {
+	// Correct the float estimate, which is off by one for large n
+	root := min(uint64(math.Sqrt(float64(n))), math.MaxUint32)
+	for root*root > n {
+		root--
+	}
+	for root < math.MaxUint32 && (root+1)*(root+1) <= n {
+		root++
+	}
+	return root
+}
@@ -0,0 +1,64 @@
+package stf
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsJustifiableSlot(t *testing.T) {
+	tests := []struct {
+		finalized, candidate uint64
+		justifiable          bool
+	}{
+		// Within 5 slots of finalization
+		{0, 0, true},
+		{0, 1, true},
+		{0, 5, true},
+		{10, 15, true},
+
+		// Perfect squares after that: 9, 16, 25
+		{0, 9, true},
+		{0, 16, true},
+		{0, 25, true},
+		{10, 19, true},
+
+		// Pronic numbers x^2+x: 6, 12, 20, 30
+		{0, 6, true},
+		{0, 12, true},
+		{0, 20, true},
+		{0, 30, true},
+		{10, 16, true},
+
+		// Neither
+		{0, 7, false},
+		{0, 8, false},
+		{0, 10, false},
+		{0, 11, false},
+		{0, 13, false},
+		{0, 24, false},
+		{0, 31, false},
+		{10, 17, false},
+
+		// Beyond float64 precision of the spec's square roots: 2^32, 2^16*(2^16+1) and their neighbours
+		{0, 1 << 32, true},
+		{0, 1<<32 + 1, false},
+		{0, 65536 * 65537, true},
+		{0, 65536*65537 - 1, false},
+	}
+
+	for _, test := range tests {
+		got, err := IsJustifiableSlot(test.finalized, test.candidate)
+		if err != nil {
+			t.Errorf("IsJustifiableSlot(%d, %d) failed: %v", test.finalized, test.candidate, err)
+			continue
+		}
+		if got != test.justifiable {
+			t.Errorf("IsJustifiableSlot(%d, %d) = %v, want %v", test.finalized, test.candidate, got, test.justifiable)
+		}
+	}
+
+	// The spec asserts the candidate is not before the finalized slot
+	if _, err := IsJustifiableSlot(10, 9); !errors.Is(err, ErrInvalidBlock) {
+		t.Errorf("candidate before the finalized slot returned %v, want ErrInvalidBlock", err)
+	}
+}
@@ -0,0 +1,91 @@
+package stf
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// justifications is the root -> votes map of get_justifications. Roots keep the order of
+// the state, with new roots appended, as the spec's insertion ordered dict does.
+type justifications struct {
+	roots [][32]byte
+	votes map[[32]byte][]bool // ValidatorRegistryLimit entries per root
+}
+
+// getJustifications unflattens the justification roots and validator bitlist of the state
+func getJustifications(state *types.State) (*justifications, error) {
+	bits, err := bitlistToBools(state.JustificationsValidators)
+	if err != nil {
+		return nil, err
+	}
+	if len(bits) != len(state.JustificationsRoots)*types.ValidatorRegistryLimit {
+		return nil, fmt.Errorf("%w: justification bitlist has %d bits for %d roots", ErrInvalidState, len(bits), len(state.JustificationsRoots))
+	}
+
+	j := &justifications{
+		roots: make([][32]byte, 0, len(state.JustificationsRoots)),
+		votes: make(map[[32]byte][]bool, len(state.JustificationsRoots)),
+	}
+	for i, root := range state.JustificationsRoots {
+		key := [32]byte(root)
+		if _, ok := j.votes[key]; !ok {
+			j.roots = append(j.roots, key)
+		}
+		start := i * types.ValidatorRegistryLimit
+		j.votes[key] = bits[start : start+types.ValidatorRegistryLimit]
+	}
+	return j, nil
+}
+
+// setJustifications flattens the map back into the state
+func setJustifications(state *types.State, j *justifications) {
+	roots := make([][]byte, 0, len(j.roots))
+	bits := make([]bool, 0, len(j.roots)*types.ValidatorRegistryLimit)
+	for _, root := range j.roots {
+		roots = append(roots, slices.Clone(root[:]))
+		bits = append(bits, j.votes[root]...)
+	}
+
+	state.JustificationsRoots = roots
+	state.JustificationsValidators = boolsToBitlist(bits)
+}
+
+// remove deletes a root and its votes
+func (j *justifications) remove(root [32]byte) {
+	delete(j.votes, root)
+	j.roots = slices.DeleteFunc(j.roots, func(r [32]byte) bool { return r == root })
+}
+
+// bitlistToBools decodes an SSZ bitlist, whose highest set bit marks its length
+func bitlistToBools(bitlist []byte) ([]bool, error) {
+	if len(bitlist) == 0 || bitlist[len(bitlist)-1] == 0 {
+		return nil, fmt.Errorf("%w: bitlist has no length bit", ErrInvalidState)
+	}
+
+	last := bitlist[len(bitlist)-1]
+	length := (len(bitlist) - 1) * 8
+	for last > 1 {
+		last >>= 1
+		length++
+	}
+
+	bits := make([]bool, length)
+	for i := range bits {
+		bits[i] = bitlist[i/8]&(1<<(i%8)) != 0
+	}
+	return bits, nil
+}
+
+// boolsToBitlist encodes bits as an SSZ bitlist
+func boolsToBitlist(bits []bool) []byte {
+	bitlist := make([]byte, len(bits)/8+1)
+	for i, bit := range bits {
+		if bit {
+			bitlist[i/8] |= 1 << (i % 8)
+		}
+	}
+	bitlist[len(bits)/8] |= 1 << (len(bits) % 8)
+	return bitlist
+}
@@ -0,0 +1,97 @@
+package stf
+
+import (
+	"bytes"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// TargetProgress is how close a candidate target slot is to being justified
+type TargetProgress struct {
+	Slot        uint64
+	Root        []byte // Block root of the slot in historical_block_hashes
+	Votes       uint64 // Validators whose vote for the root counts, 0 once justified since the state drops its votes
+	Justifiable bool   // Justifiable relative to the latest finalized slot
+	Justified   bool
+}
+
+// JustificationThreshold returns the votes that justify a target, two thirds of the
+// validators rounded up
+func JustificationThreshold(numValidators uint64) uint64 {
+	return (2*numValidators + 2) / 3
+}
+
+// JustificationProgress returns the progress of every slot after the latest finalized slot
+// with a block in historical_block_hashes, in slot order. The state removes the votes of a
+// root from justifications_roots and justifications_validators once it is justified, so
+// justified targets are reported without votes.
+func JustificationProgress(state *types.State) ([]*TargetProgress, error) {
+	justifications, err := getJustifications(state)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []*TargetProgress
+	for slot := state.LatestFinalized.Slot + 1; slot < uint64(len(state.HistoricalBlockHashes)); slot++ {
+		root := state.HistoricalBlockHashes[slot]
+		if bytes.Equal(root, zeroHash) {
+			continue
+		}
+
+		justifiable, err := IsJustifiableSlot(state.LatestFinalized.Slot, slot)
+		if err != nil {
+			return nil, err
+		}
+		target := &TargetProgress{
+			Slot:        slot,
+			Root:        root,
+			Justifiable: justifiable,
+			Justified:   slot < uint64(len(state.JustifiedSlots)) && state.JustifiedSlots[slot] != 0,
+		}
+		if !target.Justified {
+			for _, voted := range justifications.votes[[32]byte(root)] {
+				if voted {
+					target.Votes++
+				}
+			}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// PendingJustification is a target root with votes that did not justify it yet
+type PendingJustification struct {
+	Root   []byte
+	Slot   uint64 // Slot of the root in historical_block_hashes
+	Found  bool   // Whether the root is in historical_block_hashes
+	Voters []bool // Whether each validator voted for the root, NumValidators entries
+}
+
+// PendingJustifications returns the votes of the state's justifications in state order
+func PendingJustifications(state *types.State) ([]*PendingJustification, error) {
+	justifications, err := getJustifications(state)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make(map[[32]byte]uint64, len(state.HistoricalBlockHashes))
+	for slot, root := range state.HistoricalBlockHashes {
+		if !bytes.Equal(root, zeroHash) {
+			slots[[32]byte(root)] = uint64(slot)
+		}
+	}
+
+	numValidators := min(state.Config.NumValidators, types.ValidatorRegistryLimit)
+	pending := make([]*PendingJustification, 0, len(justifications.roots))
+	for _, root := range justifications.roots {
+		slot, found := slots[root]
+		pending = append(pending, &PendingJustification{
+			Root:   root[:],
+			Slot:   slot,
+			Found:  found,
+			Voters: justifications.votes[root][:numValidators],
+		})
+	}
+	return pending, nil
+}
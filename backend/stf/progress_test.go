@@ -0,0 +1,49 @@
+package stf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestJustificationProgress(t *testing.T) {
+	// Three of four validators justify slot 1, slots 2 and 3 have fewer votes
+	state := attestationState(4, 8, 0)
+	attestations := votes(0, 1, 0, 1, 2)
+	attestations = append(attestations, votes(0, 2, 0, 1)...)
+	attestations = append(attestations, votes(1, 3, 3)...)
+	if err := ProcessAttestations(state, attestations); err != nil {
+		t.Fatalf("processing attestations: %v", err)
+	}
+
+	targets, err := JustificationProgress(state)
+	if err != nil {
+		t.Fatalf("computing justification progress: %v", err)
+	}
+	expected := []TargetProgress{
+		{Slot: 1, Justifiable: true, Justified: true},
+		{Slot: 2, Votes: 2, Justifiable: true},
+		{Slot: 3, Votes: 1, Justifiable: true},
+		{Slot: 4, Justifiable: true},
+		{Slot: 5, Justifiable: true},
+		{Slot: 6, Justifiable: true},
+		{Slot: 7},
+	}
+	if len(targets) != len(expected) {
+		t.Fatalf("got %d targets, want slots 1 to 7", len(targets))
+	}
+	for i, target := range targets {
+		want := expected[i]
+		if target.Slot != want.Slot || target.Votes != want.Votes || target.Justifiable != want.Justifiable || target.Justified != want.Justified {
+			t.Errorf("got target %+v, want %+v", *target, want)
+		}
+		if !bytes.Equal(target.Root, testRoot(target.Slot)) {
+			t.Errorf("target slot %d has root %x, want the block root of the slot", target.Slot, target.Root)
+		}
+	}
+
+	// Missed slots are not targets
+	state.HistoricalBlockHashes[2] = make([]byte, 32)
+	if targets, err = JustificationProgress(state); err != nil || len(targets) != 6 || targets[1].Slot != 3 {
+		t.Errorf("missed slot 2 is reported as a target: %v", err)
+	}
+}
@@ -0,0 +1,280 @@
+package stf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+var (
+	// ErrInvalidBlock is returned when a block fails a check of the state transition
+	ErrInvalidBlock = errors.New("invalid block")
+
+	// ErrInvalidState is returned when the pre-state itself is malformed
+	ErrInvalidState = errors.New("invalid state")
+)
+
+var zeroHash = make([]byte, 32)
+
+// StateTransition applies a signed block to the state in place, following the Devnet 0
+// state transition in lean-chain.md. Signatures are verified outside the transition, the
+// result of that check is passed as validSignatures. With validateResult set the block's
+// state root must match the post-state. The state is left partially updated on error, so
+// callers that need the pre-state afterwards should pass a Copy.
+func StateTransition(state *types.State, signedBlock *types.SignedBlock, validSignatures, validateResult bool) error {
+	if !validSignatures {
+		return fmt.Errorf("%w: invalid signatures", ErrInvalidBlock)
+	}
+
+	block := signedBlock.Message
+	if err := ProcessSlots(state, block.Slot); err != nil {
+		return err
+	}
+	if err := ProcessBlock(state, block); err != nil {
+		return err
+	}
+
+	if validateResult {
+		stateRoot, err := state.HashTreeRoot()
+		if err != nil {
+			return fmt.Errorf("failed to hash state: %w", err)
+		}
+		if !bytes.Equal(block.StateRoot, stateRoot[:]) {
+			return fmt.Errorf("%w: state root 0x%x, post-state root 0x%x", ErrInvalidBlock, block.StateRoot, stateRoot)
+		}
+	}
+	return nil
+}
+
+// ProcessSlots advances the state through empty slots up to the given slot
+func ProcessSlots(state *types.State, slot uint64) error {
+	if state.Slot >= slot {
+		return fmt.Errorf("%w: slot %d is not after state slot %d", ErrInvalidBlock, slot, state.Slot)
+	}
+
+	for state.Slot < slot {
+		if err := ProcessSlot(state); err != nil {
+			return err
+		}
+		state.Slot++
+	}
+	return nil
+}
+
+// ProcessSlot caches the state root in the latest block header on the first slot after a block
+func ProcessSlot(state *types.State) error {
+	if !bytes.Equal(state.LatestBlockHeader.StateRoot, zeroHash) {
+		return nil
+	}
+
+	previousStateRoot, err := state.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash state: %w", err)
+	}
+	state.LatestBlockHeader.StateRoot = previousStateRoot[:]
+	return nil
+}
+
+// ProcessBlock applies the block header and its operations
+func ProcessBlock(state *types.State, block *types.Block) error {
+	if err := ProcessBlockHeader(state, block); err != nil {
+		return err
+	}
+	return ProcessOperations(state, block.Body)
+}
+
+// ProcessBlockHeader checks the block against the state, records its parent and the
+// missed slots before it in the history, and makes it the latest block header
+func ProcessBlockHeader(state *types.State, block *types.Block) error {
+	latest := state.LatestBlockHeader
+	if block.Slot != state.Slot {
+		return fmt.Errorf("%w: block slot %d does not match state slot %d", ErrInvalidBlock, block.Slot, state.Slot)
+	}
+	if block.Slot <= latest.Slot {
+		return fmt.Errorf("%w: block slot %d is not after latest block slot %d", ErrInvalidBlock, block.Slot, latest.Slot)
+	}
+	if state.Config.NumValidators == 0 {
+		return fmt.Errorf("%w: no validators", ErrInvalidState)
+	}
+	if expected := block.Slot % state.Config.NumValidators; block.ProposerIndex != expected {
+		return fmt.Errorf("%w: proposer %d, expected %d", ErrInvalidBlock, block.ProposerIndex, expected)
+	}
+	latestRoot, err := latest.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash latest block header: %w", err)
+	}
+	if !bytes.Equal(block.ParentRoot, latestRoot[:]) {
+		return fmt.Errorf("%w: parent root 0x%x, latest block root 0x%x", ErrInvalidBlock, block.ParentRoot, latestRoot)
+	}
+
+	// The genesis block root cannot be part of the genesis state, it is justified and
+	// finalized with the first block after it
+	if latest.Slot == 0 {
+		state.LatestJustified.Root = bytes.Clone(block.ParentRoot)
+		state.LatestFinalized.Root = bytes.Clone(block.ParentRoot)
+	}
+
+	// Record the parent at its slot, and zero hashes for the missed slots after it
+	numEmptySlots := block.Slot - latest.Slot - 1
+	if uint64(len(state.HistoricalBlockHashes))+1+numEmptySlots > types.HistoricalRootsLimit {
+		return fmt.Errorf("%w: historical block hashes exceed the limit", ErrInvalidBlock)
+	}
+	state.HistoricalBlockHashes = append(state.HistoricalBlockHashes, bytes.Clone(block.ParentRoot))
+	state.JustifiedSlots = append(state.JustifiedSlots, boolByte(latest.Slot == 0))
+	for range numEmptySlots {
+		state.HistoricalBlockHashes = append(state.HistoricalBlockHashes, bytes.Clone(zeroHash))
+		state.JustifiedSlots = append(state.JustifiedSlots, 0)
+	}
+
+	body := block.Body
+	if body == nil {
+		body = &types.BlockBody{}
+	}
+	bodyRoot, err := body.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash block body: %w", err)
+	}
+
+	// The state root is filled in by the next ProcessSlot
+	state.LatestBlockHeader = &types.BlockHeader{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    bytes.Clone(block.ParentRoot),
+		StateRoot:     bytes.Clone(zeroHash),
+		BodyRoot:      bodyRoot[:],
+	}
+	return nil
+}
+
+// ProcessOperations applies the operations of the block body, votes only in Devnet 0
+func ProcessOperations(state *types.State, body *types.BlockBody) error {
+	if body == nil {
+		return nil
+	}
+	return ProcessAttestations(state, body.Votes)
+}
+
+// ProcessAttestations applies votes with the 3SF-mini rules. Votes with an unjustified
+// source, an already justified or unknown target, or a target slot that is not justifiable
+// are ignored. A target gets justified once two thirds of the validators voted for it, and
+// the source is finalized if no justifiable slot lies between them.
+func ProcessAttestations(state *types.State, votes []*types.Vote) error {
+	justifications, err := getJustifications(state)
+	if err != nil {
+		return err
+	}
+
+	numValidators := state.Config.NumValidators
+	for _, vote := range votes {
+		source, target := vote.Source, vote.Target
+
+		// Checked in the order of the spec, whose list accesses fail the block when out of range
+		sourceJustified, err := listByte(state.JustifiedSlots, source.Slot, "justified_slots")
+		if err != nil {
+			return err
+		}
+		if sourceJustified == 0 {
+			continue
+		}
+		targetJustified, err := listByte(state.JustifiedSlots, target.Slot, "justified_slots")
+		if err != nil {
+			return err
+		}
+		if targetJustified != 0 {
+			continue
+		}
+		sourceHash, err := listRoot(state.HistoricalBlockHashes, source.Slot)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(source.Root, sourceHash) {
+			continue
+		}
+		targetHash, err := listRoot(state.HistoricalBlockHashes, target.Slot)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(target.Root, targetHash) || target.Slot <= source.Slot {
+			continue
+		}
+		justifiable, err := IsJustifiableSlot(state.LatestFinalized.Slot, target.Slot)
+		if err != nil {
+			return err
+		}
+		if !justifiable {
+			continue
+		}
+
+		// Track attempts to justify new roots. Votes are kept for every possible validator,
+		// votes of validators that do not exist fail the block.
+		if vote.ValidatorId >= numValidators {
+			return fmt.Errorf("%w: vote of validator %d, only %d validators exist", ErrInvalidBlock, vote.ValidatorId, numValidators)
+		}
+		targetRoot := [32]byte(target.Root)
+		if _, ok := justifications.votes[targetRoot]; !ok {
+			justifications.roots = append(justifications.roots, targetRoot)
+			justifications.votes[targetRoot] = make([]bool, types.ValidatorRegistryLimit)
+		}
+		justifications.votes[targetRoot][vote.ValidatorId] = true
+
+		count := uint64(0)
+		for _, voted := range justifications.votes[targetRoot] {
+			if voted {
+				count++
+			}
+		}
+
+		// At least two thirds, without integer division rounding the threshold down
+		if 3*count < 2*numValidators {
+			continue
+		}
+		state.LatestJustified = &types.Checkpoint{Root: bytes.Clone(target.Root), Slot: target.Slot}
+		state.JustifiedSlots[target.Slot] = 1
+		justifications.remove(targetRoot)
+
+		// Finalize the source if the target is the next justifiable slot after it
+		finalize := true
+		for slot := source.Slot + 1; slot < target.Slot; slot++ {
+			justifiable, err := IsJustifiableSlot(state.LatestFinalized.Slot, slot)
+			if err != nil {
+				return err
+			}
+			if justifiable {
+				finalize = false
+				break
+			}
+		}
+		if finalize {
+			state.LatestFinalized = &types.Checkpoint{Root: bytes.Clone(source.Root), Slot: source.Slot}
+		}
+	}
+
+	setJustifications(state, justifications)
+	return nil
+}
+
+// listByte returns an element of a state list, failing the block when out of range
+func listByte(list []byte, index uint64, name string) (byte, error) {
+	if index >= uint64(len(list)) {
+		return 0, fmt.Errorf("%w: slot %d is out of range of %s with %d entries", ErrInvalidBlock, index, name, len(list))
+	}
+	return list[index], nil
+}
+
+// listRoot returns an element of historical_block_hashes, failing the block when out of range
+func listRoot(list [][]byte, index uint64) ([]byte, error) {
+	if index >= uint64(len(list)) {
+		return nil, fmt.Errorf("%w: slot %d is out of range of historical_block_hashes with %d entries", ErrInvalidBlock, index, len(list))
+	}
+	return list[index], nil
+}
+
+// boolByte encodes a bool as an element of justified_slots
+func boolByte(value bool) byte {
+	if value {
+		return 1
+	}
+	return 0
+}
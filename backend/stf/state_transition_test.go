@@ -0,0 +1,190 @@
+package stf
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// testRoot is the made up block root of a slot in the attestation tests
+func testRoot(slot uint64) []byte {
+	return bytes.Repeat([]byte{byte(slot + 1)}, 32)
+}
+
+// attestationState returns a state with blocks at slots 0 through numSlots-1 and the given
+// slots justified, finalized at slot 0
+func attestationState(numValidators, numSlots uint64, justified ...uint64) *types.State {
+	state := &types.State{
+		Config:                   &types.StateConfig{NumValidators: numValidators},
+		Slot:                     numSlots,
+		LatestBlockHeader:        &types.BlockHeader{Slot: numSlots},
+		LatestJustified:          &types.Checkpoint{Root: testRoot(0)},
+		LatestFinalized:          &types.Checkpoint{Root: testRoot(0)},
+		JustifiedSlots:           make([]byte, numSlots),
+		JustificationsRoots:      [][]byte{},
+		JustificationsValidators: boolsToBitlist(nil),
+	}
+	for slot := range numSlots {
+		state.HistoricalBlockHashes = append(state.HistoricalBlockHashes, testRoot(slot))
+	}
+	for _, slot := range justified {
+		state.JustifiedSlots[slot] = 1
+	}
+	return state
+}
+
+// votes returns a vote from each validator for the target with the source
+func votes(source, target uint64, validators ...uint64) []*types.Vote {
+	var list []*types.Vote
+	for _, validator := range validators {
+		list = append(list, &types.Vote{
+			ValidatorId: validator,
+			Slot:        target,
+			Head:        &types.Checkpoint{Root: testRoot(target), Slot: target},
+			Target:      &types.Checkpoint{Root: testRoot(target), Slot: target},
+			Source:      &types.Checkpoint{Root: testRoot(source), Slot: source},
+		})
+	}
+	return list
+}
+
+func TestProcessAttestations(t *testing.T) {
+	tests := []struct {
+		name          string
+		state         *types.State
+		votes         []*types.Vote
+		justified     uint64 // Expected latest justified slot
+		finalized     uint64 // Expected latest finalized slot
+		pendingRoots  int    // Expected justification roots left in the state
+		pendingVoters []uint64
+	}{
+		{
+			// 3 * 2 >= 2 * 3, and no slot lies between source 1 and target 2
+			name:      "two thirds justify the target and finalize the source",
+			state:     attestationState(3, 4, 0, 1),
+			votes:     votes(1, 2, 0, 1),
+			justified: 2,
+			finalized: 1,
+		},
+		{
+			name:          "one vote short keeps the votes pending",
+			state:         attestationState(3, 4, 0, 1),
+			votes:         votes(1, 2, 2),
+			pendingRoots:  1,
+			pendingVoters: []uint64{2},
+		},
+		{
+			// 3 * 2 < 2 * 4, exactly two thirds are not reached
+			name:          "half of four validators do not justify",
+			state:         attestationState(4, 4, 0),
+			votes:         votes(0, 1, 0, 3),
+			pendingRoots:  1,
+			pendingVoters: []uint64{0, 3},
+		},
+		{
+			name:      "three of four validators justify",
+			state:     attestationState(4, 4, 0),
+			votes:     votes(0, 1, 0, 1, 3),
+			justified: 1,
+		},
+		{
+			// Slot 1 is justifiable, so justifying slot 2 from slot 0 does not finalize slot 0
+			name:      "a justifiable slot in between prevents finalization",
+			state:     attestationState(3, 4, 0),
+			votes:     votes(0, 2, 0, 1, 2),
+			justified: 2,
+		},
+		{
+			// 7 slots after finalization is neither within 5, a square nor pronic
+			name:  "votes for a target that is not justifiable are ignored",
+			state: attestationState(3, 8, 0),
+			votes: votes(0, 7, 0, 1, 2),
+		},
+		{
+			name:  "votes from an unjustified source are ignored",
+			state: attestationState(3, 4, 0),
+			votes: votes(1, 2, 0, 1, 2),
+		},
+		{
+			name:      "votes for an already justified target are ignored",
+			state:     attestationState(3, 4, 0, 2),
+			votes:     votes(0, 2, 0),
+			justified: 0,
+		},
+		{
+			name: "votes for a target with another root are ignored",
+			state: func() *types.State {
+				state := attestationState(3, 4, 0)
+				state.HistoricalBlockHashes[2] = testRoot(9)
+				return state
+			}(),
+			votes: votes(0, 2, 0, 1, 2),
+		},
+		{
+			name:  "votes with the target before the source are ignored",
+			state: attestationState(3, 4, 0, 2),
+			votes: votes(2, 1, 0, 1, 2),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := test.state
+			if err := ProcessAttestations(state, test.votes); err != nil {
+				t.Fatalf("processing attestations: %v", err)
+			}
+
+			if state.LatestJustified.Slot != test.justified || !bytes.Equal(state.LatestJustified.Root, testRoot(test.justified)) {
+				t.Errorf("latest justified is slot %d root 0x%x, want slot %d", state.LatestJustified.Slot, state.LatestJustified.Root, test.justified)
+			}
+			if state.LatestFinalized.Slot != test.finalized || !bytes.Equal(state.LatestFinalized.Root, testRoot(test.finalized)) {
+				t.Errorf("latest finalized is slot %d root 0x%x, want slot %d", state.LatestFinalized.Slot, state.LatestFinalized.Root, test.finalized)
+			}
+			if test.justified != 0 && state.JustifiedSlots[test.justified] != 1 {
+				t.Errorf("justified slot %d is not marked in justified_slots", test.justified)
+			}
+
+			if len(state.JustificationsRoots) != test.pendingRoots {
+				t.Fatalf("%d justification roots pending, want %d", len(state.JustificationsRoots), test.pendingRoots)
+			}
+			bits, err := bitlistToBools(state.JustificationsValidators)
+			if err != nil {
+				t.Fatalf("decoding justification bitlist: %v", err)
+			}
+			if len(bits) != test.pendingRoots*types.ValidatorRegistryLimit {
+				t.Fatalf("justification bitlist has %d bits, want %d", len(bits), test.pendingRoots*types.ValidatorRegistryLimit)
+			}
+			for validator, voted := range bits {
+				want := false
+				for _, pending := range test.pendingVoters {
+					want = want || uint64(validator) == pending
+				}
+				if voted != want {
+					t.Errorf("validator %d pending vote is %v, want %v", validator, voted, want)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessAttestationsRejectsInvalidVotes(t *testing.T) {
+	tests := []struct {
+		name  string
+		votes []*types.Vote
+	}{
+		{"validator that does not exist", votes(0, 1, 3)},
+		{"source slot beyond justified_slots", votes(4, 1, 0)},
+		{"target slot beyond the slots of the state", votes(0, 4, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ProcessAttestations(attestationState(3, 4, 0), test.votes)
+			if !errors.Is(err, ErrInvalidBlock) {
+				t.Errorf("got %v, want ErrInvalidBlock", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ValidatorRegistryLimit is the maximum number of validators, VALIDATOR_REGISTRY_LIMIT
+const ValidatorRegistryLimit = 1 << 12
+
+// Block is a full Lean block, its header commits to the body by hash tree root
+type Block struct {
+	Slot          uint64     `json:"slot"`
+	ProposerIndex uint64     `json:"proposer_index"`
+	ParentRoot    []byte     `json:"parent_root" ssz-size:"32"`
+	StateRoot     []byte     `json:"state_root" ssz-size:"32"`
+	Body          *BlockBody `json:"body"`
+}
+
+// BlockBody holds the votes included in a block. Devnet 0 does not aggregate votes.
+type BlockBody struct {
+	Votes []*Vote `json:"votes" ssz-max:"4096"`
+}
+
+// SignedBlock is a block with its proposer signature. Devnet 0 signatures are all zero bytes.
+type SignedBlock struct {
+	Message   *Block `json:"message"`
+	Signature []byte `json:"signature" ssz-size:"32"`
+}
+
+// SignedBlockDevnet1 is the Devnet 1 layout of a signed block, the signature is a variable
+// size post-quantum signature. Decoded blocks are converted to SignedBlock.
+type SignedBlockDevnet1 struct {
+	Message   *Block `json:"message"`
+	Signature []byte `json:"signature" ssz-max:"4096"`
+}
+
+// Vote is a validator's 3SF-mini vote for a head, and a target justified from a source
+type Vote struct {
+	ValidatorId uint64      `json:"validator_id"`
+	Slot        uint64      `json:"slot"`
+	Head        *Checkpoint `json:"head"`
+	Target      *Checkpoint `json:"target"`
+	Source      *Checkpoint `json:"source"`
+}
+
+// SignedVote is a vote with the validator signature
+type SignedVote struct {
+	Data      *Vote  `json:"data"`
+	Signature []byte `json:"signature" ssz-size:"32"`
+}
+
+// Header returns the header of the block
+func (b *Block) Header() (*BlockHeader, error) {
+	bodyRoot, err := b.Body.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash block body: %w", err)
+	}
+
+	return &BlockHeader{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		BodyRoot:      bodyRoot[:],
+	}, nil
+}
+
+// blockJSON is used for JSON marshaling/unmarshaling with hex strings
+type blockJSON struct {
+	Slot          flexUint64 `json:"slot"`
+	ProposerIndex flexUint64 `json:"proposer_index"`
+	ParentRoot    string     `json:"parent_root"`
+	StateRoot     string     `json:"state_root"`
+	Body          *BlockBody `json:"body"`
+}
+
+// UnmarshalJSON decodes hex roots, uint64s may be numbers or strings
+func (b *Block) UnmarshalJSON(data []byte) error {
+	var jsonBlock blockJSON
+	if err := json.Unmarshal(data, &jsonBlock); err != nil {
+		return fmt.Errorf("failed to unmarshal block JSON: %w", err)
+	}
+
+	b.Slot = uint64(jsonBlock.Slot)
+	b.ProposerIndex = uint64(jsonBlock.ProposerIndex)
+	b.Body = jsonBlock.Body
+	if b.Body == nil {
+		b.Body = &BlockBody{}
+	}
+
+	var err error
+	if b.ParentRoot, err = hexToBytes(jsonBlock.ParentRoot); err != nil {
+		return fmt.Errorf("failed to decode parent_root: %w", err)
+	}
+	if b.StateRoot, err = hexToBytes(jsonBlock.StateRoot); err != nil {
+		return fmt.Errorf("failed to decode state_root: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON encodes roots as hex strings
+func (b Block) MarshalJSON() ([]byte, error) {
+	return json.Marshal(blockJSON{
+		Slot:          flexUint64(b.Slot),
+		ProposerIndex: flexUint64(b.ProposerIndex),
+		ParentRoot:    bytesToHex(b.ParentRoot),
+		StateRoot:     bytesToHex(b.StateRoot),
+		Body:          b.Body,
+	})
+}
+
+// signedBlockJSON is used for JSON marshaling/unmarshaling with a hex signature
+type signedBlockJSON struct {
+	Message   *Block `json:"message"`
+	Signature string `json:"signature"`
+}
+
+// UnmarshalJSON decodes the hex signature
+func (sb *SignedBlock) UnmarshalJSON(data []byte) error {
+	var jsonBlock signedBlockJSON
+	if err := json.Unmarshal(data, &jsonBlock); err != nil {
+		return fmt.Errorf("failed to unmarshal signed block JSON: %w", err)
+	}
+	if jsonBlock.Message == nil {
+		return fmt.Errorf("signed block has no message")
+	}
+
+	signature, err := hexToBytes(jsonBlock.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+	sb.Message = jsonBlock.Message
+	sb.Signature = signature
+	return nil
+}
+
+// MarshalJSON encodes the signature as a hex string
+func (sb SignedBlock) MarshalJSON() ([]byte, error) {
+	return json.Marshal(signedBlockJSON{
+		Message:   sb.Message,
+		Signature: bytesToHex(sb.Signature),
+	})
+}
+
+// voteJSON is used for JSON marshaling/unmarshaling of uint64s as numbers or strings
+type voteJSON struct {
+	ValidatorId flexUint64  `json:"validator_id"`
+	Slot        flexUint64  `json:"slot"`
+	Head        *Checkpoint `json:"head"`
+	Target      *Checkpoint `json:"target"`
+	Source      *Checkpoint `json:"source"`
+}
+
+// UnmarshalJSON accepts uint64s as numbers or strings and requires all checkpoints
+func (v *Vote) UnmarshalJSON(data []byte) error {
+	var jsonVote voteJSON
+	if err := json.Unmarshal(data, &jsonVote); err != nil {
+		return fmt.Errorf("failed to unmarshal vote JSON: %w", err)
+	}
+	if jsonVote.Head == nil || jsonVote.Target == nil || jsonVote.Source == nil {
+		return fmt.Errorf("vote is missing a checkpoint")
+	}
+
+	v.ValidatorId = uint64(jsonVote.ValidatorId)
+	v.Slot = uint64(jsonVote.Slot)
+	v.Head = jsonVote.Head
+	v.Target = jsonVote.Target
+	v.Source = jsonVote.Source
+	return nil
+}
@@ -0,0 +1,738 @@
+package types
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Block object
+func (b *Block) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Block object to a target array
+func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("Block.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("Block.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Block object
+func (b *Block) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if o4 != 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BlockBody)
+		}
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Block object
+func (b *Block) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BlockBody)
+	}
+	size += b.Body.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Block object
+func (b *Block) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Block object with a hasher
+func (b *Block) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("Block.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("Block.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Block object
+func (b *Block) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the BlockBody object
+func (b *BlockBody) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlockBody object to a target array
+func (b *BlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Votes'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Votes'
+	if size := len(b.Votes); size > 4096 {
+		err = ssz.ErrListTooBigFn("BlockBody.Votes", size, 4096)
+		return
+	}
+	for ii := 0; ii < len(b.Votes); ii++ {
+		if dst, err = b.Votes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlockBody object
+func (b *BlockBody) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Votes'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Votes'
+	{
+		buf = tail[o0:]
+		num, err := ssz.DivideInt2(len(buf), 136, 4096)
+		if err != nil {
+			return err
+		}
+		b.Votes = make([]*Vote, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Votes[ii] == nil {
+				b.Votes[ii] = new(Vote)
+			}
+			if err = b.Votes[ii].UnmarshalSSZ(buf[ii*136 : (ii+1)*136]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlockBody object
+func (b *BlockBody) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Votes'
+	size += len(b.Votes) * 136
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlockBody object
+func (b *BlockBody) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlockBody object with a hasher
+func (b *BlockBody) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Votes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Votes))
+		if num > 4096 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Votes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4096)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BlockBody object
+func (b *BlockBody) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the SignedBlock object
+func (s *SignedBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlock object to a target array
+func (s *SignedBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(36)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedBlock.Signature", size, 32)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBlock object
+func (s *SignedBlock) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 36 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 36 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:36]))
+	}
+	s.Signature = append(s.Signature, buf[4:36]...)
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if s.Message == nil {
+			s.Message = new(Block)
+		}
+		if err = s.Message.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlock object
+func (s *SignedBlock) SizeSSZ() (size int) {
+	size = 36
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(Block)
+	}
+	size += s.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlock object
+func (s *SignedBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlock object with a hasher
+func (s *SignedBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedBlock.Signature", size, 32)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBlock object
+func (s *SignedBlock) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the SignedBlockDevnet1 object
+func (s *SignedBlockDevnet1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlockDevnet1 object to a target array
+func (s *SignedBlockDevnet1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Message == nil {
+		s.Message = new(Block)
+	}
+	offset += s.Message.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 4096 {
+		err = ssz.ErrBytesLengthFn("SignedBlockDevnet1.Signature", size, 4096)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBlockDevnet1 object
+func (s *SignedBlockDevnet1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:o1]
+		if s.Message == nil {
+			s.Message = new(Block)
+		}
+		if err = s.Message.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 4096 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlockDevnet1 object
+func (s *SignedBlockDevnet1) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(Block)
+	}
+	size += s.Message.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlockDevnet1 object
+func (s *SignedBlockDevnet1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlockDevnet1 object with a hasher
+func (s *SignedBlockDevnet1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 4096 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (4096+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBlockDevnet1 object
+func (s *SignedBlockDevnet1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the Vote object
+func (v *Vote) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the Vote object to a target array
+func (v *Vote) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorId'
+	dst = ssz.MarshalUint64(dst, v.ValidatorId)
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, v.Slot)
+
+	// Field (2) 'Head'
+	if v.Head == nil {
+		v.Head = new(Checkpoint)
+	}
+	if dst, err = v.Head.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Target'
+	if v.Target == nil {
+		v.Target = new(Checkpoint)
+	}
+	if dst, err = v.Target.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'Source'
+	if v.Source == nil {
+		v.Source = new(Checkpoint)
+	}
+	if dst, err = v.Source.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Vote object
+func (v *Vote) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 136 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorId'
+	v.ValidatorId = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Slot'
+	v.Slot = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Head'
+	if v.Head == nil {
+		v.Head = new(Checkpoint)
+	}
+	if err = v.Head.UnmarshalSSZ(buf[16:56]); err != nil {
+		return err
+	}
+
+	// Field (3) 'Target'
+	if v.Target == nil {
+		v.Target = new(Checkpoint)
+	}
+	if err = v.Target.UnmarshalSSZ(buf[56:96]); err != nil {
+		return err
+	}
+
+	// Field (4) 'Source'
+	if v.Source == nil {
+		v.Source = new(Checkpoint)
+	}
+	if err = v.Source.UnmarshalSSZ(buf[96:136]); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Vote object
+func (v *Vote) SizeSSZ() (size int) {
+	size = 136
+	return
+}
+
+// HashTreeRoot ssz hashes the Vote object
+func (v *Vote) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the Vote object with a hasher
+func (v *Vote) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorId'
+	hh.PutUint64(v.ValidatorId)
+
+	// Field (1) 'Slot'
+	hh.PutUint64(v.Slot)
+
+	// Field (2) 'Head'
+	if v.Head == nil {
+		v.Head = new(Checkpoint)
+	}
+	if err = v.Head.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'Target'
+	if v.Target == nil {
+		v.Target = new(Checkpoint)
+	}
+	if err = v.Target.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'Source'
+	if v.Source == nil {
+		v.Source = new(Checkpoint)
+	}
+	if err = v.Source.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Vote object
+func (v *Vote) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// MarshalSSZ ssz marshals the SignedVote object
+func (s *SignedVote) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedVote object to a target array
+func (s *SignedVote) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Data'
+	if s.Data == nil {
+		s.Data = new(Vote)
+	}
+	if dst, err = s.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedVote.Signature", size, 32)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedVote object
+func (s *SignedVote) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 168 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Data'
+	if s.Data == nil {
+		s.Data = new(Vote)
+	}
+	if err = s.Data.UnmarshalSSZ(buf[0:136]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[136:168]))
+	}
+	s.Signature = append(s.Signature, buf[136:168]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedVote object
+func (s *SignedVote) SizeSSZ() (size int) {
+	size = 168
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedVote object
+func (s *SignedVote) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedVote object with a hasher
+func (s *SignedVote) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Data'
+	if s.Data == nil {
+		s.Data = new(Vote)
+	}
+	if err = s.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 32 {
+		err = ssz.ErrBytesLengthFn("SignedVote.Signature", size, 32)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedVote object
+func (s *SignedVote) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
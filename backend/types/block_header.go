@@ -16,15 +16,15 @@ type BlockHeader struct {
 
 // blockHeaderJSON is used for JSON marshaling/unmarshaling with hex strings
 type blockHeaderJSON struct {
-	Slot          uint64 `json:"slot"`
-	ProposerIndex uint64 `json:"proposer_index"`
-	ParentRoot    string `json:"parent_root"`
-	StateRoot     string `json:"state_root"`
-	BodyRoot      string `json:"body_root"`
+	Slot          flexUint64 `json:"slot"`
+	ProposerIndex flexUint64 `json:"proposer_index"`
+	ParentRoot    string     `json:"parent_root"`
+	StateRoot     string     `json:"state_root"`
+	BodyRoot      string     `json:"body_root"`
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for BlockHeader
-// This handles the conversion of hex strings to byte arrays
+// This handles the conversion of hex strings to byte arrays, uint64s may be numbers or strings
 func (bh *BlockHeader) UnmarshalJSON(data []byte) error {
 	var jsonHeader blockHeaderJSON
 	if err := json.Unmarshal(data, &jsonHeader); err != nil {
@@ -32,8 +32,8 @@ func (bh *BlockHeader) UnmarshalJSON(data []byte) error {
 	}
 
 	// Convert basic fields
-	bh.Slot = jsonHeader.Slot
-	bh.ProposerIndex = jsonHeader.ProposerIndex
+	bh.Slot = uint64(jsonHeader.Slot)
+	bh.ProposerIndex = uint64(jsonHeader.ProposerIndex)
 
 	// Convert hex strings to bytes
 	var err error
@@ -57,8 +57,8 @@ func (bh *BlockHeader) UnmarshalJSON(data []byte) error {
 // This converts byte arrays back to hex strings for JSON output
 func (bh BlockHeader) MarshalJSON() ([]byte, error) {
 	jsonHeader := blockHeaderJSON{
-		Slot:          bh.Slot,
-		ProposerIndex: bh.ProposerIndex,
+		Slot:          flexUint64(bh.Slot),
+		ProposerIndex: flexUint64(bh.ProposerIndex),
 		ParentRoot:    bytesToHex(bh.ParentRoot),
 		StateRoot:     bytesToHex(bh.StateRoot),
 		BodyRoot:      bytesToHex(bh.BodyRoot),
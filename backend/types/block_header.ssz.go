@@ -96,7 +96,7 @@ func (b *BlockHeader) HashTreeRoot() ([32]byte, error) {
 }
 
 // HashTreeRootWith ssz hashes the BlockHeader object with a hasher
-func (b *BlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+func (b *BlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'Slot'
@@ -128,4 +128,9 @@ func (b *BlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 
 	hh.Merkleize(indx)
 	return
-}
\ No newline at end of file
+}
+
+// GetTree ssz hashes the BlockHeader object
+func (b *BlockHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
@@ -0,0 +1,43 @@
+package types
+
+// BlockSignature is the proposer signature of a full block fetched from a client, with the
+// sizes of the signature and of the signed block in the layout of its fork
+type BlockSignature struct {
+	BlockRoot     []byte `db:"block_root"`
+	Slot          uint64 `db:"slot"`
+	ProposerIndex uint64 `db:"proposer_index"`
+	Fork          string `db:"fork"`
+	Signature     []byte `db:"signature"`
+	SignatureSize uint64 `db:"signature_size"`
+	BlockSize     uint64 `db:"block_size"` // Length of the SSZ encoded signed block
+	Votes         uint64 `db:"votes"`
+	Client        string `db:"client"`      // Client the block was first fetched from
+	ObservedAt    int64  `db:"observed_at"` // Unix timestamp in milliseconds
+}
+
+// SizeStats summarizes the signature and block sizes of a set of stored blocks
+type SizeStats struct {
+	Blocks           uint64  `db:"blocks"`
+	FirstSlot        uint64  `db:"first_slot"`
+	LastSlot         uint64  `db:"last_slot"`
+	MinSignatureSize uint64  `db:"min_signature_size"`
+	MaxSignatureSize uint64  `db:"max_signature_size"`
+	AvgSignatureSize float64 `db:"avg_signature_size"`
+	MinBlockSize     uint64  `db:"min_block_size"`
+	MaxBlockSize     uint64  `db:"max_block_size"`
+	AvgBlockSize     float64 `db:"avg_block_size"`
+	TotalBytes       uint64  `db:"total_bytes"` // Sum of the block sizes
+}
+
+// SizeBucket is the size statistics of the blocks of one fork in a slot range
+type SizeBucket struct {
+	StartSlot uint64 `db:"start_slot"`
+	Fork      string `db:"fork"`
+	SizeStats
+}
+
+// ForkSizeStats is the size statistics of all stored blocks of a fork
+type ForkSizeStats struct {
+	Fork string `db:"fork"`
+	SizeStats
+}
@@ -1,7 +1,42 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // Checkpoint represents a justified or finalized checkpoint in Lean consensus
 type Checkpoint struct {
-	Root []byte `json:"root"`
+	Root []byte `json:"root" ssz-size:"32"`
 	Slot uint64 `json:"slot"`
 }
+
+// checkpointJSON is used for JSON marshaling/unmarshaling with a hex root
+type checkpointJSON struct {
+	Root string     `json:"root"`
+	Slot flexUint64 `json:"slot"`
+}
+
+// UnmarshalJSON decodes the hex root, the slot may be a number or a string
+func (c *Checkpoint) UnmarshalJSON(data []byte) error {
+	var jsonCheckpoint checkpointJSON
+	if err := json.Unmarshal(data, &jsonCheckpoint); err != nil {
+		return fmt.Errorf("failed to unmarshal checkpoint JSON: %w", err)
+	}
+
+	root, err := hexToBytes(jsonCheckpoint.Root)
+	if err != nil {
+		return fmt.Errorf("failed to decode root: %w", err)
+	}
+	c.Root = root
+	c.Slot = uint64(jsonCheckpoint.Slot)
+	return nil
+}
+
+// MarshalJSON encodes the root as a hex string
+func (c Checkpoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(checkpointJSON{
+		Root: bytesToHex(c.Root),
+		Slot: flexUint64(c.Slot),
+	})
+}
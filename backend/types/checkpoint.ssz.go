@@ -0,0 +1,81 @@
+package types
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Checkpoint object
+func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the Checkpoint object to a target array
+func (c *Checkpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Root'
+	if size := len(c.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("Checkpoint.Root", size, 32)
+		return
+	}
+	dst = append(dst, c.Root...)
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, c.Slot)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Checkpoint object
+func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Root'
+	if cap(c.Root) == 0 {
+		c.Root = make([]byte, 0, len(buf[0:32]))
+	}
+	c.Root = append(c.Root, buf[0:32]...)
+
+	// Field (1) 'Slot'
+	c.Slot = ssz.UnmarshallUint64(buf[32:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Checkpoint object
+func (c *Checkpoint) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the Checkpoint object
+func (c *Checkpoint) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the Checkpoint object with a hasher
+func (c *Checkpoint) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Root'
+	if size := len(c.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("Checkpoint.Root", size, 32)
+		return
+	}
+	hh.PutBytes(c.Root)
+
+	// Field (1) 'Slot'
+	hh.PutUint64(c.Slot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Checkpoint object
+func (c *Checkpoint) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
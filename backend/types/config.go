@@ -1,17 +1,245 @@
 package types
 
+import (
+	"net/url"
+	"time"
+)
+
 type Config struct {
+	Server ServerConfig `yaml:"server"`
+
+	Logging LoggingConfig `yaml:"logging"`
+
 	LeanApi struct {
 		Endpoint  string           `yaml:"endpoint" envconfig:"LEANAPI_ENDPOINT"`
 		Endpoints []EndpointConfig `yaml:"endpoints"`
 	} `yaml:"leanapi"`
 
+	Indexer IndexerConfig `yaml:"indexer"`
+
+	Chain ChainConfig `yaml:"chain"`
+
 	Database DatabaseConfig `yaml:"database"`
+
+	Health HealthConfig `yaml:"health"`
 }
 
 type EndpointConfig struct {
 	Url  string `yaml:"url"`
 	Name string `yaml:"name"`
+
+	// Headers are added to every request sent to the endpoint. Like auth secrets, a value
+	// can instead be read from a file or environment variable by header name, it is
+	// resolved into Headers when the config is read.
+	Headers     map[string]string `yaml:"headers"`
+	HeaderFiles map[string]string `yaml:"headerFiles"`
+	HeaderEnv   map[string]string `yaml:"headerEnv"`
+
+	// Auth is sent as an Authorization header on every request
+	Auth EndpointAuthConfig `yaml:"auth"`
+
+	TLS EndpointTLSConfig `yaml:"tls"`
+
+	// Proxy is the URL of the proxy requests are sent through, empty uses the
+	// HTTP_PROXY/HTTPS_PROXY environment variables and "none" connects directly
+	Proxy string `yaml:"proxy"`
+
+	// Timeout and HealthTimeout override indexer.httpTimeout and indexer.healthTimeout
+	Timeout       time.Duration `yaml:"timeout"`
+	HealthTimeout time.Duration `yaml:"healthTimeout"`
+}
+
+// EndpointProxyNone disables the environment proxy for an endpoint
+const EndpointProxyNone = "none"
+
+// redactedValue replaces secrets in endpoint URLs shown to API clients
+const redactedValue = "xxxxx"
+
+// RedactedUrl returns the endpoint URL with credentials and query parameter values
+// masked, safe to return from the API
+func (e *EndpointConfig) RedactedUrl() string {
+	parsed, err := url.Parse(e.Url)
+	if err != nil {
+		return ""
+	}
+	if parsed.User != nil {
+		// A lone username is often a token, mask it along with the password
+		parsed.User = url.User(redactedValue)
+	}
+	if parsed.RawQuery != "" {
+		query := parsed.Query()
+		for key := range query {
+			query.Set(key, redactedValue)
+		}
+		parsed.RawQuery = query.Encode()
+	}
+	return parsed.String()
+}
+
+// EndpointAuthConfig holds basic auth credentials or a bearer token. Every secret can be
+// given inline, read from a file or read from an environment variable, it is resolved
+// into the inline field when the config is read.
+type EndpointAuthConfig struct {
+	Username string `yaml:"username"`
+
+	Password     string `yaml:"password"`
+	PasswordFile string `yaml:"passwordFile"`
+	PasswordEnv  string `yaml:"passwordEnv"`
+
+	BearerToken     string `yaml:"bearerToken"`
+	BearerTokenFile string `yaml:"bearerTokenFile"`
+	BearerTokenEnv  string `yaml:"bearerTokenEnv"`
+}
+
+type EndpointTLSConfig struct {
+	// CAFile is a PEM bundle trusted in addition to the system roots, e.g. for self-signed certificates
+	CAFile string `yaml:"caFile"`
+
+	// InsecureSkipVerify disables certificate verification entirely
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify"`
+}
+
+type ServerConfig struct {
+	// Host is the address to listen on, empty listens on all interfaces
+	Host string `yaml:"host" envconfig:"SERVER_HOST"`
+	Port int    `yaml:"port" envconfig:"SERVER_PORT"`
+
+	// CorsOrigins lists the origins allowed to call the API with credentials, "*" allows any
+	// other origin without credentials
+	CorsOrigins []string `yaml:"corsOrigins" envconfig:"SERVER_CORS_ORIGINS"`
+
+	ReadTimeout     time.Duration `yaml:"readTimeout" envconfig:"SERVER_READ_TIMEOUT"`
+	WriteTimeout    time.Duration `yaml:"writeTimeout" envconfig:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout     time.Duration `yaml:"idleTimeout" envconfig:"SERVER_IDLE_TIMEOUT"`
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" envconfig:"SERVER_SHUTDOWN_TIMEOUT"`
+
+	// AdminToken is the bearer token required by the AdminService, empty disables the service
+	AdminToken string `yaml:"adminToken" envconfig:"SERVER_ADMIN_TOKEN"`
+}
+
+type LoggingConfig struct {
+	// Level is one of trace, debug, info, warn, error, fatal or panic
+	Level string `yaml:"level" envconfig:"LOGGING_LEVEL"`
+
+	// Format is either text or json
+	Format string `yaml:"format" envconfig:"LOGGING_FORMAT"`
+
+	// FilePath additionally writes logs to this file, empty logs to stderr only
+	FilePath string `yaml:"filePath" envconfig:"LOGGING_FILE_PATH"`
+}
+
+type IndexerConfig struct {
+	// PollInterval is how often the head is polled, defaults to the slot duration
+	PollInterval time.Duration `yaml:"pollInterval" envconfig:"INDEXER_POLL_INTERVAL"`
+
+	// RetryDelay and MaxRetries control head fetch retries within a single poll
+	RetryDelay time.Duration `yaml:"retryDelay" envconfig:"INDEXER_RETRY_DELAY"`
+	MaxRetries int           `yaml:"maxRetries" envconfig:"INDEXER_MAX_RETRIES"`
+
+	// HTTPTimeout bounds every request made to a lean node
+	HTTPTimeout time.Duration `yaml:"httpTimeout" envconfig:"INDEXER_HTTP_TIMEOUT"`
+
+	// HealthTimeout bounds a single client health check
+	HealthTimeout time.Duration `yaml:"healthTimeout" envconfig:"INDEXER_HEALTH_TIMEOUT"`
+
+	// HealthCheckInterval is how often every client is health checked
+	HealthCheckInterval time.Duration `yaml:"healthCheckInterval" envconfig:"INDEXER_HEALTH_CHECK_INTERVAL"`
+
+	// ClientSelection picks the client for head polling and single block requests
+	ClientSelection string `yaml:"clientSelection" envconfig:"INDEXER_CLIENT_SELECTION"`
+
+	// BulkClientSelection picks the client for each catchup batch
+	BulkClientSelection string `yaml:"bulkClientSelection" envconfig:"INDEXER_BULK_CLIENT_SELECTION"`
+
+	// BreakerFailureThreshold is the number of consecutive request failures that opens a client's circuit breaker
+	BreakerFailureThreshold int `yaml:"breakerFailureThreshold" envconfig:"INDEXER_BREAKER_FAILURE_THRESHOLD"`
+
+	// BreakerBaseBackoff is the wait before the first probe of an open breaker, doubling up to BreakerMaxBackoff
+	BreakerBaseBackoff time.Duration `yaml:"breakerBaseBackoff" envconfig:"INDEXER_BREAKER_BASE_BACKOFF"`
+	BreakerMaxBackoff  time.Duration `yaml:"breakerMaxBackoff" envconfig:"INDEXER_BREAKER_MAX_BACKOFF"`
+
+	// SyncingDistance is how many slots a client may trail the network head and still be healthy
+	SyncingDistance uint64 `yaml:"syncingDistance" envconfig:"INDEXER_SYNCING_DISTANCE"`
+
+	// StallSlots is how many slots a trailing client's head may stay unchanged before it is stalled
+	StallSlots uint64 `yaml:"stallSlots" envconfig:"INDEXER_STALL_SLOTS"`
+
+	// RecordFile records all lean node traffic to this archive file, replacing it
+	RecordFile string `yaml:"recordFile" envconfig:"INDEXER_RECORD_FILE"`
+
+	// ReplayFile replays a recorded archive instead of contacting the configured endpoints
+	ReplayFile string `yaml:"replayFile" envconfig:"INDEXER_REPLAY_FILE"`
+
+	// ReplaySpeed is how many times faster than recorded the archive is replayed
+	ReplaySpeed float64 `yaml:"replaySpeed" envconfig:"INDEXER_REPLAY_SPEED"`
+
+	// VerifyStateTransition replays indexed blocks through the local state transition and
+	// flags blocks with a wrong state root, it needs chain.genesisTime and the validator count
+	VerifyStateTransition bool `yaml:"verifyStateTransition" envconfig:"INDEXER_VERIFY_STATE_TRANSITION"`
+
+	// IntegrityCheckInterval is how often the stored chain is checked for consistency, 0 disables the check
+	IntegrityCheckInterval time.Duration `yaml:"integrityCheckInterval" envconfig:"INDEXER_INTEGRITY_CHECK_INTERVAL"`
+
+	// IntegrityRefetch replaces ranges with integrity issues with the headers of a healthy client
+	IntegrityRefetch bool `yaml:"integrityRefetch" envconfig:"INDEXER_INTEGRITY_REFETCH"`
+
+	// QuorumSize is how many healthy clients catchup fetches each header from, the header
+	// with a majority of the roots is stored. 0 or 1 trusts a single client.
+	QuorumSize int `yaml:"quorumSize" envconfig:"INDEXER_QUORUM_SIZE"`
+
+	// QuorumFinalizedOnly applies the quorum only to ranges at or before the finalized slot
+	QuorumFinalizedOnly bool `yaml:"quorumFinalizedOnly" envconfig:"INDEXER_QUORUM_FINALIZED_ONLY"`
+
+	// AlertWebhook receives alerts, e.g. equivocations, as JSON POST requests. Alerts are
+	// always logged as errors.
+	AlertWebhook string `yaml:"alertWebhook" envconfig:"INDEXER_ALERT_WEBHOOK"`
+
+	// StateSnapshotInterval is how often the head state is fetched from a client and stored,
+	// 0 disables snapshots
+	StateSnapshotInterval time.Duration `yaml:"stateSnapshotInterval" envconfig:"INDEXER_STATE_SNAPSHOT_INTERVAL"`
+
+	// StateSnapshotKeep is how many snapshots with the highest slots are kept, 0 keeps all
+	StateSnapshotKeep int `yaml:"stateSnapshotKeep" envconfig:"INDEXER_STATE_SNAPSHOT_KEEP"`
+}
+
+// Client selection strategies for IndexerConfig
+const (
+	ClientSelectionPrimary       = "primary"        // Primary client, falling back to the next healthy client
+	ClientSelectionHighestHead   = "highest-head"   // Client that last reported the highest head
+	ClientSelectionLowestLatency = "lowest-latency" // Client with the lowest average request time
+	ClientSelectionRoundRobin    = "round-robin"    // Each healthy client in turn
+)
+
+type ChainConfig struct {
+	// GenesisTime is the unix timestamp in seconds of slot 0, 0 disables the wall clock
+	GenesisTime uint64 `yaml:"genesisTime" envconfig:"CHAIN_GENESIS_TIME"`
+
+	// SlotDuration is the length of a slot
+	SlotDuration time.Duration `yaml:"slotDuration" envconfig:"CHAIN_SLOT_DURATION"`
+
+	// ValidatorCount drives round robin proposer assignment, 0 infers it from the
+	// validator config or from indexed proposers
+	ValidatorCount uint64 `yaml:"validatorCount" envconfig:"CHAIN_VALIDATOR_COUNT"`
+
+	// ValidatorConfig is the path of the YAML file assigning validator index ranges to clients
+	ValidatorConfig string `yaml:"validatorConfig" envconfig:"CHAIN_VALIDATOR_CONFIG"`
+
+	// Validators is loaded from ValidatorConfig
+	Validators *ValidatorConfig `yaml:"-" ignored:"true"`
+
+	// Fork is the container layout of the network from genesis, devnet0 or devnet1
+	Fork string `yaml:"fork" envconfig:"CHAIN_FORK"`
+
+	// ForkActivations switch the container layout at later slots, e.g. a devnet upgraded in place
+	ForkActivations []ForkActivation `yaml:"forkActivations"`
+}
+
+type HealthConfig struct {
+	// MaxHeadLagSlots is how far the head may trail the wall clock slot before the service is not ready
+	MaxHeadLagSlots uint64 `yaml:"maxHeadLagSlots" envconfig:"HEALTH_MAX_HEAD_LAG_SLOTS"`
+
+	// MaxPollAge is how long ago the last successful poll may be before the service is not ready
+	MaxPollAge time.Duration `yaml:"maxPollAge" envconfig:"HEALTH_MAX_POLL_AGE"`
 }
 
 type DatabaseConfig struct {
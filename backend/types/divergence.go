@@ -0,0 +1,15 @@
+package types
+
+// Divergence is a client whose header at a slot disagreed with the majority of a quorum read.
+// Roots and SSZ encoded headers are nil where a side has no block at the slot.
+type Divergence struct {
+	Slot           uint64 `db:"slot"`
+	Client         string `db:"client"`
+	Root           []byte `db:"root"`            // Root of the client's header
+	Header         []byte `db:"header"`          // SSZ encoded header of the client
+	MajorityRoot   []byte `db:"majority_root"`   // Root of the stored header
+	MajorityHeader []byte `db:"majority_header"` // SSZ encoded stored header
+	Agreeing       int    `db:"agreeing"`        // Clients that returned the majority header
+	Queried        int    `db:"queried"`         // Clients that answered the quorum read
+	DetectedAt     int64  `db:"detected_at"`     // Unix timestamp in milliseconds
+}
@@ -2,10 +2,36 @@ package types
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
+// flexUint64 is a uint64 that unmarshals from a JSON number or a decimal string,
+// beacon API style clients encode uint64s as strings
+type flexUint64 uint64
+
+// UnmarshalJSON accepts both 5 and "5"
+func (u *flexUint64) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		var number uint64
+		if err := json.Unmarshal(data, &number); err != nil {
+			return fmt.Errorf("expected uint64 number or string, got %s", data)
+		}
+		*u = flexUint64(number)
+		return nil
+	}
+
+	number, err := strconv.ParseUint(text, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid uint64 string %q: %w", text, err)
+	}
+	*u = flexUint64(number)
+	return nil
+}
+
 // hexToBytes converts a hex string (with or without 0x prefix) to bytes
 func hexToBytes(hexStr string) ([]byte, error) {
 	// Remove 0x prefix if present
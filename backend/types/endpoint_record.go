@@ -0,0 +1,17 @@
+package types
+
+// EndpointRecord is a runtime change to the endpoint list stored in the database.
+//
+// Records of endpoints added at runtime carry the full endpoint definition, their options
+// YAML encoded with secrets only as file or environment references. Records of
+// endpoints from the config file only carry the removed and primary flags, the URL
+// in the config file stays authoritative for them.
+type EndpointRecord struct {
+	Name       string `db:"name"`
+	Url        string `db:"url"`
+	Options    string `db:"options"`
+	FromConfig bool   `db:"from_config"`
+	Removed    bool   `db:"removed"`
+	IsPrimary  bool   `db:"is_primary"`
+	UpdatedAt  int64  `db:"updated_at"` // Unix timestamp in milliseconds
+}
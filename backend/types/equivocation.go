@@ -0,0 +1,24 @@
+package types
+
+// Kinds of equivocation the detector finds
+const (
+	EquivocationDoubleProposal = "double_proposal" // Two blocks of a proposer for the same slot
+	EquivocationDoubleVote     = "double_vote"     // Two different votes of a validator for the same slot
+	EquivocationSurroundVote   = "surround_vote"   // A vote whose source and target surround another vote of the validator
+)
+
+// Equivocation is evidence of a validator signing two conflicting messages, block headers
+// for double proposals and votes otherwise. A is the message observed first, for surround
+// votes it is the surrounding vote.
+type Equivocation struct {
+	Kind        string `db:"kind"`
+	ValidatorId uint64 `db:"validator_id"` // Proposer index or voting validator
+	Slot        uint64 `db:"slot"`         // Slot of message A
+	RootA       []byte `db:"root_a"`       // Hash tree root of the header or vote
+	RootB       []byte `db:"root_b"`
+	EvidenceA   []byte `db:"evidence_a"` // SSZ encoded header or vote
+	EvidenceB   []byte `db:"evidence_b"`
+	ClientA     string `db:"client_a"` // Client the message was observed from
+	ClientB     string `db:"client_b"`
+	DetectedAt  int64  `db:"detected_at"` // Unix timestamp in milliseconds
+}
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Devnet forks, each with its own signed block layout
+const (
+	ForkDevnet0 = "devnet0" // Bytes32 placeholder signatures
+	ForkDevnet1 = "devnet1" // Post-quantum signatures of around 3 KiB
+)
+
+// Devnet1MaxSignatureSize is the largest signature a Devnet 1 block may carry
+const Devnet1MaxSignatureSize = 4096
+
+// ForkVersion is the container layout of a fork
+type ForkVersion struct {
+	Name string
+
+	// MaxSignatureSize is the largest block signature, FixedSignatureSize requires exactly that size
+	MaxSignatureSize   int
+	FixedSignatureSize bool
+
+	// SSZ codec of the fork's signed block layout
+	unmarshalSignedBlock func(data []byte) (*SignedBlock, error)
+	marshalSignedBlock   func(signedBlock *SignedBlock) ([]byte, error)
+	signedBlockSize      func(signedBlock *SignedBlock) int
+}
+
+// forkVersions is the registry of known forks by name
+var forkVersions = map[string]*ForkVersion{
+	ForkDevnet0: {
+		Name:               ForkDevnet0,
+		MaxSignatureSize:   32,
+		FixedSignatureSize: true,
+		unmarshalSignedBlock: func(data []byte) (*SignedBlock, error) {
+			var signedBlock SignedBlock
+			if err := signedBlock.UnmarshalSSZ(data); err != nil {
+				return nil, err
+			}
+			return &signedBlock, nil
+		},
+		marshalSignedBlock: func(signedBlock *SignedBlock) ([]byte, error) {
+			return signedBlock.MarshalSSZ()
+		},
+		signedBlockSize: func(signedBlock *SignedBlock) int {
+			return signedBlock.SizeSSZ()
+		},
+	},
+	ForkDevnet1: {
+		Name:             ForkDevnet1,
+		MaxSignatureSize: Devnet1MaxSignatureSize,
+		unmarshalSignedBlock: func(data []byte) (*SignedBlock, error) {
+			var signedBlock SignedBlockDevnet1
+			if err := signedBlock.UnmarshalSSZ(data); err != nil {
+				return nil, err
+			}
+			return &SignedBlock{Message: signedBlock.Message, Signature: signedBlock.Signature}, nil
+		},
+		marshalSignedBlock: func(signedBlock *SignedBlock) ([]byte, error) {
+			return (&SignedBlockDevnet1{Message: signedBlock.Message, Signature: signedBlock.Signature}).MarshalSSZ()
+		},
+		signedBlockSize: func(signedBlock *SignedBlock) int {
+			return (&SignedBlockDevnet1{Message: signedBlock.Message, Signature: signedBlock.Signature}).SizeSSZ()
+		},
+	},
+}
+
+// GetForkVersion returns the fork with the given name, nil if unknown
+func GetForkVersion(name string) *ForkVersion {
+	return forkVersions[name]
+}
+
+// ForkNames returns the names of all known forks, sorted
+func ForkNames() []string {
+	names := make([]string, 0, len(forkVersions))
+	for name := range forkVersions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// UnmarshalSignedBlock decodes an SSZ signed block in the fork's layout
+func (fv *ForkVersion) UnmarshalSignedBlock(data []byte) (*SignedBlock, error) {
+	signedBlock, err := fv.unmarshalSignedBlock(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s signed block: %w", fv.Name, err)
+	}
+	return signedBlock, nil
+}
+
+// MarshalSignedBlock encodes a signed block in the fork's SSZ layout
+func (fv *ForkVersion) MarshalSignedBlock(signedBlock *SignedBlock) ([]byte, error) {
+	if err := fv.ValidateSignature(signedBlock.Signature); err != nil {
+		return nil, err
+	}
+	return fv.marshalSignedBlock(signedBlock)
+}
+
+// SignedBlockSize returns the size of a signed block in the fork's SSZ layout
+func (fv *ForkVersion) SignedBlockSize(signedBlock *SignedBlock) int {
+	return fv.signedBlockSize(signedBlock)
+}
+
+// ValidateSignature checks that a block signature fits the fork's layout
+func (fv *ForkVersion) ValidateSignature(signature []byte) error {
+	if fv.FixedSignatureSize && len(signature) != fv.MaxSignatureSize {
+		return fmt.Errorf("%s signature must be %d bytes, got %d", fv.Name, fv.MaxSignatureSize, len(signature))
+	}
+	if len(signature) > fv.MaxSignatureSize {
+		return fmt.Errorf("%s signature must be at most %d bytes, got %d", fv.Name, fv.MaxSignatureSize, len(signature))
+	}
+	return nil
+}
+
+// ForkActivation activates a fork from a slot on
+type ForkActivation struct {
+	Fork string `yaml:"fork"`
+	Slot uint64 `yaml:"slot"`
+}
+
+// ForkSchedule selects the fork of a slot, the network fork from genesis followed by the
+// activations. A nil schedule is Devnet 0 from genesis.
+type ForkSchedule struct {
+	activations []ForkActivation // Sorted by slot, the first activates at slot 0
+}
+
+// NewForkSchedule creates a schedule starting with the network fork at genesis, Devnet 0 if
+// empty, followed by the activations
+func NewForkSchedule(network string, activations []ForkActivation) (*ForkSchedule, error) {
+	if network == "" {
+		network = ForkDevnet0
+	}
+	if GetForkVersion(network) == nil {
+		return nil, fmt.Errorf("unknown fork %q, known forks are %v", network, ForkNames())
+	}
+
+	schedule := &ForkSchedule{
+		activations: make([]ForkActivation, 0, len(activations)+1),
+	}
+	schedule.activations = append(schedule.activations, ForkActivation{Fork: network})
+	sorted := slices.Clone(activations)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Slot < sorted[j].Slot })
+	for _, activation := range sorted {
+		if GetForkVersion(activation.Fork) == nil {
+			return nil, fmt.Errorf("unknown fork %q at slot %d, known forks are %v", activation.Fork, activation.Slot, ForkNames())
+		}
+		if activation.Slot == 0 {
+			return nil, fmt.Errorf("fork %q activates at slot 0, set it as the network fork instead", activation.Fork)
+		}
+		if last := schedule.activations[len(schedule.activations)-1]; last.Slot == activation.Slot {
+			return nil, fmt.Errorf("forks %q and %q both activate at slot %d", last.Fork, activation.Fork, activation.Slot)
+		}
+		schedule.activations = append(schedule.activations, activation)
+	}
+	return schedule, nil
+}
+
+// Activations returns the forks of the schedule by activation slot, starting at slot 0
+func (fs *ForkSchedule) Activations() []ForkActivation {
+	if fs == nil {
+		return []ForkActivation{{Fork: ForkDevnet0}}
+	}
+	return slices.Clone(fs.activations)
+}
+
+// ForkAt returns the fork active at a slot
+func (fs *ForkSchedule) ForkAt(slot uint64) *ForkVersion {
+	if fs == nil {
+		return forkVersions[ForkDevnet0]
+	}
+
+	name := fs.activations[0].Fork
+	for _, activation := range fs.activations[1:] {
+		if activation.Slot > slot {
+			break
+		}
+		name = activation.Fork
+	}
+	return forkVersions[name]
+}
+
+// UnmarshalSignedBlock decodes an SSZ signed block in the layout of the fork active at its
+// slot. Every layout starts with the offset of the message, which starts with the slot.
+func (fs *ForkSchedule) UnmarshalSignedBlock(data []byte) (*SignedBlock, *ForkVersion, error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("signed block of %d bytes is too short", len(data))
+	}
+	offset := uint64(binary.LittleEndian.Uint32(data[:4]))
+	if offset+8 > uint64(len(data)) {
+		return nil, nil, fmt.Errorf("signed block message offset %d is out of bounds", offset)
+	}
+	slot := binary.LittleEndian.Uint64(data[offset : offset+8])
+
+	fork := fs.ForkAt(slot)
+	signedBlock, err := fork.UnmarshalSignedBlock(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signedBlock, fork, nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import "time"
+
+// Kinds of inconsistencies the chain verifier finds in the stored block headers
+const (
+	IntegrityIssueInvalidRootLength  = "invalid_root_length"  // A root of the header is not 32 bytes
+	IntegrityIssueSlotOrder          = "slot_order"           // The slot does not increase over the previous header
+	IntegrityIssueParentMismatch     = "parent_mismatch"      // The parent root is not the root of the previous header
+	IntegrityIssueDuplicateParent    = "duplicate_parent"     // An earlier header has the same parent root
+	IntegrityIssueDuplicateStateRoot = "duplicate_state_root" // An earlier header has the same state root
+	IntegrityIssueProposerMismatch   = "proposer_mismatch"    // The proposer is not the round robin proposer of the slot
+)
+
+// IntegrityIssue is an inconsistency of the stored header at a slot
+type IntegrityIssue struct {
+	Slot        uint64 `db:"slot"`
+	Kind        string `db:"kind"`
+	RelatedSlot uint64 `db:"related_slot"` // Earlier header the issue involves, 0 if none
+	Detail      string `db:"detail"`
+	DetectedAt  int64  `db:"detected_at"` // Unix timestamp in milliseconds
+}
+
+// SlotRange is an inclusive range of slots
+type SlotRange struct {
+	Start uint64
+	End   uint64
+}
+
+// IntegrityReport is the outcome of a chain verification run
+type IntegrityReport struct {
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	FromSlot       uint64
+	LastSlot       uint64 // Slot of the last checked header
+	HeadersChecked uint64
+	Issues         []*IntegrityIssue // Issues left after refetching, in slot order
+	Refetched      []SlotRange       // Ranges replaced with headers from a healthy client
+}
@@ -0,0 +1,20 @@
+package types
+
+// Reasons an indexed block was flagged by the state transition verifier
+const (
+	InvalidBlockStateRootMismatch = "state_root_mismatch" // The block's state root differs from the computed post-state
+	InvalidBlockRejected          = "rejected"            // The state transition rejected the block
+	InvalidBlockGenesisMismatch   = "genesis_mismatch"    // The first indexed block does not build on the local genesis block
+)
+
+// InvalidBlock is an indexed block the local state transition flagged
+type InvalidBlock struct {
+	Root              []byte `db:"root"`
+	Slot              uint64 `db:"slot"`
+	Reason            string `db:"reason"`
+	StateRoot         []byte `db:"state_root"`          // State root claimed by the block
+	ComputedStateRoot []byte `db:"computed_state_root"` // Nil if the block was rejected
+	Error             string `db:"error"`               // Why the block was rejected
+	Client            string `db:"client"`              // Client the full block was fetched from
+	DetectedAt        int64  `db:"detected_at"`         // Unix timestamp in milliseconds
+}
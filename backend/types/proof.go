@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// BlockHeaderFields names the fields of BlockHeader in SSZ order, as in JSON
+var BlockHeaderFields = []string{"slot", "proposer_index", "parent_root", "state_root", "body_root"}
+
+// StateFields names the fields of State in SSZ order, as in JSON
+var StateFields = []string{
+	"config",
+	"slot",
+	"latest_block_header",
+	"latest_justified",
+	"latest_finalized",
+	"historical_block_hashes",
+	"justified_slots",
+	"justifications_roots",
+	"justifications_validators",
+}
+
+// FieldProof is a Merkle proof of the hash tree root of a container field against the
+// hash tree root of the container
+type FieldProof struct {
+	Field            string   `json:"field"`
+	GeneralizedIndex int      `json:"generalized_index"`
+	Leaf             []byte   `json:"leaf"`   // Hash tree root of the field
+	Branch           [][]byte `json:"branch"` // Sibling hashes from the leaf up to the root
+}
+
+// Verify checks the proof against the hash tree root of the container
+func (p *FieldProof) Verify(root []byte) (bool, error) {
+	return ssz.VerifyProof(root, &ssz.Proof{
+		Index:  p.GeneralizedIndex,
+		Leaf:   p.Leaf,
+		Hashes: p.Branch,
+	})
+}
+
+// ProveFields returns a proof for every field of the block header in SSZ order
+func (b *BlockHeader) ProveFields() ([]*FieldProof, error) {
+	return proveFields(b, BlockHeaderFields)
+}
+
+// ProveFields returns a proof for every field of the state in SSZ order
+func (s *State) ProveFields() ([]*FieldProof, error) {
+	return proveFields(s, StateFields)
+}
+
+// DiffFieldProofs returns the pairs of proofs whose fields differ, the proofs must be of
+// the same container type
+func DiffFieldProofs(a, b []*FieldProof) ([][2]*FieldProof, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("cannot compare %d field proofs with %d", len(a), len(b))
+	}
+
+	var diffs [][2]*FieldProof
+	for i := range a {
+		if a[i].Field != b[i].Field {
+			return nil, fmt.Errorf("field %d is %s in one container and %s in the other", i, a[i].Field, b[i].Field)
+		}
+		if !bytes.Equal(a[i].Leaf, b[i].Leaf) {
+			diffs = append(diffs, [2]*FieldProof{a[i], b[i]})
+		}
+	}
+	return diffs, nil
+}
+
+// proveFields builds the proof tree of a container and proves each of its fields. Fields
+// are the leaves of the container's tree, padded to a power of two, so field i is at the
+// generalized index width + i.
+func proveFields(container ssz.HashRoot, fields []string) ([]*FieldProof, error) {
+	tree, err := container.GetTree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build proof tree: %w", err)
+	}
+
+	width := 1
+	if len(fields) > 1 {
+		width = 1 << bits.Len(uint(len(fields)-1))
+	}
+
+	proofs := make([]*FieldProof, 0, len(fields))
+	for i, field := range fields {
+		proof, err := tree.Prove(width + i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prove field %s: %w", field, err)
+		}
+		proofs = append(proofs, &FieldProof{
+			Field:            field,
+			GeneralizedIndex: proof.Index,
+			Leaf:             proof.Leaf,
+			Branch:           proof.Hashes,
+		})
+	}
+	return proofs, nil
+}
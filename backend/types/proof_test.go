@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestBlockHeaderFieldProofs(t *testing.T) {
+	header := &BlockHeader{
+		Slot:          12,
+		ProposerIndex: 3,
+		ParentRoot:    bytes.Repeat([]byte{0x01}, 32),
+		StateRoot:     bytes.Repeat([]byte{0x02}, 32),
+		BodyRoot:      bytes.Repeat([]byte{0x03}, 32),
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("hashing header: %v", err)
+	}
+	proofs, err := header.ProveFields()
+	if err != nil {
+		t.Fatalf("proving header fields: %v", err)
+	}
+
+	// Five fields pad to eight leaves, so the fields are at generalized indices 8 to 12
+	uint64Leaf := func(value uint64) []byte {
+		leaf := make([]byte, 32)
+		binary.LittleEndian.PutUint64(leaf, value)
+		return leaf
+	}
+	leaves := [][]byte{uint64Leaf(12), uint64Leaf(3), header.ParentRoot, header.StateRoot, header.BodyRoot}
+	if len(proofs) != len(BlockHeaderFields) {
+		t.Fatalf("got %d proofs, want one per field", len(proofs))
+	}
+	for i, proof := range proofs {
+		if proof.Field != BlockHeaderFields[i] || proof.GeneralizedIndex != 8+i || len(proof.Branch) != 3 {
+			t.Errorf("proof %d is of %s at generalized index %d with %d hashes, want %s at %d with 3",
+				i, proof.Field, proof.GeneralizedIndex, len(proof.Branch), BlockHeaderFields[i], 8+i)
+		}
+		if !bytes.Equal(proof.Leaf, leaves[i]) {
+			t.Errorf("leaf of %s is %x, want %x", proof.Field, proof.Leaf, leaves[i])
+		}
+		if ok, err := proof.Verify(root[:]); err != nil || !ok {
+			t.Errorf("proof of %s does not verify: %v", proof.Field, err)
+		}
+	}
+
+	// A proof does not verify for another leaf or root
+	tampered := *proofs[3]
+	tampered.Leaf = header.BodyRoot
+	if ok, _ := tampered.Verify(root[:]); ok {
+		t.Errorf("proof of state_root verifies with another leaf")
+	}
+	if ok, _ := proofs[3].Verify(header.ParentRoot); ok {
+		t.Errorf("proof of state_root verifies against another root")
+	}
+
+	// Headers differing in one field differ in its leaf only
+	other := *header
+	other.StateRoot = bytes.Repeat([]byte{0x04}, 32)
+	otherProofs, err := other.ProveFields()
+	if err != nil {
+		t.Fatalf("proving header fields: %v", err)
+	}
+	diffs, err := DiffFieldProofs(proofs, otherProofs)
+	if err != nil {
+		t.Fatalf("diffing proofs: %v", err)
+	}
+	if len(diffs) != 1 || diffs[0][0].Field != "state_root" || diffs[0][1].GeneralizedIndex != 11 {
+		t.Errorf("got %d differences, want state_root at generalized index 11", len(diffs))
+	}
+}
+
+func TestStateFieldProofs(t *testing.T) {
+	header := &BlockHeader{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+	state := &State{
+		Config:                   &StateConfig{NumValidators: 4, GenesisTime: 1_700_000_000},
+		Slot:                     5,
+		LatestBlockHeader:        header,
+		LatestJustified:          &Checkpoint{Root: make([]byte, 32)},
+		LatestFinalized:          &Checkpoint{Root: make([]byte, 32)},
+		JustificationsValidators: []byte{0x01},
+	}
+	root, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("hashing state: %v", err)
+	}
+	proofs, err := state.ProveFields()
+	if err != nil {
+		t.Fatalf("proving state fields: %v", err)
+	}
+
+	// Nine fields pad to sixteen leaves, so the fields are at generalized indices 16 to 24
+	if len(proofs) != len(StateFields) {
+		t.Fatalf("got %d proofs, want one per field", len(proofs))
+	}
+	for i, proof := range proofs {
+		if proof.Field != StateFields[i] || proof.GeneralizedIndex != 16+i || len(proof.Branch) != 4 {
+			t.Errorf("proof %d is of %s at generalized index %d with %d hashes, want %s at %d with 4",
+				i, proof.Field, proof.GeneralizedIndex, len(proof.Branch), StateFields[i], 16+i)
+		}
+		if ok, err := proof.Verify(root[:]); err != nil || !ok {
+			t.Errorf("proof of %s does not verify: %v", proof.Field, err)
+		}
+	}
+
+	// The leaf of a container field is the container's root
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("hashing header: %v", err)
+	}
+	if !bytes.Equal(proofs[2].Leaf, headerRoot[:]) {
+		t.Errorf("leaf of latest_block_header is %x, want the header root %x", proofs[2].Leaf, headerRoot)
+	}
+}
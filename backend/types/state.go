@@ -0,0 +1,153 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// HistoricalRootsLimit bounds the block hash and justification lists of the state, HISTORICAL_ROOTS_LIMIT
+const HistoricalRootsLimit = 1 << 18
+
+// State is the Devnet 0 lean consensus state
+type State struct {
+	Config            *StateConfig `json:"config"`
+	Slot              uint64       `json:"slot"`
+	LatestBlockHeader *BlockHeader `json:"latest_block_header"`
+
+	LatestJustified *Checkpoint `json:"latest_justified"`
+	LatestFinalized *Checkpoint `json:"latest_finalized"`
+
+	// HistoricalBlockHashes has the root of every slot before the latest block, zero for
+	// missed slots. JustifiedSlots has one byte per slot, 1 if justified, which is how SSZ
+	// encodes List[bool].
+	HistoricalBlockHashes [][]byte `json:"historical_block_hashes" ssz-max:"262144" ssz-size:"?,32"`
+	JustifiedSlots        []byte   `json:"justified_slots" ssz-max:"262144"`
+
+	// Votes for roots that are not justified yet, flattened for SSZ: the bitlist holds
+	// ValidatorRegistryLimit bits per root of JustificationsRoots
+	JustificationsRoots      [][]byte `json:"justifications_roots" ssz-max:"262144" ssz-size:"?,32"`
+	JustificationsValidators []byte   `json:"justifications_validators" ssz:"bitlist" ssz-max:"1073741824"`
+}
+
+// StateConfig is the chain config stored in the state. The spec names this container Config.
+type StateConfig struct {
+	// NumValidators drives round robin block production in the absence of randao and deposits
+	NumValidators uint64 `json:"num_validators"`
+	GenesisTime   uint64 `json:"genesis_time"`
+}
+
+// stateConfigJSON is used for JSON marshaling/unmarshaling of uint64s as numbers or strings
+type stateConfigJSON struct {
+	NumValidators flexUint64 `json:"num_validators"`
+	GenesisTime   flexUint64 `json:"genesis_time"`
+}
+
+// UnmarshalJSON accepts uint64s as numbers or strings
+func (c *StateConfig) UnmarshalJSON(data []byte) error {
+	var jsonConfig stateConfigJSON
+	if err := json.Unmarshal(data, &jsonConfig); err != nil {
+		return fmt.Errorf("failed to unmarshal state config JSON: %w", err)
+	}
+	c.NumValidators = uint64(jsonConfig.NumValidators)
+	c.GenesisTime = uint64(jsonConfig.GenesisTime)
+	return nil
+}
+
+// stateJSON is used for JSON marshaling/unmarshaling with hex roots. Justified slots are a
+// list of booleans, the justification validators a hex encoded SSZ bitlist as the beacon
+// API encodes bitlists.
+type stateJSON struct {
+	Config                   *StateConfig `json:"config"`
+	Slot                     flexUint64   `json:"slot"`
+	LatestBlockHeader        *BlockHeader `json:"latest_block_header"`
+	LatestJustified          *Checkpoint  `json:"latest_justified"`
+	LatestFinalized          *Checkpoint  `json:"latest_finalized"`
+	HistoricalBlockHashes    []string     `json:"historical_block_hashes"`
+	JustifiedSlots           []bool       `json:"justified_slots"`
+	JustificationsRoots      []string     `json:"justifications_roots"`
+	JustificationsValidators string       `json:"justifications_validators"`
+}
+
+// UnmarshalJSON decodes hex roots and bitlists and requires the config, header and checkpoints
+func (s *State) UnmarshalJSON(data []byte) error {
+	var jsonState stateJSON
+	if err := json.Unmarshal(data, &jsonState); err != nil {
+		return fmt.Errorf("failed to unmarshal state JSON: %w", err)
+	}
+	if jsonState.Config == nil || jsonState.LatestBlockHeader == nil || jsonState.LatestJustified == nil || jsonState.LatestFinalized == nil {
+		return fmt.Errorf("state is missing its config, latest block header or a checkpoint")
+	}
+
+	historicalBlockHashes, err := hexListToBytes(jsonState.HistoricalBlockHashes)
+	if err != nil {
+		return fmt.Errorf("failed to decode historical_block_hashes: %w", err)
+	}
+	justificationsRoots, err := hexListToBytes(jsonState.JustificationsRoots)
+	if err != nil {
+		return fmt.Errorf("failed to decode justifications_roots: %w", err)
+	}
+	justificationsValidators, err := hexToBytes(jsonState.JustificationsValidators)
+	if err != nil {
+		return fmt.Errorf("failed to decode justifications_validators: %w", err)
+	}
+
+	justifiedSlots := make([]byte, len(jsonState.JustifiedSlots))
+	for i, justified := range jsonState.JustifiedSlots {
+		if justified {
+			justifiedSlots[i] = 1
+		}
+	}
+
+	s.Config = jsonState.Config
+	s.Slot = uint64(jsonState.Slot)
+	s.LatestBlockHeader = jsonState.LatestBlockHeader
+	s.LatestJustified = jsonState.LatestJustified
+	s.LatestFinalized = jsonState.LatestFinalized
+	s.HistoricalBlockHashes = historicalBlockHashes
+	s.JustifiedSlots = justifiedSlots
+	s.JustificationsRoots = justificationsRoots
+	s.JustificationsValidators = justificationsValidators
+	return nil
+}
+
+// MarshalJSON encodes roots and the justification bitlist as hex strings
+func (s State) MarshalJSON() ([]byte, error) {
+	justifiedSlots := make([]bool, len(s.JustifiedSlots))
+	for i, justified := range s.JustifiedSlots {
+		justifiedSlots[i] = justified != 0
+	}
+
+	return json.Marshal(stateJSON{
+		Config:                   s.Config,
+		Slot:                     flexUint64(s.Slot),
+		LatestBlockHeader:        s.LatestBlockHeader,
+		LatestJustified:          s.LatestJustified,
+		LatestFinalized:          s.LatestFinalized,
+		HistoricalBlockHashes:    bytesListToHex(s.HistoricalBlockHashes),
+		JustifiedSlots:           justifiedSlots,
+		JustificationsRoots:      bytesListToHex(s.JustificationsRoots),
+		JustificationsValidators: bytesToHex(s.JustificationsValidators),
+	})
+}
+
+// hexListToBytes decodes a list of hex encoded roots
+func hexListToBytes(hexList []string) ([][]byte, error) {
+	list := make([][]byte, 0, len(hexList))
+	for i, hexStr := range hexList {
+		decoded, err := hexToBytes(hexStr)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		list = append(list, decoded)
+	}
+	return list, nil
+}
+
+// bytesListToHex encodes a list of roots as hex strings
+func bytesListToHex(list [][]byte) []string {
+	hexList := make([]string, 0, len(list))
+	for _, item := range list {
+		hexList = append(hexList, bytesToHex(item))
+	}
+	return hexList
+}
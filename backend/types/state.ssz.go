@@ -0,0 +1,428 @@
+package types
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the State object
+func (s *State) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the State object to a target array
+func (s *State) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(232)
+
+	// Field (0) 'Config'
+	if s.Config == nil {
+		s.Config = new(StateConfig)
+	}
+	if dst, err = s.Config.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, s.Slot)
+
+	// Field (2) 'LatestBlockHeader'
+	if s.LatestBlockHeader == nil {
+		s.LatestBlockHeader = new(BlockHeader)
+	}
+	if dst, err = s.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'LatestJustified'
+	if s.LatestJustified == nil {
+		s.LatestJustified = new(Checkpoint)
+	}
+	if dst, err = s.LatestJustified.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestFinalized'
+	if s.LatestFinalized == nil {
+		s.LatestFinalized = new(Checkpoint)
+	}
+	if dst, err = s.LatestFinalized.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (5) 'HistoricalBlockHashes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.HistoricalBlockHashes) * 32
+
+	// Offset (6) 'JustifiedSlots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.JustifiedSlots)
+
+	// Offset (7) 'JustificationsRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.JustificationsRoots) * 32
+
+	// Offset (8) 'JustificationsValidators'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (5) 'HistoricalBlockHashes'
+	if size := len(s.HistoricalBlockHashes); size > 262144 {
+		err = ssz.ErrListTooBigFn("State.HistoricalBlockHashes", size, 262144)
+		return
+	}
+	for ii := 0; ii < len(s.HistoricalBlockHashes); ii++ {
+		if size := len(s.HistoricalBlockHashes[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("State.HistoricalBlockHashes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, s.HistoricalBlockHashes[ii]...)
+	}
+
+	// Field (6) 'JustifiedSlots'
+	if size := len(s.JustifiedSlots); size > 262144 {
+		err = ssz.ErrBytesLengthFn("State.JustifiedSlots", size, 262144)
+		return
+	}
+	dst = append(dst, s.JustifiedSlots...)
+
+	// Field (7) 'JustificationsRoots'
+	if size := len(s.JustificationsRoots); size > 262144 {
+		err = ssz.ErrListTooBigFn("State.JustificationsRoots", size, 262144)
+		return
+	}
+	for ii := 0; ii < len(s.JustificationsRoots); ii++ {
+		if size := len(s.JustificationsRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("State.JustificationsRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, s.JustificationsRoots[ii]...)
+	}
+
+	// Field (8) 'JustificationsValidators'
+	if size := len(s.JustificationsValidators); size > 1073741824 {
+		err = ssz.ErrBytesLengthFn("State.JustificationsValidators", size, 1073741824)
+		return
+	}
+	dst = append(dst, s.JustificationsValidators...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the State object
+func (s *State) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 232 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o5, o6, o7, o8 uint64
+
+	// Field (0) 'Config'
+	if s.Config == nil {
+		s.Config = new(StateConfig)
+	}
+	if err = s.Config.UnmarshalSSZ(buf[0:16]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Slot'
+	s.Slot = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (2) 'LatestBlockHeader'
+	if s.LatestBlockHeader == nil {
+		s.LatestBlockHeader = new(BlockHeader)
+	}
+	if err = s.LatestBlockHeader.UnmarshalSSZ(buf[24:136]); err != nil {
+		return err
+	}
+
+	// Field (3) 'LatestJustified'
+	if s.LatestJustified == nil {
+		s.LatestJustified = new(Checkpoint)
+	}
+	if err = s.LatestJustified.UnmarshalSSZ(buf[136:176]); err != nil {
+		return err
+	}
+
+	// Field (4) 'LatestFinalized'
+	if s.LatestFinalized == nil {
+		s.LatestFinalized = new(Checkpoint)
+	}
+	if err = s.LatestFinalized.UnmarshalSSZ(buf[176:216]); err != nil {
+		return err
+	}
+
+	// Offset (5) 'HistoricalBlockHashes'
+	if o5 = ssz.ReadOffset(buf[216:220]); o5 > size {
+		return ssz.ErrOffset
+	}
+
+	if o5 != 232 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (6) 'JustifiedSlots'
+	if o6 = ssz.ReadOffset(buf[220:224]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'JustificationsRoots'
+	if o7 = ssz.ReadOffset(buf[224:228]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'JustificationsValidators'
+	if o8 = ssz.ReadOffset(buf[228:232]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (5) 'HistoricalBlockHashes'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DivideInt2(len(buf), 32, 262144)
+		if err != nil {
+			return err
+		}
+		s.HistoricalBlockHashes = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(s.HistoricalBlockHashes[ii]) == 0 {
+				s.HistoricalBlockHashes[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			s.HistoricalBlockHashes[ii] = append(s.HistoricalBlockHashes[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (6) 'JustifiedSlots'
+	{
+		buf = tail[o6:o7]
+		if len(buf) > 262144 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.JustifiedSlots) == 0 {
+			s.JustifiedSlots = make([]byte, 0, len(buf))
+		}
+		s.JustifiedSlots = append(s.JustifiedSlots, buf...)
+	}
+
+	// Field (7) 'JustificationsRoots'
+	{
+		buf = tail[o7:o8]
+		num, err := ssz.DivideInt2(len(buf), 32, 262144)
+		if err != nil {
+			return err
+		}
+		s.JustificationsRoots = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(s.JustificationsRoots[ii]) == 0 {
+				s.JustificationsRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			s.JustificationsRoots[ii] = append(s.JustificationsRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (8) 'JustificationsValidators'
+	{
+		buf = tail[o8:]
+		if err = ssz.ValidateBitlist(buf, 1073741824); err != nil {
+			return err
+		}
+		if cap(s.JustificationsValidators) == 0 {
+			s.JustificationsValidators = make([]byte, 0, len(buf))
+		}
+		s.JustificationsValidators = append(s.JustificationsValidators, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the State object
+func (s *State) SizeSSZ() (size int) {
+	size = 232
+
+	// Field (5) 'HistoricalBlockHashes'
+	size += len(s.HistoricalBlockHashes) * 32
+
+	// Field (6) 'JustifiedSlots'
+	size += len(s.JustifiedSlots)
+
+	// Field (7) 'JustificationsRoots'
+	size += len(s.JustificationsRoots) * 32
+
+	// Field (8) 'JustificationsValidators'
+	size += len(s.JustificationsValidators)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the State object
+func (s *State) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the State object with a hasher
+func (s *State) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Config'
+	if s.Config == nil {
+		s.Config = new(StateConfig)
+	}
+	if err = s.Config.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Slot'
+	hh.PutUint64(s.Slot)
+
+	// Field (2) 'LatestBlockHeader'
+	if s.LatestBlockHeader == nil {
+		s.LatestBlockHeader = new(BlockHeader)
+	}
+	if err = s.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'LatestJustified'
+	if s.LatestJustified == nil {
+		s.LatestJustified = new(Checkpoint)
+	}
+	if err = s.LatestJustified.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestFinalized'
+	if s.LatestFinalized == nil {
+		s.LatestFinalized = new(Checkpoint)
+	}
+	if err = s.LatestFinalized.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'HistoricalBlockHashes'
+	{
+		if size := len(s.HistoricalBlockHashes); size > 262144 {
+			err = ssz.ErrListTooBigFn("State.HistoricalBlockHashes", size, 262144)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.HistoricalBlockHashes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		numItems := uint64(len(s.HistoricalBlockHashes))
+		hh.MerkleizeWithMixin(subIndx, numItems, 262144)
+	}
+
+	// Field (6) 'JustifiedSlots'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.JustifiedSlots))
+		if byteLen > 262144 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.JustifiedSlots)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (262144+31)/32)
+	}
+
+	// Field (7) 'JustificationsRoots'
+	{
+		if size := len(s.JustificationsRoots); size > 262144 {
+			err = ssz.ErrListTooBigFn("State.JustificationsRoots", size, 262144)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.JustificationsRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		numItems := uint64(len(s.JustificationsRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, 262144)
+	}
+
+	// Field (8) 'JustificationsValidators'
+	if len(s.JustificationsValidators) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(s.JustificationsValidators, 1073741824)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the State object
+func (s *State) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the StateConfig object
+func (s *StateConfig) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StateConfig object to a target array
+func (s *StateConfig) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'NumValidators'
+	dst = ssz.MarshalUint64(dst, s.NumValidators)
+
+	// Field (1) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, s.GenesisTime)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the StateConfig object
+func (s *StateConfig) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'NumValidators'
+	s.NumValidators = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'GenesisTime'
+	s.GenesisTime = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StateConfig object
+func (s *StateConfig) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the StateConfig object
+func (s *StateConfig) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the StateConfig object with a hasher
+func (s *StateConfig) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'NumValidators'
+	hh.PutUint64(s.NumValidators)
+
+	// Field (1) 'GenesisTime'
+	hh.PutUint64(s.GenesisTime)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the StateConfig object
+func (s *StateConfig) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
@@ -0,0 +1,35 @@
+package types
+
+// StateSnapshot is a state fetched from a client, stored SSZ encoded by its state root
+type StateSnapshot struct {
+	StateRoot        []byte `db:"state_root"`
+	Slot             uint64 `db:"slot"`
+	BlockRoot        []byte `db:"block_root"` // Root of the state's latest block header with the state root filled in
+	Client           string `db:"client"`
+	JustifiedSlot    uint64 `db:"justified_slot"`
+	JustifiedRoot    []byte `db:"justified_root"`
+	FinalizedSlot    uint64 `db:"finalized_slot"`
+	FinalizedRoot    []byte `db:"finalized_root"`
+	Data             []byte `db:"data"`              // SSZ encoded state, nil when listing snapshots
+	Size             uint64 `db:"size"`              // Length of the SSZ encoded state
+	CheckedHashes    uint64 `db:"checked_hashes"`    // Block hashes compared with the indexed chain when fetched
+	MismatchedHashes uint64 `db:"mismatched_hashes"` // Compared block hashes that differ from the indexed chain
+	UnindexedHashes  uint64 `db:"unindexed_hashes"`  // Block hashes of slots without an indexed header
+	FetchedAt        int64  `db:"fetched_at"`        // Unix timestamp in milliseconds
+}
+
+// HashMismatch is a slot whose block hash in a state differs from the indexed chain
+type HashMismatch struct {
+	Slot        uint64
+	StateRoot   []byte // Block root the state has for the slot, zero for a missed slot
+	IndexedRoot []byte // Root of the indexed header at the slot
+}
+
+// HashCheck is the outcome of comparing a state's block hashes with the indexed chain.
+// Slots the state has a block for but the indexed chain does not are counted as unindexed
+// rather than mismatched, since catchup may not have stored them yet.
+type HashCheck struct {
+	Checked    uint64 // Slots compared, from slot 1 up to the latest indexed slot
+	Unindexed  []uint64
+	Mismatches []*HashMismatch
+}
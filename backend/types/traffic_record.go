@@ -0,0 +1,38 @@
+package types
+
+import "time"
+
+// TrafficArchiveVersion is the format version written to new traffic archives
+const TrafficArchiveVersion = 1
+
+// TrafficArchiveHeader is the first line of a traffic archive. It carries what a replay
+// needs besides the recorded exchanges.
+type TrafficArchiveHeader struct {
+	Version      int                      `json:"version"`
+	StartedAt    time.Time                `json:"started_at"`
+	GenesisTime  uint64                   `json:"genesis_time"`
+	SlotDuration time.Duration            `json:"slot_duration"`
+	Endpoints    []TrafficArchiveEndpoint `json:"endpoints"`
+}
+
+// TrafficArchiveEndpoint is an endpoint of the recorded client pool, in pool order
+type TrafficArchiveEndpoint struct {
+	Name string `json:"name"`
+	Url  string `json:"url"` // Credentials redacted
+}
+
+// TrafficRecord is a single recorded request to a lean node and its response. Request
+// headers are not recorded since they may carry credentials.
+type TrafficRecord struct {
+	Endpoint  string        `json:"endpoint"`
+	Method    string        `json:"method"`
+	Path      string        `json:"path"`
+	Accept    string        `json:"accept,omitempty"`
+	StartedAt time.Time     `json:"started_at"`
+	Duration  time.Duration `json:"duration"` // Until the response body was read or the request failed
+
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+	Error       string `json:"error,omitempty"` // Transport error, set instead of a response
+}
@@ -0,0 +1,43 @@
+package types
+
+// ValidatorConfig describes how validators are assigned to clients on a devnet
+type ValidatorConfig struct {
+	Validators []ValidatorAssignment `yaml:"validators"`
+}
+
+// ValidatorAssignment assigns an inclusive validator index range to a client.
+// Client must match the Name of the lean api endpoint running those validators.
+type ValidatorAssignment struct {
+	Client     string `yaml:"client"`
+	StartIndex uint64 `yaml:"startIndex"`
+	EndIndex   uint64 `yaml:"endIndex"`
+}
+
+// ClientForValidator returns the client running the given validator, or an empty string if unassigned
+func (vc *ValidatorConfig) ClientForValidator(index uint64) string {
+	if vc == nil {
+		return ""
+	}
+
+	for _, assignment := range vc.Validators {
+		if index >= assignment.StartIndex && index <= assignment.EndIndex {
+			return assignment.Client
+		}
+	}
+	return ""
+}
+
+// ValidatorCount returns the number of validators covered by the assignment
+func (vc *ValidatorConfig) ValidatorCount() uint64 {
+	if vc == nil {
+		return 0
+	}
+
+	var count uint64
+	for _, assignment := range vc.Validators {
+		if assignment.EndIndex+1 > count {
+			count = assignment.EndIndex + 1
+		}
+	}
+	return count
+}
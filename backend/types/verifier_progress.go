@@ -0,0 +1,11 @@
+package types
+
+// VerifierProgress is the verified tip of the state transition verifier, stored so a
+// restart resumes from it instead of replaying the chain from genesis
+type VerifierProgress struct {
+	BlockRoot   []byte `db:"block_root"`
+	Slot        uint64 `db:"slot"`
+	Data        []byte `db:"data"`         // SSZ encoded post-state of the block
+	JustifiedAt string `db:"justified_at"` // JSON map of target slot to the slot of the block that justified it
+	SavedAt     int64  `db:"saved_at"`     // Unix timestamp in milliseconds
+}
@@ -4,11 +4,19 @@
 package utils
 
 import (
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 
 	"github.com/syjn99/leanView/backend/config"
@@ -17,13 +25,51 @@ import (
 
 var Config *types.Config
 
+// Defaults for settings left unset in the config file and environment
+const (
+	defaultServerPort      = 8080
+	defaultReadTimeout     = 15 * time.Second
+	defaultWriteTimeout    = 15 * time.Second
+	defaultIdleTimeout     = 60 * time.Second
+	defaultShutdownTimeout = 30 * time.Second
+
+	defaultLogLevel  = "info"
+	defaultLogFormat = "text"
+
+	defaultSlotDuration        = 4 * time.Second
+	defaultRetryDelay          = 2 * time.Second
+	defaultMaxRetries          = 3
+	defaultHTTPTimeout         = 30 * time.Second
+	defaultHealthTimeout       = 10 * time.Second
+	defaultHealthCheckInterval = 30 * time.Second
+	defaultClientSelection     = types.ClientSelectionPrimary
+	defaultBulkClientSelection = types.ClientSelectionRoundRobin
+	defaultBreakerThreshold    = 5
+	defaultBreakerBaseBackoff  = 5 * time.Second
+	defaultBreakerMaxBackoff   = 5 * time.Minute
+	defaultSyncingDistance     = 4
+	defaultStallSlots          = 8
+	defaultReplaySpeed         = 1
+
+	defaultMaxOpenConns = 50
+	defaultMaxIdleConns = 10
+
+	defaultMaxHeadLagSlots = 8
+	defaultMaxPollAge      = 30 * time.Second
+)
+
+// defaultCorsOrigins allows the Vite dev server
+var defaultCorsOrigins = []string{"http://localhost:5173"}
+
 func ReadConfig(cfg *types.Config, path string) error {
 	err := readConfigFile(cfg, path)
 	if err != nil {
 		return err
 	}
 
-	readConfigEnv(cfg)
+	if err := readConfigEnv(cfg); err != nil {
+		return fmt.Errorf("error reading config from environment: %v", err)
+	}
 
 	if cfg.LeanApi.Endpoints == nil && cfg.LeanApi.Endpoint != "" {
 		cfg.LeanApi.Endpoints = []types.EndpointConfig{
@@ -43,9 +89,428 @@ func ReadConfig(cfg *types.Config, path string) error {
 			}
 		}
 	}
-	if len(cfg.LeanApi.Endpoints) == 0 {
+	// A replay takes its endpoints from the traffic archive
+	if len(cfg.LeanApi.Endpoints) == 0 && cfg.Indexer.ReplayFile == "" {
 		return fmt.Errorf("missing lean node endpoints (need at least 1 endpoint to run the explorer)")
 	}
+	for idx := range cfg.LeanApi.Endpoints {
+		if err := ResolveEndpointSecrets(&cfg.LeanApi.Endpoints[idx]); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Chain.ValidatorConfig != "" {
+		validatorPath := cfg.Chain.ValidatorConfig
+		if !filepath.IsAbs(validatorPath) && path != "" {
+			// Resolve relative to the config file so both can live side by side
+			validatorPath = filepath.Join(filepath.Dir(path), validatorPath)
+		}
+
+		validators, err := ReadValidatorConfig(validatorPath)
+		if err != nil {
+			return err
+		}
+		cfg.Chain.Validators = validators
+	}
+
+	setConfigDefaults(cfg)
+
+	if err := validateConfig(cfg); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	return nil
+}
+
+// ResolveEndpointSecrets loads auth secrets and header values given as files or environment
+// variables into the inline fields, so the rest of the backend only reads those
+func ResolveEndpointSecrets(endpoint *types.EndpointConfig) error {
+	if len(endpoint.HeaderFiles) > 0 || len(endpoint.HeaderEnv) > 0 {
+		// Copy the headers, the map may be shared with the unresolved endpoint
+		headers := maps.Clone(endpoint.Headers)
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		for _, names := range []map[string]string{endpoint.HeaderFiles, endpoint.HeaderEnv} {
+			for name := range names {
+				value, err := resolveSecret(endpoint.Headers[name], endpoint.HeaderFiles[name], endpoint.HeaderEnv[name])
+				if err != nil {
+					return fmt.Errorf("error loading header %s for endpoint %q: %w", name, endpoint.Name, err)
+				}
+				headers[name] = value
+			}
+		}
+		endpoint.Headers = headers
+	}
+
+	auth := &endpoint.Auth
+
+	password, err := resolveSecret(auth.Password, auth.PasswordFile, auth.PasswordEnv)
+	if err != nil {
+		return fmt.Errorf("error loading password for endpoint %q: %w", endpoint.Name, err)
+	}
+	auth.Password = password
+
+	token, err := resolveSecret(auth.BearerToken, auth.BearerTokenFile, auth.BearerTokenEnv)
+	if err != nil {
+		return fmt.Errorf("error loading bearer token for endpoint %q: %w", endpoint.Name, err)
+	}
+	auth.BearerToken = token
+
+	return nil
+}
+
+// resolveSecret returns the secret from whichever of an inline value, a file (trimmed)
+// or an environment variable is set
+func resolveSecret(value, file, env string) (string, error) {
+	sources := 0
+	for _, source := range []string{value, file, env} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return "", errors.New("only one of the inline value, file and env may be set")
+	}
+
+	switch {
+	case file != "":
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("error reading secret file: %w", err)
+		}
+		secret := strings.TrimSpace(string(content))
+		if secret == "" {
+			return "", fmt.Errorf("secret file %v is empty", file)
+		}
+		return secret, nil
+	case env != "":
+		secret, ok := os.LookupEnv(env)
+		if !ok || secret == "" {
+			return "", fmt.Errorf("environment variable %v is not set", env)
+		}
+		return secret, nil
+	}
+	return value, nil
+}
+
+// ValidateEndpoint checks that an endpoint has a name, an http(s) URL and consistent
+// request options
+func ValidateEndpoint(endpoint types.EndpointConfig) error {
+	if endpoint.Name == "" {
+		return fmt.Errorf("endpoint %q has no name", endpoint.RedactedUrl())
+	}
+	if parsed, err := url.Parse(endpoint.Url); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("endpoint %q has invalid url %q, expected http(s)://host[:port]", endpoint.Name, endpoint.RedactedUrl())
+	}
+
+	for name := range endpoint.Headers {
+		if name == "" || strings.ContainsAny(name, ": \t\r\n") {
+			return fmt.Errorf("endpoint %q has invalid header name %q", endpoint.Name, name)
+		}
+	}
+
+	auth := endpoint.Auth
+	if auth.Password != "" && auth.Username == "" {
+		return fmt.Errorf("endpoint %q has a password but no username", endpoint.Name)
+	}
+	if auth.Username != "" && auth.BearerToken != "" {
+		return fmt.Errorf("endpoint %q sets both basic auth and a bearer token", endpoint.Name)
+	}
+
+	if endpoint.TLS.CAFile != "" {
+		pem, err := os.ReadFile(endpoint.TLS.CAFile)
+		if err != nil {
+			return fmt.Errorf("endpoint %q: error reading CA file: %w", endpoint.Name, err)
+		}
+		if !x509.NewCertPool().AppendCertsFromPEM(pem) {
+			return fmt.Errorf("endpoint %q: CA file %v contains no PEM certificates", endpoint.Name, endpoint.TLS.CAFile)
+		}
+	}
+
+	if endpoint.Proxy != "" && endpoint.Proxy != types.EndpointProxyNone {
+		if parsed, err := url.Parse(endpoint.Proxy); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("endpoint %q has invalid proxy, expected a URL such as http://proxy:3128 or \"none\"", endpoint.Name)
+		}
+	}
+
+	if endpoint.Timeout < 0 || endpoint.HealthTimeout < 0 {
+		return fmt.Errorf("endpoint %q timeouts must not be negative", endpoint.Name)
+	}
+	return nil
+}
+
+// setConfigDefaults fills in every setting that was left unset
+func setConfigDefaults(cfg *types.Config) {
+	if cfg.Server.Port == 0 {
+		cfg.Server.Port = defaultServerPort
+	}
+	if len(cfg.Server.CorsOrigins) == 0 {
+		cfg.Server.CorsOrigins = defaultCorsOrigins
+	}
+	if cfg.Server.ReadTimeout == 0 {
+		cfg.Server.ReadTimeout = defaultReadTimeout
+	}
+	if cfg.Server.WriteTimeout == 0 {
+		cfg.Server.WriteTimeout = defaultWriteTimeout
+	}
+	if cfg.Server.IdleTimeout == 0 {
+		cfg.Server.IdleTimeout = defaultIdleTimeout
+	}
+	if cfg.Server.ShutdownTimeout == 0 {
+		cfg.Server.ShutdownTimeout = defaultShutdownTimeout
+	}
+
+	if cfg.Logging.Level == "" {
+		cfg.Logging.Level = defaultLogLevel
+	}
+	if cfg.Logging.Format == "" {
+		cfg.Logging.Format = defaultLogFormat
+	}
+
+	if cfg.Chain.SlotDuration == 0 {
+		cfg.Chain.SlotDuration = defaultSlotDuration
+	}
+
+	// Poll once per slot unless configured otherwise
+	if cfg.Indexer.PollInterval == 0 {
+		cfg.Indexer.PollInterval = cfg.Chain.SlotDuration
+	}
+	if cfg.Indexer.RetryDelay == 0 {
+		cfg.Indexer.RetryDelay = defaultRetryDelay
+	}
+	if cfg.Indexer.MaxRetries == 0 {
+		cfg.Indexer.MaxRetries = defaultMaxRetries
+	}
+	if cfg.Indexer.HTTPTimeout == 0 {
+		cfg.Indexer.HTTPTimeout = defaultHTTPTimeout
+	}
+	if cfg.Indexer.HealthTimeout == 0 {
+		cfg.Indexer.HealthTimeout = defaultHealthTimeout
+	}
+	if cfg.Indexer.HealthCheckInterval == 0 {
+		cfg.Indexer.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if cfg.Indexer.ClientSelection == "" {
+		cfg.Indexer.ClientSelection = defaultClientSelection
+	}
+	if cfg.Indexer.BulkClientSelection == "" {
+		cfg.Indexer.BulkClientSelection = defaultBulkClientSelection
+	}
+	if cfg.Indexer.BreakerFailureThreshold == 0 {
+		cfg.Indexer.BreakerFailureThreshold = defaultBreakerThreshold
+	}
+	if cfg.Indexer.BreakerBaseBackoff == 0 {
+		cfg.Indexer.BreakerBaseBackoff = defaultBreakerBaseBackoff
+	}
+	if cfg.Indexer.BreakerMaxBackoff == 0 {
+		cfg.Indexer.BreakerMaxBackoff = defaultBreakerMaxBackoff
+	}
+	if cfg.Indexer.SyncingDistance == 0 {
+		cfg.Indexer.SyncingDistance = defaultSyncingDistance
+	}
+	if cfg.Indexer.StallSlots == 0 {
+		cfg.Indexer.StallSlots = defaultStallSlots
+	}
+	if cfg.Indexer.ReplaySpeed == 0 {
+		cfg.Indexer.ReplaySpeed = defaultReplaySpeed
+	}
+
+	if cfg.Database.MaxOpenConns == 0 {
+		cfg.Database.MaxOpenConns = defaultMaxOpenConns
+	}
+	if cfg.Database.MaxIdleConns == 0 {
+		cfg.Database.MaxIdleConns = defaultMaxIdleConns
+	}
+	if cfg.Database.MaxOpenConns < cfg.Database.MaxIdleConns {
+		cfg.Database.MaxIdleConns = cfg.Database.MaxOpenConns
+	}
+
+	if cfg.Health.MaxHeadLagSlots == 0 {
+		cfg.Health.MaxHeadLagSlots = defaultMaxHeadLagSlots
+	}
+	if cfg.Health.MaxPollAge == 0 {
+		cfg.Health.MaxPollAge = defaultMaxPollAge
+	}
+}
+
+// validateConfig checks every section and reports all problems at once
+func validateConfig(cfg *types.Config) error {
+	var problems []string
+	addErr := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+	requirePositive := func(name string, value time.Duration) {
+		if value <= 0 {
+			addErr("%s must be positive, got %v", name, value)
+		}
+	}
+
+	// Server
+	if cfg.Server.Port < 1 || cfg.Server.Port > 65535 {
+		addErr("server.port must be between 1 and 65535, got %d", cfg.Server.Port)
+	}
+	for _, origin := range cfg.Server.CorsOrigins {
+		if origin == "*" {
+			continue
+		}
+		if parsed, err := url.Parse(origin); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			addErr("server.corsOrigins entry %q must be \"*\" or an http(s) origin such as http://localhost:5173", origin)
+		}
+	}
+	requirePositive("server.readTimeout", cfg.Server.ReadTimeout)
+	requirePositive("server.writeTimeout", cfg.Server.WriteTimeout)
+	requirePositive("server.idleTimeout", cfg.Server.IdleTimeout)
+	requirePositive("server.shutdownTimeout", cfg.Server.ShutdownTimeout)
+
+	// Logging
+	if _, err := logrus.ParseLevel(cfg.Logging.Level); err != nil {
+		addErr("logging.level %q is not one of trace, debug, info, warn, error, fatal, panic", cfg.Logging.Level)
+	}
+	if cfg.Logging.Format != "text" && cfg.Logging.Format != "json" {
+		addErr("logging.format %q must be text or json", cfg.Logging.Format)
+	}
+
+	// Endpoints
+	endpointNames := make(map[string]bool, len(cfg.LeanApi.Endpoints))
+	for _, endpoint := range cfg.LeanApi.Endpoints {
+		if err := ValidateEndpoint(endpoint); err != nil {
+			addErr("leanapi %v", err)
+		}
+		if endpointNames[endpoint.Name] {
+			addErr("leanapi endpoint name %q is used more than once", endpoint.Name)
+		}
+		endpointNames[endpoint.Name] = true
+	}
+
+	// Chain
+	if _, err := types.NewForkSchedule(cfg.Chain.Fork, cfg.Chain.ForkActivations); err != nil {
+		addErr("chain.fork: %v", err)
+	}
+
+	// Indexer
+	requirePositive("chain.slotDuration", cfg.Chain.SlotDuration)
+	requirePositive("indexer.pollInterval", cfg.Indexer.PollInterval)
+	requirePositive("indexer.retryDelay", cfg.Indexer.RetryDelay)
+	requirePositive("indexer.httpTimeout", cfg.Indexer.HTTPTimeout)
+	requirePositive("indexer.healthTimeout", cfg.Indexer.HealthTimeout)
+	requirePositive("indexer.healthCheckInterval", cfg.Indexer.HealthCheckInterval)
+	if cfg.Indexer.MaxRetries < 1 {
+		addErr("indexer.maxRetries must be at least 1, got %d", cfg.Indexer.MaxRetries)
+	}
+	if cfg.Indexer.BreakerFailureThreshold < 1 {
+		addErr("indexer.breakerFailureThreshold must be at least 1, got %d", cfg.Indexer.BreakerFailureThreshold)
+	}
+	requirePositive("indexer.breakerBaseBackoff", cfg.Indexer.BreakerBaseBackoff)
+	if cfg.Indexer.BreakerMaxBackoff < cfg.Indexer.BreakerBaseBackoff {
+		addErr("indexer.breakerMaxBackoff %v must not be less than indexer.breakerBaseBackoff %v", cfg.Indexer.BreakerMaxBackoff, cfg.Indexer.BreakerBaseBackoff)
+	}
+	strategies := []string{
+		types.ClientSelectionPrimary,
+		types.ClientSelectionHighestHead,
+		types.ClientSelectionLowestLatency,
+		types.ClientSelectionRoundRobin,
+	}
+	if !slices.Contains(strategies, cfg.Indexer.ClientSelection) {
+		addErr("indexer.clientSelection %q must be one of %s", cfg.Indexer.ClientSelection, strings.Join(strategies, ", "))
+	}
+	if !slices.Contains(strategies, cfg.Indexer.BulkClientSelection) {
+		addErr("indexer.bulkClientSelection %q must be one of %s", cfg.Indexer.BulkClientSelection, strings.Join(strategies, ", "))
+	}
+	if cfg.Indexer.RecordFile != "" && cfg.Indexer.ReplayFile != "" {
+		addErr("indexer.recordFile and indexer.replayFile must not both be set")
+	}
+	if cfg.Indexer.ReplaySpeed <= 0 {
+		addErr("indexer.replaySpeed must be positive, got %v", cfg.Indexer.ReplaySpeed)
+	}
+	if cfg.Indexer.VerifyStateTransition {
+		// A replay takes the genesis time from the archive
+		if cfg.Chain.GenesisTime == 0 && cfg.Indexer.ReplayFile == "" {
+			addErr("indexer.verifyStateTransition needs chain.genesisTime")
+		}
+		if cfg.Chain.ValidatorCount == 0 && cfg.Chain.Validators.ValidatorCount() == 0 {
+			addErr("indexer.verifyStateTransition needs chain.validatorCount or chain.validatorConfig")
+		}
+	}
+
+	if cfg.Indexer.IntegrityCheckInterval < 0 {
+		addErr("indexer.integrityCheckInterval must not be negative, got %v", cfg.Indexer.IntegrityCheckInterval)
+	}
+	if cfg.Indexer.QuorumSize < 0 {
+		addErr("indexer.quorumSize must not be negative, got %d", cfg.Indexer.QuorumSize)
+	}
+	// A replay takes its endpoints from the archive
+	if cfg.Indexer.ReplayFile == "" && cfg.Indexer.QuorumSize > len(cfg.LeanApi.Endpoints) {
+		addErr("indexer.quorumSize %d exceeds the %d configured endpoints", cfg.Indexer.QuorumSize, len(cfg.LeanApi.Endpoints))
+	}
+	if cfg.Indexer.StateSnapshotInterval < 0 {
+		addErr("indexer.stateSnapshotInterval must not be negative, got %v", cfg.Indexer.StateSnapshotInterval)
+	}
+	if cfg.Indexer.StateSnapshotKeep < 0 {
+		addErr("indexer.stateSnapshotKeep must not be negative, got %d", cfg.Indexer.StateSnapshotKeep)
+	}
+	if cfg.Indexer.AlertWebhook != "" {
+		if parsed, err := url.Parse(cfg.Indexer.AlertWebhook); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			addErr("indexer.alertWebhook must be an http(s) url")
+		}
+	}
+
+	// Database and health
+	if cfg.Database.File == "" {
+		addErr("database.file must be set")
+	}
+	requirePositive("health.maxPollAge", cfg.Health.MaxPollAge)
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// ReadValidatorConfig loads the validator to client assignment from a YAML file
+func ReadValidatorConfig(path string) (*types.ValidatorConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening validator config file %v: %v", path, err)
+	}
+	defer f.Close()
+
+	validators := &types.ValidatorConfig{}
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(validators); err != nil {
+		return nil, fmt.Errorf("error decoding validator config %v: %v", path, err)
+	}
+
+	if err := validateValidatorConfig(validators); err != nil {
+		return nil, fmt.Errorf("invalid validator config %v: %v", path, err)
+	}
+
+	return validators, nil
+}
+
+// validateValidatorConfig checks that ranges are well formed and do not overlap
+func validateValidatorConfig(validators *types.ValidatorConfig) error {
+	if len(validators.Validators) == 0 {
+		return fmt.Errorf("no validator assignments")
+	}
+
+	for i, assignment := range validators.Validators {
+		if assignment.Client == "" {
+			return fmt.Errorf("assignment %d has no client name", i+1)
+		}
+		if assignment.StartIndex > assignment.EndIndex {
+			return fmt.Errorf("assignment %d (%s) has startIndex %d greater than endIndex %d",
+				i+1, assignment.Client, assignment.StartIndex, assignment.EndIndex)
+		}
+
+		for j, other := range validators.Validators[:i] {
+			if assignment.StartIndex <= other.EndIndex && other.StartIndex <= assignment.EndIndex {
+				return fmt.Errorf("assignment %d (%s) overlaps assignment %d (%s)",
+					i+1, assignment.Client, j+1, other.Client)
+			}
+		}
+	}
 
 	return nil
 }
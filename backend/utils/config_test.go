@@ -0,0 +1,226 @@
+package utils
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syjn99/leanView/backend/types"
+)
+
+// validConfig returns a config that passes validation
+func validConfig() *types.Config {
+	cfg := &types.Config{}
+	cfg.Database.File = "leanview.db"
+	setConfigDefaults(cfg)
+	return cfg
+}
+
+func TestSetConfigDefaults(t *testing.T) {
+	cfg := &types.Config{}
+	cfg.Chain.SlotDuration = 12 * time.Second
+	cfg.Indexer.MaxRetries = 7
+	cfg.Database.MaxOpenConns = 4
+	setConfigDefaults(cfg)
+
+	tests := []struct {
+		name          string
+		got, expected any
+	}{
+		{"server.port", cfg.Server.Port, defaultServerPort},
+		{"server.corsOrigins", strings.Join(cfg.Server.CorsOrigins, ","), "http://localhost:5173"},
+		{"server.readTimeout", cfg.Server.ReadTimeout, defaultReadTimeout},
+		{"logging.level", cfg.Logging.Level, defaultLogLevel},
+		{"logging.format", cfg.Logging.Format, defaultLogFormat},
+		{"chain.slotDuration is kept", cfg.Chain.SlotDuration, 12 * time.Second},
+		{"indexer.pollInterval follows the slot duration", cfg.Indexer.PollInterval, 12 * time.Second},
+		{"indexer.maxRetries is kept", cfg.Indexer.MaxRetries, 7},
+		{"indexer.clientSelection", cfg.Indexer.ClientSelection, types.ClientSelectionPrimary},
+		{"indexer.bulkClientSelection", cfg.Indexer.BulkClientSelection, types.ClientSelectionRoundRobin},
+		{"indexer.breakerMaxBackoff", cfg.Indexer.BreakerMaxBackoff, defaultBreakerMaxBackoff},
+		{"indexer.replaySpeed", cfg.Indexer.ReplaySpeed, float64(defaultReplaySpeed)},
+		{"database.maxOpenConns is kept", cfg.Database.MaxOpenConns, 4},
+		{"database.maxIdleConns is capped by maxOpenConns", cfg.Database.MaxIdleConns, 4},
+		{"health.maxHeadLagSlots", cfg.Health.MaxHeadLagSlots, uint64(defaultMaxHeadLagSlots)},
+		{"health.maxPollAge", cfg.Health.MaxPollAge, defaultMaxPollAge},
+	}
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("%s: got %v, want %v", test.name, test.got, test.expected)
+		}
+	}
+
+	if err := validateConfig(validConfig()); err != nil {
+		t.Errorf("defaults do not validate: %v", err)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(cfg *types.Config)
+		expected []string // Substrings of the error, none if the config is valid
+	}{
+		{"any origin", func(cfg *types.Config) {
+			cfg.Server.CorsOrigins = []string{"*", "https://leanview.example"}
+		}, nil},
+		{"origin without scheme", func(cfg *types.Config) {
+			cfg.Server.CorsOrigins = []string{"localhost:5173"}
+		}, []string{`server.corsOrigins entry "localhost:5173"`}},
+		{"invalid port", func(cfg *types.Config) {
+			cfg.Server.Port = 70000
+		}, []string{"server.port must be between 1 and 65535, got 70000"}},
+		{"duplicate endpoint", func(cfg *types.Config) {
+			endpoint := types.EndpointConfig{Name: "zeam-0", Url: "http://localhost:5052"}
+			cfg.LeanApi.Endpoints = []types.EndpointConfig{endpoint, endpoint}
+		}, []string{`leanapi endpoint name "zeam-0" is used more than once`}},
+		{"backoff below base", func(cfg *types.Config) {
+			cfg.Indexer.BreakerMaxBackoff = time.Second
+		}, []string{"indexer.breakerMaxBackoff 1s must not be less than indexer.breakerBaseBackoff 5s"}},
+		{"quorum above endpoints", func(cfg *types.Config) {
+			cfg.Indexer.QuorumSize = 2
+		}, []string{"indexer.quorumSize 2 exceeds the 0 configured endpoints"}},
+		{"record and replay", func(cfg *types.Config) {
+			cfg.Indexer.RecordFile = "traffic.jsonl"
+			cfg.Indexer.ReplayFile = "traffic.jsonl"
+		}, []string{"indexer.recordFile and indexer.replayFile must not both be set"}},
+		{"verification without chain settings", func(cfg *types.Config) {
+			cfg.Indexer.VerifyStateTransition = true
+		}, []string{"needs chain.genesisTime", "needs chain.validatorCount or chain.validatorConfig"}},
+		{"every problem is reported", func(cfg *types.Config) {
+			cfg.Logging.Level = "verbose"
+			cfg.Logging.Format = "xml"
+			cfg.Indexer.ClientSelection = "random"
+			cfg.Indexer.PollInterval = -time.Second
+			cfg.Indexer.AlertWebhook = "ftp://alerts.example"
+			cfg.Database.File = ""
+		}, []string{
+			`logging.level "verbose"`,
+			`logging.format "xml" must be text or json`,
+			`indexer.clientSelection "random" must be one of`,
+			"indexer.pollInterval must be positive, got -1s",
+			"indexer.alertWebhook must be an http(s) url",
+			"database.file must be set",
+		}},
+	}
+	for _, test := range tests {
+		cfg := validConfig()
+		test.modify(cfg)
+		err := validateConfig(cfg)
+		if len(test.expected) == 0 {
+			if err != nil {
+				t.Errorf("%s: %v", test.name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: config is valid, want an error", test.name)
+			continue
+		}
+		for _, expected := range test.expected {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("%s: error %q does not report %q", test.name, err, expected)
+			}
+		}
+		if problems := strings.Count(err.Error(), "; ") + 1; problems != len(test.expected) {
+			t.Errorf("%s: got %d problems, want %d: %v", test.name, problems, len(test.expected), err)
+		}
+	}
+}
+
+func TestReadValidatorConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		expected string // Substring of the error, empty if the file is valid
+	}{
+		{"adjacent ranges", `
+validators:
+  - {client: zeam-0, startIndex: 0, endIndex: 3}
+  - {client: ream-0, startIndex: 4, endIndex: 7}
+`, ""},
+		{"overlapping ranges", `
+validators:
+  - {client: zeam-0, startIndex: 0, endIndex: 3}
+  - {client: ream-0, startIndex: 4, endIndex: 7}
+  - {client: qlean-0, startIndex: 7, endIndex: 9}
+`, "assignment 3 (qlean-0) overlaps assignment 2 (ream-0)"},
+		{"range inside another", `
+validators:
+  - {client: zeam-0, startIndex: 0, endIndex: 9}
+  - {client: ream-0, startIndex: 4, endIndex: 5}
+`, "assignment 2 (ream-0) overlaps assignment 1 (zeam-0)"},
+		{"reversed range", `
+validators:
+  - {client: zeam-0, startIndex: 3, endIndex: 0}
+`, "assignment 1 (zeam-0) has startIndex 3 greater than endIndex 0"},
+		{"missing client", `
+validators:
+  - {startIndex: 0, endIndex: 3}
+`, "assignment 1 has no client name"},
+		{"unknown field", `
+validators:
+  - {client: zeam-0, start: 0, endIndex: 3}
+`, "field start not found"},
+		{"no assignments", "validators: []\n", "no validator assignments"},
+	}
+	for _, test := range tests {
+		path := filepath.Join(t.TempDir(), "validators.yml")
+		if err := os.WriteFile(path, []byte(test.yaml), 0o600); err != nil {
+			t.Fatalf("%s: writing validator config: %v", test.name, err)
+		}
+
+		validators, err := ReadValidatorConfig(path)
+		if test.expected == "" {
+			if err != nil {
+				t.Errorf("%s: %v", test.name, err)
+			} else if validators.ClientForValidator(4) != "ream-0" {
+				t.Errorf("%s: validator 4 runs on %q, want ream-0", test.name, validators.ClientForValidator(4))
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s: got error %v, want %q", test.name, err, test.expected)
+		}
+	}
+
+	if _, err := ReadValidatorConfig(filepath.Join(t.TempDir(), "missing.yml")); err == nil {
+		t.Errorf("missing validator config was read")
+	}
+}
+
+func TestResolveEndpointSecretsHeaders(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	if err := os.WriteFile(keyFile, []byte("file-key\n"), 0o600); err != nil {
+		t.Fatalf("writing key file: %v", err)
+	}
+	t.Setenv("LEAN_API_TOKEN", "env-token")
+
+	headers := map[string]string{"X-Network": "devnet"}
+	endpoint := types.EndpointConfig{
+		Name:        "zeam-0",
+		Headers:     headers,
+		HeaderFiles: map[string]string{"X-Api-Key": keyFile},
+		HeaderEnv:   map[string]string{"X-Api-Token": "LEAN_API_TOKEN"},
+	}
+	resolved := endpoint
+	if err := ResolveEndpointSecrets(&resolved); err != nil {
+		t.Fatalf("resolving headers: %v", err)
+	}
+	expected := map[string]string{"X-Network": "devnet", "X-Api-Key": "file-key", "X-Api-Token": "env-token"}
+	if !maps.Equal(resolved.Headers, expected) {
+		t.Errorf("resolved headers %v, want %v", resolved.Headers, expected)
+	}
+	if len(headers) != 1 {
+		t.Errorf("resolving changed the headers of the unresolved endpoint: %v", headers)
+	}
+
+	// A header given inline and by reference is ambiguous
+	endpoint.Headers = map[string]string{"X-Api-Key": "inline-key"}
+	if err := ResolveEndpointSecrets(&endpoint); err == nil || !strings.Contains(err.Error(), "header X-Api-Key") {
+		t.Errorf("resolving an inline and referenced header returned %v", err)
+	}
+}
@@ -1,11 +1,51 @@
 package utils
 
 import (
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/sirupsen/logrus"
+
+	"github.com/syjn99/leanView/backend/types"
 )
 
-func NewLogger() logrus.FieldLogger {
+// NewLogger configures the standard logger with the level, format and optional log file from the config
+func NewLogger(cfg *types.LoggingConfig) (logrus.FieldLogger, error) {
 	logger := logrus.StandardLogger()
-	logger.SetLevel(logrus.TraceLevel)
-	return logger
+
+	if err := ReloadLogger(cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.FilePath != "" {
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("error opening log file %v: %v", cfg.FilePath, err)
+		}
+		logger.SetOutput(io.MultiWriter(os.Stderr, file))
+	}
+
+	return logger, nil
+}
+
+// ReloadLogger applies the level and format from the config to the standard logger,
+// the log file is only opened once by NewLogger
+func ReloadLogger(cfg *types.LoggingConfig) error {
+	logger := logrus.StandardLogger()
+
+	level, err := logrus.ParseLevel(cfg.Level)
+	if err != nil {
+		return fmt.Errorf("invalid log level %q: %v", cfg.Level, err)
+	}
+	logger.SetLevel(level)
+
+	switch cfg.Format {
+	case "json":
+		logger.SetFormatter(&logrus.JSONFormatter{})
+	default:
+		logger.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	}
+
+	return nil
 }
@@ -0,0 +1,33 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/admin.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { AdminService } from "./admin_pb";
+
+/**
+ * List all endpoints in the client pool
+ *
+ * @generated from rpc api.v1.AdminService.ListEndpoints
+ */
+export const listEndpoints = AdminService.method.listEndpoints;
+
+/**
+ * Add a new endpoint to the client pool
+ *
+ * @generated from rpc api.v1.AdminService.AddEndpoint
+ */
+export const addEndpoint = AdminService.method.addEndpoint;
+
+/**
+ * Remove an endpoint from the client pool
+ *
+ * @generated from rpc api.v1.AdminService.RemoveEndpoint
+ */
+export const removeEndpoint = AdminService.method.removeEndpoint;
+
+/**
+ * Make an endpoint the preferred client for polling
+ *
+ * @generated from rpc api.v1.AdminService.SetPrimaryEndpoint
+ */
+export const setPrimaryEndpoint = AdminService.method.setPrimaryEndpoint;
@@ -0,0 +1,420 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/admin.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/admin.proto.
+ */
+export const file_proto_api_v1_admin: GenFile = /*@__PURE__*/
+  fileDesc("Chhwcm90by9hcGkvdjEvYWRtaW4ucHJvdG8SBmFwaS52MSKPAQoIRW5kcG9pbnQSDAoEbmFtZRgBIAEoCRILCgN1cmwYAiABKAkSEgoKaXNfcHJpbWFyeRgDIAEoCBISCgppc19oZWFsdGh5GAQgASgIEhMKC2Zyb21fY29uZmlnGAUgASgIEhcKD2xhc3RfY2hlY2tlZF9tcxgGIAEoAxISCgpsYXN0X2Vycm9yGAcgASgJIhYKFExpc3RFbmRwb2ludHNSZXF1ZXN0IjwKFUxpc3RFbmRwb2ludHNSZXNwb25zZRIjCgllbmRwb2ludHMYASADKAsyEC5hcGkudjEuRW5kcG9pbnQi6gQKEkFkZEVuZHBvaW50UmVxdWVzdBIMCgRuYW1lGAEgASgJEgsKA3VybBgCIAEoCRI4CgdoZWFkZXJzGAMgAygLMicuYXBpLnYxLkFkZEVuZHBvaW50UmVxdWVzdC5IZWFkZXJzRW50cnkSEAoIdXNlcm5hbWUYBCABKAkSFQoNcGFzc3dvcmRfZmlsZRgFIAEoCRIUCgxwYXNzd29yZF9lbnYYBiABKAkSGQoRYmVhcmVyX3Rva2VuX2ZpbGUYByABKAkSGAoQYmVhcmVyX3Rva2VuX2VudhgIIAEoCRITCgt0bHNfY2FfZmlsZRgJIAEoCRIgChh0bHNfaW5zZWN1cmVfc2tpcF92ZXJpZnkYCiABKAgSDQoFcHJveHkYCyABKAkSEgoKdGltZW91dF9tcxgMIAEoBBIZChFoZWFsdGhfdGltZW91dF9tcxgNIAEoBBJBCgxoZWFkZXJfZmlsZXMYDiADKAsyKy5hcGkudjEuQWRkRW5kcG9pbnRSZXF1ZXN0LkhlYWRlckZpbGVzRW50cnkSPQoKaGVhZGVyX2VudhgPIAMoCzIpLmFwaS52MS5BZGRFbmRwb2ludFJlcXVlc3QuSGVhZGVyRW52RW50cnkaLgoMSGVhZGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaMgoQSGVhZGVyRmlsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjAKDkhlYWRlckVudkVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiOgoTQWRkRW5kcG9pbnRSZXNwb25zZRIjCgllbmRwb2ludHMYASADKAsyEC5hcGkudjEuRW5kcG9pbnQiJQoVUmVtb3ZlRW5kcG9pbnRSZXF1ZXN0EgwKBG5hbWUYASABKAkiPQoWUmVtb3ZlRW5kcG9pbnRSZXNwb25zZRIjCgllbmRwb2ludHMYASADKAsyEC5hcGkudjEuRW5kcG9pbnQiKQoZU2V0UHJpbWFyeUVuZHBvaW50UmVxdWVzdBIMCgRuYW1lGAEgASgJIkEKGlNldFByaW1hcnlFbmRwb2ludFJlc3BvbnNlEiMKCWVuZHBvaW50cxgBIAMoCzIQLmFwaS52MS5FbmRwb2ludDLSAgoMQWRtaW5TZXJ2aWNlEkwKDUxpc3RFbmRwb2ludHMSHC5hcGkudjEuTGlzdEVuZHBvaW50c1JlcXVlc3QaHS5hcGkudjEuTGlzdEVuZHBvaW50c1Jlc3BvbnNlEkYKC0FkZEVuZHBvaW50EhouYXBpLnYxLkFkZEVuZHBvaW50UmVxdWVzdBobLmFwaS52MS5BZGRFbmRwb2ludFJlc3BvbnNlEk8KDlJlbW92ZUVuZHBvaW50Eh0uYXBpLnYxLlJlbW92ZUVuZHBvaW50UmVxdWVzdBoeLmFwaS52MS5SZW1vdmVFbmRwb2ludFJlc3BvbnNlElsKElNldFByaW1hcnlFbmRwb2ludBIhLmFwaS52MS5TZXRQcmltYXJ5RW5kcG9pbnRSZXF1ZXN0GiIuYXBpLnYxLlNldFByaW1hcnlFbmRwb2ludFJlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z");
+
+/**
+ * Endpoint describes a lean node endpoint in the client pool
+ *
+ * @generated from message api.v1.Endpoint
+ */
+export type Endpoint = Message<"api.v1.Endpoint"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Credentials redacted
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: bool is_primary = 3;
+   */
+  isPrimary: boolean;
+
+  /**
+   * @generated from field: bool is_healthy = 4;
+   */
+  isHealthy: boolean;
+
+  /**
+   * Whether the endpoint is defined in the config file
+   *
+   * @generated from field: bool from_config = 5;
+   */
+  fromConfig: boolean;
+
+  /**
+   * Unix timestamp in milliseconds of the last health check
+   *
+   * @generated from field: int64 last_checked_ms = 6;
+   */
+  lastCheckedMs: bigint;
+
+  /**
+   * Last health check error (empty if healthy)
+   *
+   * @generated from field: string last_error = 7;
+   */
+  lastError: string;
+};
+
+/**
+ * Describes the message api.v1.Endpoint.
+ * Use `create(EndpointSchema)` to create a new message.
+ */
+export const EndpointSchema: GenMessage<Endpoint> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 0);
+
+/**
+ * Empty - returns all endpoints
+ *
+ * @generated from message api.v1.ListEndpointsRequest
+ */
+export type ListEndpointsRequest = Message<"api.v1.ListEndpointsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListEndpointsRequest.
+ * Use `create(ListEndpointsRequestSchema)` to create a new message.
+ */
+export const ListEndpointsRequestSchema: GenMessage<ListEndpointsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 1);
+
+/**
+ * @generated from message api.v1.ListEndpointsResponse
+ */
+export type ListEndpointsResponse = Message<"api.v1.ListEndpointsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Endpoint endpoints = 1;
+   */
+  endpoints: Endpoint[];
+};
+
+/**
+ * Describes the message api.v1.ListEndpointsResponse.
+ * Use `create(ListEndpointsResponseSchema)` to create a new message.
+ */
+export const ListEndpointsResponseSchema: GenMessage<ListEndpointsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 2);
+
+/**
+ * AddEndpointRequest takes the endpoint options of the config file. Auth secrets and header
+ * values are only accepted as files or environment variables on the indexer host, as the
+ * endpoint is stored.
+ *
+ * @generated from message api.v1.AddEndpointRequest
+ */
+export type AddEndpointRequest = Message<"api.v1.AddEndpointRequest"> & {
+  /**
+   * Unique endpoint name
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Base URL of the lean node API
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * Rejected, header values are given by header_files or header_env
+   *
+   * @generated from field: map<string, string> headers = 3;
+   */
+  headers: { [key: string]: string };
+
+  /**
+   * Basic auth username
+   *
+   * @generated from field: string username = 4;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string password_file = 5;
+   */
+  passwordFile: string;
+
+  /**
+   * @generated from field: string password_env = 6;
+   */
+  passwordEnv: string;
+
+  /**
+   * @generated from field: string bearer_token_file = 7;
+   */
+  bearerTokenFile: string;
+
+  /**
+   * @generated from field: string bearer_token_env = 8;
+   */
+  bearerTokenEnv: string;
+
+  /**
+   * PEM bundle trusted in addition to the system roots
+   *
+   * @generated from field: string tls_ca_file = 9;
+   */
+  tlsCaFile: string;
+
+  /**
+   * @generated from field: bool tls_insecure_skip_verify = 10;
+   */
+  tlsInsecureSkipVerify: boolean;
+
+  /**
+   * Proxy URL, empty uses the environment and "none" connects directly
+   *
+   * @generated from field: string proxy = 11;
+   */
+  proxy: string;
+
+  /**
+   * Overrides indexer.httpTimeout (0 keeps it)
+   *
+   * @generated from field: uint64 timeout_ms = 12;
+   */
+  timeoutMs: bigint;
+
+  /**
+   * Overrides indexer.healthTimeout (0 keeps it)
+   *
+   * @generated from field: uint64 health_timeout_ms = 13;
+   */
+  healthTimeoutMs: bigint;
+
+  /**
+   * Header name to the file its value is read from
+   *
+   * @generated from field: map<string, string> header_files = 14;
+   */
+  headerFiles: { [key: string]: string };
+
+  /**
+   * Header name to the environment variable holding its value
+   *
+   * @generated from field: map<string, string> header_env = 15;
+   */
+  headerEnv: { [key: string]: string };
+};
+
+/**
+ * Describes the message api.v1.AddEndpointRequest.
+ * Use `create(AddEndpointRequestSchema)` to create a new message.
+ */
+export const AddEndpointRequestSchema: GenMessage<AddEndpointRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 3);
+
+/**
+ * @generated from message api.v1.AddEndpointRequest.HeadersEntry
+ */
+export type AddEndpointRequest_HeadersEntry = Message<"api.v1.AddEndpointRequest.HeadersEntry"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message api.v1.AddEndpointRequest.HeadersEntry.
+ * Use `create(AddEndpointRequest_HeadersEntrySchema)` to create a new message.
+ */
+export const AddEndpointRequest_HeadersEntrySchema: GenMessage<AddEndpointRequest_HeadersEntry> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 3, 0);
+
+/**
+ * @generated from message api.v1.AddEndpointRequest.HeaderFilesEntry
+ */
+export type AddEndpointRequest_HeaderFilesEntry = Message<"api.v1.AddEndpointRequest.HeaderFilesEntry"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message api.v1.AddEndpointRequest.HeaderFilesEntry.
+ * Use `create(AddEndpointRequest_HeaderFilesEntrySchema)` to create a new message.
+ */
+export const AddEndpointRequest_HeaderFilesEntrySchema: GenMessage<AddEndpointRequest_HeaderFilesEntry> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 3, 1);
+
+/**
+ * @generated from message api.v1.AddEndpointRequest.HeaderEnvEntry
+ */
+export type AddEndpointRequest_HeaderEnvEntry = Message<"api.v1.AddEndpointRequest.HeaderEnvEntry"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message api.v1.AddEndpointRequest.HeaderEnvEntry.
+ * Use `create(AddEndpointRequest_HeaderEnvEntrySchema)` to create a new message.
+ */
+export const AddEndpointRequest_HeaderEnvEntrySchema: GenMessage<AddEndpointRequest_HeaderEnvEntry> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 3, 2);
+
+/**
+ * @generated from message api.v1.AddEndpointResponse
+ */
+export type AddEndpointResponse = Message<"api.v1.AddEndpointResponse"> & {
+  /**
+   * Endpoints after the change
+   *
+   * @generated from field: repeated api.v1.Endpoint endpoints = 1;
+   */
+  endpoints: Endpoint[];
+};
+
+/**
+ * Describes the message api.v1.AddEndpointResponse.
+ * Use `create(AddEndpointResponseSchema)` to create a new message.
+ */
+export const AddEndpointResponseSchema: GenMessage<AddEndpointResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 4);
+
+/**
+ * @generated from message api.v1.RemoveEndpointRequest
+ */
+export type RemoveEndpointRequest = Message<"api.v1.RemoveEndpointRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.v1.RemoveEndpointRequest.
+ * Use `create(RemoveEndpointRequestSchema)` to create a new message.
+ */
+export const RemoveEndpointRequestSchema: GenMessage<RemoveEndpointRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 5);
+
+/**
+ * @generated from message api.v1.RemoveEndpointResponse
+ */
+export type RemoveEndpointResponse = Message<"api.v1.RemoveEndpointResponse"> & {
+  /**
+   * Endpoints after the change
+   *
+   * @generated from field: repeated api.v1.Endpoint endpoints = 1;
+   */
+  endpoints: Endpoint[];
+};
+
+/**
+ * Describes the message api.v1.RemoveEndpointResponse.
+ * Use `create(RemoveEndpointResponseSchema)` to create a new message.
+ */
+export const RemoveEndpointResponseSchema: GenMessage<RemoveEndpointResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 6);
+
+/**
+ * @generated from message api.v1.SetPrimaryEndpointRequest
+ */
+export type SetPrimaryEndpointRequest = Message<"api.v1.SetPrimaryEndpointRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.v1.SetPrimaryEndpointRequest.
+ * Use `create(SetPrimaryEndpointRequestSchema)` to create a new message.
+ */
+export const SetPrimaryEndpointRequestSchema: GenMessage<SetPrimaryEndpointRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 7);
+
+/**
+ * @generated from message api.v1.SetPrimaryEndpointResponse
+ */
+export type SetPrimaryEndpointResponse = Message<"api.v1.SetPrimaryEndpointResponse"> & {
+  /**
+   * Endpoints after the change
+   *
+   * @generated from field: repeated api.v1.Endpoint endpoints = 1;
+   */
+  endpoints: Endpoint[];
+};
+
+/**
+ * Describes the message api.v1.SetPrimaryEndpointResponse.
+ * Use `create(SetPrimaryEndpointResponseSchema)` to create a new message.
+ */
+export const SetPrimaryEndpointResponseSchema: GenMessage<SetPrimaryEndpointResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_admin, 8);
+
+/**
+ * AdminService manages lean node endpoints at runtime, changes are persisted across restarts
+ *
+ * @generated from service api.v1.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * List all endpoints in the client pool
+   *
+   * @generated from rpc api.v1.AdminService.ListEndpoints
+   */
+  listEndpoints: {
+    methodKind: "unary";
+    input: typeof ListEndpointsRequestSchema;
+    output: typeof ListEndpointsResponseSchema;
+  },
+  /**
+   * Add a new endpoint to the client pool
+   *
+   * @generated from rpc api.v1.AdminService.AddEndpoint
+   */
+  addEndpoint: {
+    methodKind: "unary";
+    input: typeof AddEndpointRequestSchema;
+    output: typeof AddEndpointResponseSchema;
+  },
+  /**
+   * Remove an endpoint from the client pool
+   *
+   * @generated from rpc api.v1.AdminService.RemoveEndpoint
+   */
+  removeEndpoint: {
+    methodKind: "unary";
+    input: typeof RemoveEndpointRequestSchema;
+    output: typeof RemoveEndpointResponseSchema;
+  },
+  /**
+   * Make an endpoint the preferred client for polling
+   *
+   * @generated from rpc api.v1.AdminService.SetPrimaryEndpoint
+   */
+  setPrimaryEndpoint: {
+    methodKind: "unary";
+    input: typeof SetPrimaryEndpointRequestSchema;
+    output: typeof SetPrimaryEndpointResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_admin, 0);
+
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/api/v1/block.proto.
  */
 export const file_proto_api_v1_block: GenFile = /*@__PURE__*/
-  fileDesc("Chhwcm90by9hcGkvdjEvYmxvY2sucHJvdG8SBmFwaS52MSJvCgtCbG9ja0hlYWRlchIMCgRzbG90GAEgASgEEhYKDnByb3Bvc2VyX2luZGV4GAIgASgEEhMKC3BhcmVudF9yb290GAMgASgJEhIKCnN0YXRlX3Jvb3QYBCABKAkSEQoJYm9keV9yb290GAUgASgJIh0KG0dldExhdGVzdEJsb2NrSGVhZGVyUmVxdWVzdCJdChxHZXRMYXRlc3RCbG9ja0hlYWRlclJlc3BvbnNlEikKDGJsb2NrX2hlYWRlchgBIAEoCzITLmFwaS52MS5CbG9ja0hlYWRlchISCgpibG9ja19yb290GAIgASgJIp8BChZHZXRCbG9ja0hlYWRlcnNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgNEg4KBm9mZnNldBgCIAEoBBI8Cgpzb3J0X29yZGVyGAMgASgOMiguYXBpLnYxLkdldEJsb2NrSGVhZGVyc1JlcXVlc3QuU29ydE9yZGVyIigKCVNvcnRPcmRlchINCglTTE9UX0RFU0MQABIMCghTTE9UX0FTQxABIoMBChdHZXRCbG9ja0hlYWRlcnNSZXNwb25zZRIsCgdoZWFkZXJzGAEgAygLMhsuYXBpLnYxLkJsb2NrSGVhZGVyV2l0aFJvb3QSEwoLdG90YWxfY291bnQYAiABKA0SEAoIaGFzX21vcmUYAyABKAgSEwoLbmV4dF9vZmZzZXQYBCABKAQiTgoTQmxvY2tIZWFkZXJXaXRoUm9vdBIjCgZoZWFkZXIYASABKAsyEy5hcGkudjEuQmxvY2tIZWFkZXISEgoKYmxvY2tfcm9vdBgCIAEoCTLFAQoMQmxvY2tTZXJ2aWNlEmEKFEdldExhdGVzdEJsb2NrSGVhZGVyEiMuYXBpLnYxLkdldExhdGVzdEJsb2NrSGVhZGVyUmVxdWVzdBokLmFwaS52MS5HZXRMYXRlc3RCbG9ja0hlYWRlclJlc3BvbnNlElIKD0dldEJsb2NrSGVhZGVycxIeLmFwaS52MS5HZXRCbG9ja0hlYWRlcnNSZXF1ZXN0Gh8uYXBpLnYxLkdldEJsb2NrSGVhZGVyc1Jlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z");
+  fileDesc("Chhwcm90by9hcGkvdjEvYmxvY2sucHJvdG8SBmFwaS52MSJvCgtCbG9ja0hlYWRlchIMCgRzbG90GAEgASgEEhYKDnByb3Bvc2VyX2luZGV4GAIgASgEEhMKC3BhcmVudF9yb290GAMgASgJEhIKCnN0YXRlX3Jvb3QYBCABKAkSEQoJYm9keV9yb290GAUgASgJIh0KG0dldExhdGVzdEJsb2NrSGVhZGVyUmVxdWVzdCKNAQocR2V0TGF0ZXN0QmxvY2tIZWFkZXJSZXNwb25zZRIpCgxibG9ja19oZWFkZXIYASABKAsyEy5hcGkudjEuQmxvY2tIZWFkZXISEgoKYmxvY2tfcm9vdBgCIAEoCRIXCg9wcm9wb3Nlcl9jbGllbnQYAyABKAkSFQoNc291cmNlX2NsaWVudBgEIAEoCSKfAQoWR2V0QmxvY2tIZWFkZXJzUmVxdWVzdBINCgVsaW1pdBgBIAEoDRIOCgZvZmZzZXQYAiABKAQSPAoKc29ydF9vcmRlchgDIAEoDjIoLmFwaS52MS5HZXRCbG9ja0hlYWRlcnNSZXF1ZXN0LlNvcnRPcmRlciIoCglTb3J0T3JkZXISDQoJU0xPVF9ERVNDEAASDAoIU0xPVF9BU0MQASKDAQoXR2V0QmxvY2tIZWFkZXJzUmVzcG9uc2USLAoHaGVhZGVycxgBIAMoCzIbLmFwaS52MS5CbG9ja0hlYWRlcldpdGhSb290EhMKC3RvdGFsX2NvdW50GAIgASgNEhAKCGhhc19tb3JlGAMgASgIEhMKC25leHRfb2Zmc2V0GAQgASgEIn4KE0Jsb2NrSGVhZGVyV2l0aFJvb3QSIwoGaGVhZGVyGAEgASgLMhMuYXBpLnYxLkJsb2NrSGVhZGVyEhIKCmJsb2NrX3Jvb3QYAiABKAkSFwoPcHJvcG9zZXJfY2xpZW50GAMgASgJEhUKDXNvdXJjZV9jbGllbnQYBCABKAkyxQEKDEJsb2NrU2VydmljZRJhChRHZXRMYXRlc3RCbG9ja0hlYWRlchIjLmFwaS52MS5HZXRMYXRlc3RCbG9ja0hlYWRlclJlcXVlc3QaJC5hcGkudjEuR2V0TGF0ZXN0QmxvY2tIZWFkZXJSZXNwb25zZRJSCg9HZXRCbG9ja0hlYWRlcnMSHi5hcGkudjEuR2V0QmxvY2tIZWFkZXJzUmVxdWVzdBofLmFwaS52MS5HZXRCbG9ja0hlYWRlcnNSZXNwb25zZUI7WjlnaXRodWIuY29tL3N5am45OS9sZWFuVmlldy9iYWNrZW5kL2dlbi9wcm90by9hcGkvdjE7YXBpdjFiBnByb3RvMw==");
 
 /**
  * BlockHeader represents essential block information
@@ -87,6 +87,20 @@ export type GetLatestBlockHeaderResponse = Message<"api.v1.GetLatestBlockHeaderR
    * @generated from field: string block_root = 2;
    */
   blockRoot: string;
+
+  /**
+   * Client running the proposer (empty if unknown)
+   *
+   * @generated from field: string proposer_client = 3;
+   */
+  proposerClient: string;
+
+  /**
+   * Client the header was fetched from (empty if unknown)
+   *
+   * @generated from field: string source_client = 4;
+   */
+  sourceClient: string;
 };
 
 /**
@@ -211,6 +225,20 @@ export type BlockHeaderWithRoot = Message<"api.v1.BlockHeaderWithRoot"> & {
    * @generated from field: string block_root = 2;
    */
   blockRoot: string;
+
+  /**
+   * Client running the proposer (empty if unknown)
+   *
+   * @generated from field: string proposer_client = 3;
+   */
+  proposerClient: string;
+
+  /**
+   * Client the header was fetched from (empty if unknown)
+   *
+   * @generated from field: string source_client = 4;
+   */
+  sourceClient: string;
 };
 
 /**
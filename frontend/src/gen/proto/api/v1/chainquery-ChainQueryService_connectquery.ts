@@ -0,0 +1,33 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/chainquery.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { ChainQueryService } from "./chainquery_pb";
+
+/**
+ * Check whether a block is another block or one of its ancestors
+ *
+ * @generated from rpc api.v1.ChainQueryService.IsAncestor
+ */
+export const isAncestor = ChainQueryService.method.isAncestor;
+
+/**
+ * Get the latest block two blocks both descend from
+ *
+ * @generated from rpc api.v1.ChainQueryService.GetCommonAncestor
+ */
+export const getCommonAncestor = ChainQueryService.method.getCommonAncestor;
+
+/**
+ * Get the block at a slot on the chain of a head block
+ *
+ * @generated from rpc api.v1.ChainQueryService.GetAncestorAtSlot
+ */
+export const getAncestorAtSlot = ChainQueryService.method.getAncestorAtSlot;
+
+/**
+ * Get the blocks of a block's branch from the indexed chain up to its tip
+ *
+ * @generated from rpc api.v1.ChainQueryService.GetBranch
+ */
+export const getBranch = ChainQueryService.method.getBranch;
@@ -0,0 +1,270 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/chainquery.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { BlockHeaderWithRoot } from "./block_pb";
+import { file_proto_api_v1_block } from "./block_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/chainquery.proto.
+ */
+export const file_proto_api_v1_chainquery: GenFile = /*@__PURE__*/
+  fileDesc("Ch1wcm90by9hcGkvdjEvY2hhaW5xdWVyeS5wcm90bxIGYXBpLnYxIkMKEUlzQW5jZXN0b3JSZXF1ZXN0EhUKDWFuY2VzdG9yX3Jvb3QYASABKAkSFwoPZGVzY2VuZGFudF9yb290GAIgASgJIikKEklzQW5jZXN0b3JSZXNwb25zZRITCgtpc19hbmNlc3RvchgBIAEoCCI6ChhHZXRDb21tb25BbmNlc3RvclJlcXVlc3QSDgoGcm9vdF9hGAEgASgJEg4KBnJvb3RfYhgCIAEoCSJvChlHZXRDb21tb25BbmNlc3RvclJlc3BvbnNlEioKBWJsb2NrGAEgASgLMhsuYXBpLnYxLkJsb2NrSGVhZGVyV2l0aFJvb3QSEgoKZGlzdGFuY2VfYRgCIAEoBBISCgpkaXN0YW5jZV9iGAMgASgEIjsKGEdldEFuY2VzdG9yQXRTbG90UmVxdWVzdBIRCgloZWFkX3Jvb3QYASABKAkSDAoEc2xvdBgCIAEoBCJeChlHZXRBbmNlc3RvckF0U2xvdFJlc3BvbnNlEioKBWJsb2NrGAEgASgLMhsuYXBpLnYxLkJsb2NrSGVhZGVyV2l0aFJvb3QSFQoNaXNfZW1wdHlfc2xvdBgCIAEoCCIgChBHZXRCcmFuY2hSZXF1ZXN0EgwKBHJvb3QYASABKAkiqwEKEUdldEJyYW5jaFJlc3BvbnNlEikKBGJhc2UYASABKAsyGy5hcGkudjEuQmxvY2tIZWFkZXJXaXRoUm9vdBIrCgZibG9ja3MYAiADKAsyGy5hcGkudjEuQmxvY2tIZWFkZXJXaXRoUm9vdBIoCgN0aXAYAyABKAsyGy5hcGkudjEuQmxvY2tIZWFkZXJXaXRoUm9vdBIUCgxpc19jYW5vbmljYWwYBCABKAgyzgIKEUNoYWluUXVlcnlTZXJ2aWNlEkMKCklzQW5jZXN0b3ISGS5hcGkudjEuSXNBbmNlc3RvclJlcXVlc3QaGi5hcGkudjEuSXNBbmNlc3RvclJlc3BvbnNlElgKEUdldENvbW1vbkFuY2VzdG9yEiAuYXBpLnYxLkdldENvbW1vbkFuY2VzdG9yUmVxdWVzdBohLmFwaS52MS5HZXRDb21tb25BbmNlc3RvclJlc3BvbnNlElgKEUdldEFuY2VzdG9yQXRTbG90EiAuYXBpLnYxLkdldEFuY2VzdG9yQXRTbG90UmVxdWVzdBohLmFwaS52MS5HZXRBbmNlc3RvckF0U2xvdFJlc3BvbnNlEkAKCUdldEJyYW5jaBIYLmFwaS52MS5HZXRCcmFuY2hSZXF1ZXN0GhkuYXBpLnYxLkdldEJyYW5jaFJlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_proto_api_v1_block]);
+
+/**
+ * IsAncestorRequest - roots are hex encoded with 0x prefix
+ *
+ * @generated from message api.v1.IsAncestorRequest
+ */
+export type IsAncestorRequest = Message<"api.v1.IsAncestorRequest"> & {
+  /**
+   * @generated from field: string ancestor_root = 1;
+   */
+  ancestorRoot: string;
+
+  /**
+   * @generated from field: string descendant_root = 2;
+   */
+  descendantRoot: string;
+};
+
+/**
+ * Describes the message api.v1.IsAncestorRequest.
+ * Use `create(IsAncestorRequestSchema)` to create a new message.
+ */
+export const IsAncestorRequestSchema: GenMessage<IsAncestorRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 0);
+
+/**
+ * @generated from message api.v1.IsAncestorResponse
+ */
+export type IsAncestorResponse = Message<"api.v1.IsAncestorResponse"> & {
+  /**
+   * @generated from field: bool is_ancestor = 1;
+   */
+  isAncestor: boolean;
+};
+
+/**
+ * Describes the message api.v1.IsAncestorResponse.
+ * Use `create(IsAncestorResponseSchema)` to create a new message.
+ */
+export const IsAncestorResponseSchema: GenMessage<IsAncestorResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 1);
+
+/**
+ * GetCommonAncestorRequest - roots are hex encoded with 0x prefix, e.g. the heads of two clients
+ *
+ * @generated from message api.v1.GetCommonAncestorRequest
+ */
+export type GetCommonAncestorRequest = Message<"api.v1.GetCommonAncestorRequest"> & {
+  /**
+   * @generated from field: string root_a = 1;
+   */
+  rootA: string;
+
+  /**
+   * @generated from field: string root_b = 2;
+   */
+  rootB: string;
+};
+
+/**
+ * Describes the message api.v1.GetCommonAncestorRequest.
+ * Use `create(GetCommonAncestorRequestSchema)` to create a new message.
+ */
+export const GetCommonAncestorRequestSchema: GenMessage<GetCommonAncestorRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 2);
+
+/**
+ * @generated from message api.v1.GetCommonAncestorResponse
+ */
+export type GetCommonAncestorResponse = Message<"api.v1.GetCommonAncestorResponse"> & {
+  /**
+   * @generated from field: api.v1.BlockHeaderWithRoot block = 1;
+   */
+  block?: BlockHeaderWithRoot;
+
+  /**
+   * Slots from the common ancestor to block A
+   *
+   * @generated from field: uint64 distance_a = 2;
+   */
+  distanceA: bigint;
+
+  /**
+   * Slots from the common ancestor to block B
+   *
+   * @generated from field: uint64 distance_b = 3;
+   */
+  distanceB: bigint;
+};
+
+/**
+ * Describes the message api.v1.GetCommonAncestorResponse.
+ * Use `create(GetCommonAncestorResponseSchema)` to create a new message.
+ */
+export const GetCommonAncestorResponseSchema: GenMessage<GetCommonAncestorResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 3);
+
+/**
+ * GetAncestorAtSlotRequest - head_root is hex encoded with 0x prefix
+ *
+ * @generated from message api.v1.GetAncestorAtSlotRequest
+ */
+export type GetAncestorAtSlotRequest = Message<"api.v1.GetAncestorAtSlotRequest"> & {
+  /**
+   * @generated from field: string head_root = 1;
+   */
+  headRoot: string;
+
+  /**
+   * @generated from field: uint64 slot = 2;
+   */
+  slot: bigint;
+};
+
+/**
+ * Describes the message api.v1.GetAncestorAtSlotRequest.
+ * Use `create(GetAncestorAtSlotRequestSchema)` to create a new message.
+ */
+export const GetAncestorAtSlotRequestSchema: GenMessage<GetAncestorAtSlotRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 4);
+
+/**
+ * @generated from message api.v1.GetAncestorAtSlotResponse
+ */
+export type GetAncestorAtSlotResponse = Message<"api.v1.GetAncestorAtSlotResponse"> & {
+  /**
+   * Latest block at or before the slot
+   *
+   * @generated from field: api.v1.BlockHeaderWithRoot block = 1;
+   */
+  block?: BlockHeaderWithRoot;
+
+  /**
+   * No block at the slot on this chain
+   *
+   * @generated from field: bool is_empty_slot = 2;
+   */
+  isEmptySlot: boolean;
+};
+
+/**
+ * Describes the message api.v1.GetAncestorAtSlotResponse.
+ * Use `create(GetAncestorAtSlotResponseSchema)` to create a new message.
+ */
+export const GetAncestorAtSlotResponseSchema: GenMessage<GetAncestorAtSlotResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 5);
+
+/**
+ * GetBranchRequest - root is hex encoded with 0x prefix
+ *
+ * @generated from message api.v1.GetBranchRequest
+ */
+export type GetBranchRequest = Message<"api.v1.GetBranchRequest"> & {
+  /**
+   * @generated from field: string root = 1;
+   */
+  root: string;
+};
+
+/**
+ * Describes the message api.v1.GetBranchRequest.
+ * Use `create(GetBranchRequestSchema)` to create a new message.
+ */
+export const GetBranchRequestSchema: GenMessage<GetBranchRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 6);
+
+/**
+ * @generated from message api.v1.GetBranchResponse
+ */
+export type GetBranchResponse = Message<"api.v1.GetBranchResponse"> & {
+  /**
+   * Last block on the indexed chain (unset if the ancestry is unknown)
+   *
+   * @generated from field: api.v1.BlockHeaderWithRoot base = 1;
+   */
+  base?: BlockHeaderWithRoot;
+
+  /**
+   * Blocks after the base up to the tip, ordered by slot
+   *
+   * @generated from field: repeated api.v1.BlockHeaderWithRoot blocks = 2;
+   */
+  blocks: BlockHeaderWithRoot[];
+
+  /**
+   * Highest block of the branch
+   *
+   * @generated from field: api.v1.BlockHeaderWithRoot tip = 3;
+   */
+  tip?: BlockHeaderWithRoot;
+
+  /**
+   * The block is on the indexed chain
+   *
+   * @generated from field: bool is_canonical = 4;
+   */
+  isCanonical: boolean;
+};
+
+/**
+ * Describes the message api.v1.GetBranchResponse.
+ * Use `create(GetBranchResponseSchema)` to create a new message.
+ */
+export const GetBranchResponseSchema: GenMessage<GetBranchResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_chainquery, 7);
+
+/**
+ * ChainQueryService answers ancestry questions over the block tree and the indexed chain
+ *
+ * @generated from service api.v1.ChainQueryService
+ */
+export const ChainQueryService: GenService<{
+  /**
+   * Check whether a block is another block or one of its ancestors
+   *
+   * @generated from rpc api.v1.ChainQueryService.IsAncestor
+   */
+  isAncestor: {
+    methodKind: "unary";
+    input: typeof IsAncestorRequestSchema;
+    output: typeof IsAncestorResponseSchema;
+  },
+  /**
+   * Get the latest block two blocks both descend from
+   *
+   * @generated from rpc api.v1.ChainQueryService.GetCommonAncestor
+   */
+  getCommonAncestor: {
+    methodKind: "unary";
+    input: typeof GetCommonAncestorRequestSchema;
+    output: typeof GetCommonAncestorResponseSchema;
+  },
+  /**
+   * Get the block at a slot on the chain of a head block
+   *
+   * @generated from rpc api.v1.ChainQueryService.GetAncestorAtSlot
+   */
+  getAncestorAtSlot: {
+    methodKind: "unary";
+    input: typeof GetAncestorAtSlotRequestSchema;
+    output: typeof GetAncestorAtSlotResponseSchema;
+  },
+  /**
+   * Get the blocks of a block's branch from the indexed chain up to its tip
+   *
+   * @generated from rpc api.v1.ChainQueryService.GetBranch
+   */
+  getBranch: {
+    methodKind: "unary";
+    input: typeof GetBranchRequestSchema;
+    output: typeof GetBranchResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_chainquery, 0);
+
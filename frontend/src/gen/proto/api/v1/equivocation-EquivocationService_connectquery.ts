@@ -0,0 +1,12 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/equivocation.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { EquivocationService } from "./equivocation_pb";
+
+/**
+ * Get detected double proposals, double votes and surround votes
+ *
+ * @generated from rpc api.v1.EquivocationService.GetEquivocations
+ */
+export const getEquivocations = EquivocationService.method.getEquivocations;
@@ -0,0 +1,243 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/equivocation.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { BlockHeaderWithRoot } from "./block_pb";
+import { file_proto_api_v1_block } from "./block_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/equivocation.proto.
+ */
+export const file_proto_api_v1_equivocation: GenFile = /*@__PURE__*/
+  fileDesc("Ch9wcm90by9hcGkvdjEvZXF1aXZvY2F0aW9uLnByb3RvEgZhcGkudjEipAEKBFZvdGUSFAoMdmFsaWRhdG9yX2lkGAEgASgEEgwKBHNsb3QYAiABKAQSEQoJaGVhZF9yb290GAMgASgJEhEKCWhlYWRfc2xvdBgEIAEoBBITCgt0YXJnZXRfcm9vdBgFIAEoCRITCgt0YXJnZXRfc2xvdBgGIAEoBBITCgtzb3VyY2Vfcm9vdBgHIAEoCRITCgtzb3VyY2Vfc2xvdBgIIAEoBCJ8ChRFcXVpdm9jYXRpb25FdmlkZW5jZRIMCgRyb290GAEgASgJEg4KBmNsaWVudBgCIAEoCRIqCgVibG9jaxgDIAEoCzIbLmFwaS52MS5CbG9ja0hlYWRlcldpdGhSb290EhoKBHZvdGUYBCABKAsyDC5hcGkudjEuVm90ZSLBAQoMRXF1aXZvY2F0aW9uEgwKBGtpbmQYASABKAkSFAoMdmFsaWRhdG9yX2lkGAIgASgEEhgKEHZhbGlkYXRvcl9jbGllbnQYAyABKAkSDAoEc2xvdBgEIAEoBBInCgFhGAUgASgLMhwuYXBpLnYxLkVxdWl2b2NhdGlvbkV2aWRlbmNlEicKAWIYBiABKAsyHC5hcGkudjEuRXF1aXZvY2F0aW9uRXZpZGVuY2USEwoLZGV0ZWN0ZWRfYXQYByABKAMiNgoXR2V0RXF1aXZvY2F0aW9uc1JlcXVlc3QSDAoEa2luZBgBIAEoCRINCgVsaW1pdBgCIAEoDSJHChhHZXRFcXVpdm9jYXRpb25zUmVzcG9uc2USKwoNZXF1aXZvY2F0aW9ucxgBIAMoCzIULmFwaS52MS5FcXVpdm9jYXRpb24ybAoTRXF1aXZvY2F0aW9uU2VydmljZRJVChBHZXRFcXVpdm9jYXRpb25zEh8uYXBpLnYxLkdldEVxdWl2b2NhdGlvbnNSZXF1ZXN0GiAuYXBpLnYxLkdldEVxdWl2b2NhdGlvbnNSZXNwb25zZUI7WjlnaXRodWIuY29tL3N5am45OS9sZWFuVmlldy9iYWNrZW5kL2dlbi9wcm90by9hcGkvdjE7YXBpdjFiBnByb3RvMw==", [file_proto_api_v1_block]);
+
+/**
+ * Vote is a validator's vote for a head, and a target justified from a source
+ *
+ * @generated from message api.v1.Vote
+ */
+export type Vote = Message<"api.v1.Vote"> & {
+  /**
+   * @generated from field: uint64 validator_id = 1;
+   */
+  validatorId: bigint;
+
+  /**
+   * @generated from field: uint64 slot = 2;
+   */
+  slot: bigint;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string head_root = 3;
+   */
+  headRoot: string;
+
+  /**
+   * @generated from field: uint64 head_slot = 4;
+   */
+  headSlot: bigint;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string target_root = 5;
+   */
+  targetRoot: string;
+
+  /**
+   * @generated from field: uint64 target_slot = 6;
+   */
+  targetSlot: bigint;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string source_root = 7;
+   */
+  sourceRoot: string;
+
+  /**
+   * @generated from field: uint64 source_slot = 8;
+   */
+  sourceSlot: bigint;
+};
+
+/**
+ * Describes the message api.v1.Vote.
+ * Use `create(VoteSchema)` to create a new message.
+ */
+export const VoteSchema: GenMessage<Vote> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_equivocation, 0);
+
+/**
+ * EquivocationEvidence is one of the two conflicting messages
+ *
+ * @generated from message api.v1.EquivocationEvidence
+ */
+export type EquivocationEvidence = Message<"api.v1.EquivocationEvidence"> & {
+  /**
+   * Hash tree root of the header or vote, hex encoded with 0x prefix
+   *
+   * @generated from field: string root = 1;
+   */
+  root: string;
+
+  /**
+   * Client the message was observed from
+   *
+   * @generated from field: string client = 2;
+   */
+  client: string;
+
+  /**
+   * Set for double proposals
+   *
+   * @generated from field: api.v1.BlockHeaderWithRoot block = 3;
+   */
+  block?: BlockHeaderWithRoot;
+
+  /**
+   * Set for double and surround votes
+   *
+   * @generated from field: api.v1.Vote vote = 4;
+   */
+  vote?: Vote;
+};
+
+/**
+ * Describes the message api.v1.EquivocationEvidence.
+ * Use `create(EquivocationEvidenceSchema)` to create a new message.
+ */
+export const EquivocationEvidenceSchema: GenMessage<EquivocationEvidence> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_equivocation, 1);
+
+/**
+ * Equivocation is a validator signing two conflicting messages
+ *
+ * @generated from message api.v1.Equivocation
+ */
+export type Equivocation = Message<"api.v1.Equivocation"> & {
+  /**
+   * double_proposal, double_vote or surround_vote
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * Proposer index or voting validator
+   *
+   * @generated from field: uint64 validator_id = 2;
+   */
+  validatorId: bigint;
+
+  /**
+   * Client running the validator (empty if unknown)
+   *
+   * @generated from field: string validator_client = 3;
+   */
+  validatorClient: string;
+
+  /**
+   * Slot of message a
+   *
+   * @generated from field: uint64 slot = 4;
+   */
+  slot: bigint;
+
+  /**
+   * Observed first, the surrounding vote for surround votes
+   *
+   * @generated from field: api.v1.EquivocationEvidence a = 5;
+   */
+  a?: EquivocationEvidence;
+
+  /**
+   * @generated from field: api.v1.EquivocationEvidence b = 6;
+   */
+  b?: EquivocationEvidence;
+
+  /**
+   * Unix timestamp in milliseconds
+   *
+   * @generated from field: int64 detected_at = 7;
+   */
+  detectedAt: bigint;
+};
+
+/**
+ * Describes the message api.v1.Equivocation.
+ * Use `create(EquivocationSchema)` to create a new message.
+ */
+export const EquivocationSchema: GenMessage<Equivocation> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_equivocation, 2);
+
+/**
+ * GetEquivocationsRequest - newest slot first
+ *
+ * @generated from message api.v1.GetEquivocationsRequest
+ */
+export type GetEquivocationsRequest = Message<"api.v1.GetEquivocationsRequest"> & {
+  /**
+   * Empty for every kind
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * Max equivocations to return (default: 50, max: 500)
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message api.v1.GetEquivocationsRequest.
+ * Use `create(GetEquivocationsRequestSchema)` to create a new message.
+ */
+export const GetEquivocationsRequestSchema: GenMessage<GetEquivocationsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_equivocation, 3);
+
+/**
+ * @generated from message api.v1.GetEquivocationsResponse
+ */
+export type GetEquivocationsResponse = Message<"api.v1.GetEquivocationsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Equivocation equivocations = 1;
+   */
+  equivocations: Equivocation[];
+};
+
+/**
+ * Describes the message api.v1.GetEquivocationsResponse.
+ * Use `create(GetEquivocationsResponseSchema)` to create a new message.
+ */
+export const GetEquivocationsResponseSchema: GenMessage<GetEquivocationsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_equivocation, 4);
+
+/**
+ * EquivocationService exposes the evidence of validators signing conflicting blocks or votes
+ *
+ * @generated from service api.v1.EquivocationService
+ */
+export const EquivocationService: GenService<{
+  /**
+   * Get detected double proposals, double votes and surround votes
+   *
+   * @generated from rpc api.v1.EquivocationService.GetEquivocations
+   */
+  getEquivocations: {
+    methodKind: "unary";
+    input: typeof GetEquivocationsRequestSchema;
+    output: typeof GetEquivocationsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_equivocation, 0);
+
@@ -0,0 +1,12 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/forkchoice.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { ForkChoiceService } from "./forkchoice_pb";
+
+/**
+ * Get the blocks since the finalized checkpoint with vote weights and each client's head
+ *
+ * @generated from rpc api.v1.ForkChoiceService.GetForkChoiceTree
+ */
+export const getForkChoiceTree = ForkChoiceService.method.getForkChoiceTree;
@@ -0,0 +1,249 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/forkchoice.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/forkchoice.proto.
+ */
+export const file_proto_api_v1_forkchoice: GenFile = /*@__PURE__*/
+  fileDesc("Ch1wcm90by9hcGkvdjEvZm9ya2Nob2ljZS5wcm90bxIGYXBpLnYxIukBCg5Gb3JrQ2hvaWNlTm9kZRIMCgRyb290GAEgASgJEhMKC3BhcmVudF9yb290GAIgASgJEgwKBHNsb3QYAyABKAQSFgoOcHJvcG9zZXJfaW5kZXgYBCABKAQSFwoPcHJvcG9zZXJfY2xpZW50GAUgASgJEg4KBndlaWdodBgGIAEoBBIPCgdpc19oZWFkGAcgASgIEhIKCmlzX2luZGV4ZWQYCCABKAgSFAoMaXNfanVzdGlmaWVkGAkgASgIEhQKDGlzX2ZpbmFsaXplZBgKIAEoCBIUCgxjbGllbnRfaGVhZHMYCyADKAkiaQoURm9ya0Nob2ljZUNsaWVudEhlYWQSDgoGY2xpZW50GAEgASgJEgwKBHJvb3QYAiABKAkSDAoEc2xvdBgDIAEoBBIPCgdpbl90cmVlGAQgASgIEhQKDG1hdGNoZXNfaGVhZBgFIAEoCCIaChhHZXRGb3JrQ2hvaWNlVHJlZVJlcXVlc3QikAIKGUdldEZvcmtDaG9pY2VUcmVlUmVzcG9uc2USJQoFbm9kZXMYASADKAsyFi5hcGkudjEuRm9ya0Nob2ljZU5vZGUSEQoJaGVhZF9yb290GAIgASgJEhEKCWhlYWRfc2xvdBgDIAEoBBIZChFpbmRleGVkX2hlYWRfcm9vdBgEIAEoCRIZChFpbmRleGVkX2hlYWRfc2xvdBgFIAEoBBISCgpzdGFydF9yb290GAYgASgJEhIKCnN0YXJ0X3Nsb3QYByABKAQSFAoMbGF0ZXN0X3ZvdGVzGAggASgNEjIKDGNsaWVudF9oZWFkcxgJIAMoCzIcLmFwaS52MS5Gb3JrQ2hvaWNlQ2xpZW50SGVhZDJtChFGb3JrQ2hvaWNlU2VydmljZRJYChFHZXRGb3JrQ2hvaWNlVHJlZRIgLmFwaS52MS5HZXRGb3JrQ2hvaWNlVHJlZVJlcXVlc3QaIS5hcGkudjEuR2V0Rm9ya0Nob2ljZVRyZWVSZXNwb25zZUI7WjlnaXRodWIuY29tL3N5am45OS9sZWFuVmlldy9iYWNrZW5kL2dlbi9wcm90by9hcGkvdjE7YXBpdjFiBnByb3RvMw==");
+
+/**
+ * ForkChoiceNode is a block of the tree, parent_root links it to its parent node
+ *
+ * @generated from message api.v1.ForkChoiceNode
+ */
+export type ForkChoiceNode = Message<"api.v1.ForkChoiceNode"> & {
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string root = 1;
+   */
+  root: string;
+
+  /**
+   * Hex encoded with 0x prefix, may not be in the tree
+   *
+   * @generated from field: string parent_root = 2;
+   */
+  parentRoot: string;
+
+  /**
+   * @generated from field: uint64 slot = 3;
+   */
+  slot: bigint;
+
+  /**
+   * @generated from field: uint64 proposer_index = 4;
+   */
+  proposerIndex: bigint;
+
+  /**
+   * Client running the proposer (empty if unknown)
+   *
+   * @generated from field: string proposer_client = 5;
+   */
+  proposerClient: string;
+
+  /**
+   * Latest votes for this block or one of its descendants
+   *
+   * @generated from field: uint64 weight = 6;
+   */
+  weight: bigint;
+
+  /**
+   * Head selected by LMD-GHOST
+   *
+   * @generated from field: bool is_head = 7;
+   */
+  isHead: boolean;
+
+  /**
+   * On the chain stored by the indexer
+   *
+   * @generated from field: bool is_indexed = 8;
+   */
+  isIndexed: boolean;
+
+  /**
+   * Latest justified checkpoint
+   *
+   * @generated from field: bool is_justified = 9;
+   */
+  isJustified: boolean;
+
+  /**
+   * Latest finalized checkpoint
+   *
+   * @generated from field: bool is_finalized = 10;
+   */
+  isFinalized: boolean;
+
+  /**
+   * Clients reporting this block as their head
+   *
+   * @generated from field: repeated string client_heads = 11;
+   */
+  clientHeads: string[];
+};
+
+/**
+ * Describes the message api.v1.ForkChoiceNode.
+ * Use `create(ForkChoiceNodeSchema)` to create a new message.
+ */
+export const ForkChoiceNodeSchema: GenMessage<ForkChoiceNode> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_forkchoice, 0);
+
+/**
+ * ForkChoiceClientHead is the head a client last reported
+ *
+ * @generated from message api.v1.ForkChoiceClientHead
+ */
+export type ForkChoiceClientHead = Message<"api.v1.ForkChoiceClientHead"> & {
+  /**
+   * @generated from field: string client = 1;
+   */
+  client: string;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string root = 2;
+   */
+  root: string;
+
+  /**
+   * @generated from field: uint64 slot = 3;
+   */
+  slot: bigint;
+
+  /**
+   * False if the block is not in the tree, e.g. never indexed
+   *
+   * @generated from field: bool in_tree = 4;
+   */
+  inTree: boolean;
+
+  /**
+   * Same block as the LMD-GHOST head
+   *
+   * @generated from field: bool matches_head = 5;
+   */
+  matchesHead: boolean;
+};
+
+/**
+ * Describes the message api.v1.ForkChoiceClientHead.
+ * Use `create(ForkChoiceClientHeadSchema)` to create a new message.
+ */
+export const ForkChoiceClientHeadSchema: GenMessage<ForkChoiceClientHead> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_forkchoice, 1);
+
+/**
+ * GetForkChoiceTreeRequest - fetch the whole tree
+ *
+ * Empty - returns every block in the tree
+ *
+ * @generated from message api.v1.GetForkChoiceTreeRequest
+ */
+export type GetForkChoiceTreeRequest = Message<"api.v1.GetForkChoiceTreeRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetForkChoiceTreeRequest.
+ * Use `create(GetForkChoiceTreeRequestSchema)` to create a new message.
+ */
+export const GetForkChoiceTreeRequestSchema: GenMessage<GetForkChoiceTreeRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_forkchoice, 2);
+
+/**
+ * @generated from message api.v1.GetForkChoiceTreeResponse
+ */
+export type GetForkChoiceTreeResponse = Message<"api.v1.GetForkChoiceTreeResponse"> & {
+  /**
+   * Ordered by slot, parents before their children
+   *
+   * @generated from field: repeated api.v1.ForkChoiceNode nodes = 1;
+   */
+  nodes: ForkChoiceNode[];
+
+  /**
+   * LMD-GHOST head (empty if the tree is empty)
+   *
+   * @generated from field: string head_root = 2;
+   */
+  headRoot: string;
+
+  /**
+   * @generated from field: uint64 head_slot = 3;
+   */
+  headSlot: bigint;
+
+  /**
+   * Head of the chain stored by the indexer
+   *
+   * @generated from field: string indexed_head_root = 4;
+   */
+  indexedHeadRoot: string;
+
+  /**
+   * @generated from field: uint64 indexed_head_slot = 5;
+   */
+  indexedHeadSlot: bigint;
+
+  /**
+   * Block LMD-GHOST started from: justified or the tree's root
+   *
+   * @generated from field: string start_root = 6;
+   */
+  startRoot: string;
+
+  /**
+   * @generated from field: uint64 start_slot = 7;
+   */
+  startSlot: bigint;
+
+  /**
+   * Validators with a vote counted
+   *
+   * @generated from field: uint32 latest_votes = 8;
+   */
+  latestVotes: number;
+
+  /**
+   * @generated from field: repeated api.v1.ForkChoiceClientHead client_heads = 9;
+   */
+  clientHeads: ForkChoiceClientHead[];
+};
+
+/**
+ * Describes the message api.v1.GetForkChoiceTreeResponse.
+ * Use `create(GetForkChoiceTreeResponseSchema)` to create a new message.
+ */
+export const GetForkChoiceTreeResponseSchema: GenMessage<GetForkChoiceTreeResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_forkchoice, 3);
+
+/**
+ * ForkChoiceService exposes the block tree the indexer runs LMD-GHOST on
+ *
+ * @generated from service api.v1.ForkChoiceService
+ */
+export const ForkChoiceService: GenService<{
+  /**
+   * Get the blocks since the finalized checkpoint with vote weights and each client's head
+   *
+   * @generated from rpc api.v1.ForkChoiceService.GetForkChoiceTree
+   */
+  getForkChoiceTree: {
+    methodKind: "unary";
+    input: typeof GetForkChoiceTreeRequestSchema;
+    output: typeof GetForkChoiceTreeResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_forkchoice, 0);
+
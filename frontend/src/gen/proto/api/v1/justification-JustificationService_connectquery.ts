@@ -0,0 +1,12 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/justification.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { JustificationService } from "./justification_pb";
+
+/**
+ * Get the votes of every candidate target slot since the finalized slot
+ *
+ * @generated from rpc api.v1.JustificationService.GetJustificationProgress
+ */
+export const getJustificationProgress = JustificationService.method.getJustificationProgress;
@@ -0,0 +1,172 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/justification.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/justification.proto.
+ */
+export const file_proto_api_v1_justification: GenFile = /*@__PURE__*/
+  fileDesc("CiBwcm90by9hcGkvdjEvanVzdGlmaWNhdGlvbi5wcm90bxIGYXBpLnYxIoMBChNKdXN0aWZpY2F0aW9uVGFyZ2V0EgwKBHNsb3QYASABKAQSDAoEcm9vdBgCIAEoCRINCgV2b3RlcxgDIAEoBBITCgtqdXN0aWZpYWJsZRgEIAEoCBIRCglqdXN0aWZpZWQYBSABKAgSGQoRanVzdGlmaWVkX2F0X3Nsb3QYBiABKAQiIQofR2V0SnVzdGlmaWNhdGlvblByb2dyZXNzUmVxdWVzdCKFAgogR2V0SnVzdGlmaWNhdGlvblByb2dyZXNzUmVzcG9uc2USEgoKc3RhdGVfc2xvdBgBIAEoBBIWCg5udW1fdmFsaWRhdG9ycxgCIAEoBBIRCgl0aHJlc2hvbGQYAyABKAQSFgoOanVzdGlmaWVkX3Nsb3QYBCABKAQSFgoOanVzdGlmaWVkX3Jvb3QYBSABKAkSFgoOZmluYWxpemVkX3Nsb3QYBiABKAQSFgoOZmluYWxpemVkX3Jvb3QYByABKAkSLAoHdGFyZ2V0cxgIIAMoCzIbLmFwaS52MS5KdXN0aWZpY2F0aW9uVGFyZ2V0EhQKDHN0YXRlX2NsaWVudBgJIAEoCTKFAQoUSnVzdGlmaWNhdGlvblNlcnZpY2USbQoYR2V0SnVzdGlmaWNhdGlvblByb2dyZXNzEicuYXBpLnYxLkdldEp1c3RpZmljYXRpb25Qcm9ncmVzc1JlcXVlc3QaKC5hcGkudjEuR2V0SnVzdGlmaWNhdGlvblByb2dyZXNzUmVzcG9uc2VCO1o5Z2l0aHViLmNvbS9zeWpuOTkvbGVhblZpZXcvYmFja2VuZC9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxYgZwcm90bzM=");
+
+/**
+ * JustificationTarget is a slot that votes may justify
+ *
+ * @generated from message api.v1.JustificationTarget
+ */
+export type JustificationTarget = Message<"api.v1.JustificationTarget"> & {
+  /**
+   * @generated from field: uint64 slot = 1;
+   */
+  slot: bigint;
+
+  /**
+   * Block root hex encoded with 0x prefix
+   *
+   * @generated from field: string root = 2;
+   */
+  root: string;
+
+  /**
+   * Validators whose vote counts, 0 once justified since the state drops its votes
+   *
+   * @generated from field: uint64 votes = 3;
+   */
+  votes: bigint;
+
+  /**
+   * Justifiable relative to the finalized slot
+   *
+   * @generated from field: bool justifiable = 4;
+   */
+  justifiable: boolean;
+
+  /**
+   * @generated from field: bool justified = 5;
+   */
+  justified: boolean;
+
+  /**
+   * Slot of the block whose votes justified it, 0 if unknown
+   *
+   * @generated from field: uint64 justified_at_slot = 6;
+   */
+  justifiedAtSlot: bigint;
+};
+
+/**
+ * Describes the message api.v1.JustificationTarget.
+ * Use `create(JustificationTargetSchema)` to create a new message.
+ */
+export const JustificationTargetSchema: GenMessage<JustificationTarget> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_justification, 0);
+
+/**
+ * GetJustificationProgressRequest - progress at the verified tip, or at the latest state
+ * snapshot without state verification
+ *
+ * Empty - returns every target after the finalized slot
+ *
+ * @generated from message api.v1.GetJustificationProgressRequest
+ */
+export type GetJustificationProgressRequest = Message<"api.v1.GetJustificationProgressRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetJustificationProgressRequest.
+ * Use `create(GetJustificationProgressRequestSchema)` to create a new message.
+ */
+export const GetJustificationProgressRequestSchema: GenMessage<GetJustificationProgressRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_justification, 1);
+
+/**
+ * @generated from message api.v1.GetJustificationProgressResponse
+ */
+export type GetJustificationProgressResponse = Message<"api.v1.GetJustificationProgressResponse"> & {
+  /**
+   * Slot of the block the progress is taken from
+   *
+   * @generated from field: uint64 state_slot = 1;
+   */
+  stateSlot: bigint;
+
+  /**
+   * @generated from field: uint64 num_validators = 2;
+   */
+  numValidators: bigint;
+
+  /**
+   * Votes that justify a target, two thirds of the validators
+   *
+   * @generated from field: uint64 threshold = 3;
+   */
+  threshold: bigint;
+
+  /**
+   * @generated from field: uint64 justified_slot = 4;
+   */
+  justifiedSlot: bigint;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string justified_root = 5;
+   */
+  justifiedRoot: string;
+
+  /**
+   * @generated from field: uint64 finalized_slot = 6;
+   */
+  finalizedSlot: bigint;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string finalized_root = 7;
+   */
+  finalizedRoot: string;
+
+  /**
+   * In slot order
+   *
+   * @generated from field: repeated api.v1.JustificationTarget targets = 8;
+   */
+  targets: JustificationTarget[];
+
+  /**
+   * Client the state snapshot was fetched from, empty for the verified tip
+   *
+   * @generated from field: string state_client = 9;
+   */
+  stateClient: string;
+};
+
+/**
+ * Describes the message api.v1.GetJustificationProgressResponse.
+ * Use `create(GetJustificationProgressResponseSchema)` to create a new message.
+ */
+export const GetJustificationProgressResponseSchema: GenMessage<GetJustificationProgressResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_justification, 2);
+
+/**
+ * JustificationService explains 3SF-mini justification and finalization progress
+ *
+ * @generated from service api.v1.JustificationService
+ */
+export const JustificationService: GenService<{
+  /**
+   * Get the votes of every candidate target slot since the finalized slot
+   *
+   * @generated from rpc api.v1.JustificationService.GetJustificationProgress
+   */
+  getJustificationProgress: {
+    methodKind: "unary";
+    input: typeof GetJustificationProgressRequestSchema;
+    output: typeof GetJustificationProgressResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_justification, 0);
+
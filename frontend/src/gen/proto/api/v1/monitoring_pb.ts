@@ -2,8 +2,8 @@
 // @generated from file proto/api/v1/monitoring.proto (package api.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { BlockHeader } from "./block_pb";
 import { file_proto_api_v1_block } from "./block_pb";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/api/v1/monitoring.proto.
  */
 export const file_proto_api_v1_monitoring: GenFile = /*@__PURE__*/
-  fileDesc("Ch1wcm90by9hcGkvdjEvbW9uaXRvcmluZy5wcm90bxIGYXBpLnYxIqMBCgpDbGllbnRIZWFkEhQKDGNsaWVudF9sYWJlbBgBIAEoCRIUCgxlbmRwb2ludF91cmwYAiABKAkSEgoKaXNfaGVhbHRoeRgDIAEoCBIpCgxibG9ja19oZWFkZXIYBCABKAsyEy5hcGkudjEuQmxvY2tIZWFkZXISEgoKYmxvY2tfcm9vdBgFIAEoCRIWCg5sYXN0X3VwZGF0ZV9tcxgGIAEoAyIbChlHZXRBbGxDbGllbnRzSGVhZHNSZXF1ZXN0InYKGkdldEFsbENsaWVudHNIZWFkc1Jlc3BvbnNlEigKDGNsaWVudF9oZWFkcxgBIAMoCzISLmFwaS52MS5DbGllbnRIZWFkEhUKDXRvdGFsX2NsaWVudHMYAiABKAUSFwoPaGVhbHRoeV9jbGllbnRzGAMgASgFMnAKEU1vbml0b3JpbmdTZXJ2aWNlElsKEkdldEFsbENsaWVudHNIZWFkcxIhLmFwaS52MS5HZXRBbGxDbGllbnRzSGVhZHNSZXF1ZXN0GiIuYXBpLnYxLkdldEFsbENsaWVudHNIZWFkc1Jlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_proto_api_v1_block]);
+  fileDesc("Ch1wcm90by9hcGkvdjEvbW9uaXRvcmluZy5wcm90bxIGYXBpLnYxIoYFCgpDbGllbnRIZWFkEhQKDGNsaWVudF9sYWJlbBgBIAEoCRIUCgxlbmRwb2ludF91cmwYAiABKAkSEgoKaXNfaGVhbHRoeRgDIAEoCBIpCgxibG9ja19oZWFkZXIYBCABKAsyEy5hcGkudjEuQmxvY2tIZWFkZXISEgoKYmxvY2tfcm9vdBgFIAEoCRIWCg5sYXN0X3VwZGF0ZV9tcxgGIAEoAxIcChRoZWFkX3Byb3Bvc2VyX2NsaWVudBgHIAEoCRISCgpsYXRlbmN5X21zGAggASgBEhUKDXJlcXVlc3RfY291bnQYCSABKAQSGAoQcmVxdWVzdF9mYWlsdXJlcxgKIAEoBBI2Cg1icmVha2VyX3N0YXRlGAsgASgOMh8uYXBpLnYxLkNsaWVudEhlYWQuQnJlYWtlclN0YXRlEhwKFGNvbnNlY3V0aXZlX2ZhaWx1cmVzGAwgASgNEhQKDGxhc3RfZmFpbHVyZRgNIAEoCRIXCg9sYXN0X2ZhaWx1cmVfbXMYDiABKAMSFQoNbmV4dF9wcm9iZV9tcxgPIAEoAxIpCgZzdGF0dXMYECABKA4yGS5hcGkudjEuQ2xpZW50SGVhZC5TdGF0dXMSFQoNc3RhdHVzX3JlYXNvbhgRIAEoCRIQCghlbmNvZGluZxgSIAEoCSIzCgxCcmVha2VyU3RhdGUSCgoGQ0xPU0VEEAASCAoET1BFThABEg0KCUhBTEZfT1BFThACIlkKBlN0YXR1cxILCgdVTktOT1dOEAASCwoHSEVBTFRIWRABEgsKB1NZTkNJTkcQAhILCgdTVEFMTEVEEAMSCgoGRk9SS0VEEAQSDwoLVU5SRUFDSEFCTEUQBSIbChlHZXRBbGxDbGllbnRzSGVhZHNSZXF1ZXN0InYKGkdldEFsbENsaWVudHNIZWFkc1Jlc3BvbnNlEigKDGNsaWVudF9oZWFkcxgBIAMoCzISLmFwaS52MS5DbGllbnRIZWFkEhUKDXRvdGFsX2NsaWVudHMYAiABKAUSFwoPaGVhbHRoeV9jbGllbnRzGAMgASgFMnAKEU1vbml0b3JpbmdTZXJ2aWNlElsKEkdldEFsbENsaWVudHNIZWFkcxIhLmFwaS52MS5HZXRBbGxDbGllbnRzSGVhZHNSZXF1ZXN0GiIuYXBpLnYxLkdldEFsbENsaWVudHNIZWFkc1Jlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_proto_api_v1_block]);
 
 /**
  * ClientHead represents a client's current head block
@@ -28,14 +28,14 @@ export type ClientHead = Message<"api.v1.ClientHead"> & {
   clientLabel: string;
 
   /**
-   * Client endpoint URL
+   * Client endpoint URL, credentials redacted
    *
    * @generated from field: string endpoint_url = 2;
    */
   endpointUrl: string;
 
   /**
-   * Whether the client is currently healthy
+   * Whether the client status is HEALTHY
    *
    * @generated from field: bool is_healthy = 3;
    */
@@ -61,6 +61,86 @@ export type ClientHead = Message<"api.v1.ClientHead"> & {
    * @generated from field: int64 last_update_ms = 6;
    */
   lastUpdateMs: bigint;
+
+  /**
+   * Client running the head block's proposer (empty if unknown)
+   *
+   * @generated from field: string head_proposer_client = 7;
+   */
+  headProposerClient: string;
+
+  /**
+   * Moving average of successful request times
+   *
+   * @generated from field: double latency_ms = 8;
+   */
+  latencyMs: number;
+
+  /**
+   * Requests made to the client since startup
+   *
+   * @generated from field: uint64 request_count = 9;
+   */
+  requestCount: bigint;
+
+  /**
+   * Failed requests since startup
+   *
+   * @generated from field: uint64 request_failures = 10;
+   */
+  requestFailures: bigint;
+
+  /**
+   * @generated from field: api.v1.ClientHead.BreakerState breaker_state = 11;
+   */
+  breakerState: ClientHead_BreakerState;
+
+  /**
+   * Consecutive failed requests
+   *
+   * @generated from field: uint32 consecutive_failures = 12;
+   */
+  consecutiveFailures: number;
+
+  /**
+   * Reason of the last failed request (empty if none)
+   *
+   * @generated from field: string last_failure = 13;
+   */
+  lastFailure: string;
+
+  /**
+   * Unix timestamp in milliseconds of the last failed request (0 if none)
+   *
+   * @generated from field: int64 last_failure_ms = 14;
+   */
+  lastFailureMs: bigint;
+
+  /**
+   * Unix timestamp in milliseconds of the next probe while open (0 otherwise)
+   *
+   * @generated from field: int64 next_probe_ms = 15;
+   */
+  nextProbeMs: bigint;
+
+  /**
+   * @generated from field: api.v1.ClientHead.Status status = 16;
+   */
+  status: ClientHead_Status;
+
+  /**
+   * Why the client is not healthy (empty if healthy)
+   *
+   * @generated from field: string status_reason = 17;
+   */
+  statusReason: string;
+
+  /**
+   * Detected header encoding: ssz or json (empty before the first response)
+   *
+   * @generated from field: string encoding = 18;
+   */
+  encoding: string;
 };
 
 /**
@@ -70,6 +150,93 @@ export type ClientHead = Message<"api.v1.ClientHead"> & {
 export const ClientHeadSchema: GenMessage<ClientHead> = /*@__PURE__*/
   messageDesc(file_proto_api_v1_monitoring, 0);
 
+/**
+ * State of the client's circuit breaker
+ *
+ * @generated from enum api.v1.ClientHead.BreakerState
+ */
+export enum ClientHead_BreakerState {
+  /**
+   * Requests flow normally
+   *
+   * @generated from enum value: CLOSED = 0;
+   */
+  CLOSED = 0,
+
+  /**
+   * Requests are rejected until the next probe
+   *
+   * @generated from enum value: OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * A probe request is deciding whether to close
+   *
+   * @generated from enum value: HALF_OPEN = 2;
+   */
+  HALF_OPEN = 2,
+}
+
+/**
+ * Describes the enum api.v1.ClientHead.BreakerState.
+ */
+export const ClientHead_BreakerStateSchema: GenEnum<ClientHead_BreakerState> = /*@__PURE__*/
+  enumDesc(file_proto_api_v1_monitoring, 0, 0);
+
+/**
+ * Client status derived from reachability and head progress
+ *
+ * @generated from enum api.v1.ClientHead.Status
+ */
+export enum ClientHead_Status {
+  /**
+   * @generated from enum value: UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * Reachable and at the network head
+   *
+   * @generated from enum value: HEALTHY = 1;
+   */
+  HEALTHY = 1,
+
+  /**
+   * Reachable and advancing, but behind the network head
+   *
+   * @generated from enum value: SYNCING = 2;
+   */
+  SYNCING = 2,
+
+  /**
+   * Head stopped advancing while the network moved on
+   *
+   * @generated from enum value: STALLED = 3;
+   */
+  STALLED = 3,
+
+  /**
+   * Head conflicts with the indexed chain
+   *
+   * @generated from enum value: FORKED = 4;
+   */
+  FORKED = 4,
+
+  /**
+   * Failing health checks or rejected by the circuit breaker
+   *
+   * @generated from enum value: UNREACHABLE = 5;
+   */
+  UNREACHABLE = 5,
+}
+
+/**
+ * Describes the enum api.v1.ClientHead.Status.
+ */
+export const ClientHead_StatusSchema: GenEnum<ClientHead_Status> = /*@__PURE__*/
+  enumDesc(file_proto_api_v1_monitoring, 0, 1);
+
 /**
  * GetAllClientsHeadsRequest - fetch heads from all clients
  *
@@ -0,0 +1,12 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/network.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { NetworkService } from "./network_pb";
+
+/**
+ * Get a summary of chain progress, finality and client health
+ *
+ * @generated from rpc api.v1.NetworkService.GetNetworkSummary
+ */
+export const getNetworkSummary = NetworkService.method.getNetworkSummary;
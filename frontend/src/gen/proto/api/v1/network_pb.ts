@@ -0,0 +1,254 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/network.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/network.proto.
+ */
+export const file_proto_api_v1_network: GenFile = /*@__PURE__*/
+  fileDesc("Chpwcm90by9hcGkvdjEvbmV0d29yay5wcm90bxIGYXBpLnYxIu8DCg5OZXR3b3JrU3VtbWFyeRIRCgloZWFkX3Nsb3QYASABKAQSFwoPd2FsbF9jbG9ja19zbG90GAIgASgEEhYKDmhlYWRfbGFnX3Nsb3RzGAMgASgDEhYKDmp1c3RpZmllZF9zbG90GAQgASgEEhYKDmZpbmFsaXplZF9zbG90GAUgASgEEhoKEmZpbmFsaXR5X2xhZ19zbG90cxgGIAEoBBIaChJtaXNzZWRfc2xvdF93aW5kb3cYByABKAQSFAoMbWlzc2VkX3Nsb3RzGAggASgEEhgKEG1pc3NlZF9zbG90X3JhdGUYCSABKAESEwoLcmVvcmdfY291bnQYCiABKAQSFwoPaGVhbHRoeV9jbGllbnRzGAsgASgFEhUKDXRvdGFsX2NsaWVudHMYDCABKAUSGgoSY2xpZW50X2hlYWRfc3ByZWFkGA0gASgEEhgKEGxhc3RfZGJfd3JpdGVfbXMYDiABKAMSFwoPZ2VuZXJhdGVkX2F0X21zGA8gASgDEhMKC2hlYWRfY2xpZW50GBAgASgJEhUKDW9wZW5fY2lyY3VpdHMYESABKAUSIgoGbWlzc2VkGBIgAygLMhIuYXBpLnYxLk1pc3NlZFNsb3QSHQoVdW5saW5rZWRfaGVhZF9jaGFuZ2VzGBMgASgEIloKCk1pc3NlZFNsb3QSDAoEc2xvdBgBIAEoBBIWCg5wcm9wb3Nlcl9pbmRleBgCIAEoBBIWCg5wcm9wb3Nlcl9rbm93bhgDIAEoCBIOCgZjbGllbnQYBCABKAkiNgoYR2V0TmV0d29ya1N1bW1hcnlSZXF1ZXN0EhoKEm1pc3NlZF9zbG90X3dpbmRvdxgBIAEoBCJEChlHZXROZXR3b3JrU3VtbWFyeVJlc3BvbnNlEicKB3N1bW1hcnkYASABKAsyFi5hcGkudjEuTmV0d29ya1N1bW1hcnkyagoOTmV0d29ya1NlcnZpY2USWAoRR2V0TmV0d29ya1N1bW1hcnkSIC5hcGkudjEuR2V0TmV0d29ya1N1bW1hcnlSZXF1ZXN0GiEuYXBpLnYxLkdldE5ldHdvcmtTdW1tYXJ5UmVzcG9uc2VCO1o5Z2l0aHViLmNvbS9zeWpuOTkvbGVhblZpZXcvYmFja2VuZC9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxYgZwcm90bzM=");
+
+/**
+ * NetworkSummary describes the state of the whole devnet at a point in time
+ *
+ * @generated from message api.v1.NetworkSummary
+ */
+export type NetworkSummary = Message<"api.v1.NetworkSummary"> & {
+  /**
+   * Head slot from the head cache
+   *
+   * @generated from field: uint64 head_slot = 1;
+   */
+  headSlot: bigint;
+
+  /**
+   * Current slot by wall clock (0 if genesis time is unknown)
+   *
+   * @generated from field: uint64 wall_clock_slot = 2;
+   */
+  wallClockSlot: bigint;
+
+  /**
+   * wall_clock_slot - head_slot
+   *
+   * @generated from field: int64 head_lag_slots = 3;
+   */
+  headLagSlots: bigint;
+
+  /**
+   * Latest justified checkpoint slot
+   *
+   * @generated from field: uint64 justified_slot = 4;
+   */
+  justifiedSlot: bigint;
+
+  /**
+   * Latest finalized checkpoint slot
+   *
+   * @generated from field: uint64 finalized_slot = 5;
+   */
+  finalizedSlot: bigint;
+
+  /**
+   * head_slot - finalized_slot
+   *
+   * @generated from field: uint64 finality_lag_slots = 6;
+   */
+  finalityLagSlots: bigint;
+
+  /**
+   * Slots considered for the missed slot rate
+   *
+   * @generated from field: uint64 missed_slot_window = 7;
+   */
+  missedSlotWindow: bigint;
+
+  /**
+   * Slots without a stored block within the window
+   *
+   * @generated from field: uint64 missed_slots = 8;
+   */
+  missedSlots: bigint;
+
+  /**
+   * missed_slots / missed_slot_window
+   *
+   * @generated from field: double missed_slot_rate = 9;
+   */
+  missedSlotRate: number;
+
+  /**
+   * Head reorgs observed since startup
+   *
+   * @generated from field: uint64 reorg_count = 10;
+   */
+  reorgCount: bigint;
+
+  /**
+   * @generated from field: int32 healthy_clients = 11;
+   */
+  healthyClients: number;
+
+  /**
+   * @generated from field: int32 total_clients = 12;
+   */
+  totalClients: number;
+
+  /**
+   * Highest minus lowest head slot across responding clients
+   *
+   * @generated from field: uint64 client_head_spread = 13;
+   */
+  clientHeadSpread: bigint;
+
+  /**
+   * Unix timestamp in milliseconds of the last DB write (0 if none)
+   *
+   * @generated from field: int64 last_db_write_ms = 14;
+   */
+  lastDbWriteMs: bigint;
+
+  /**
+   * Unix timestamp in milliseconds when the summary was computed
+   *
+   * @generated from field: int64 generated_at_ms = 15;
+   */
+  generatedAtMs: bigint;
+
+  /**
+   * Client that served the last head poll (empty if none yet)
+   *
+   * @generated from field: string head_client = 16;
+   */
+  headClient: string;
+
+  /**
+   * Clients whose circuit breaker is not closed
+   *
+   * @generated from field: int32 open_circuits = 17;
+   */
+  openCircuits: number;
+
+  /**
+   * Slots without a stored block within the window, latest first
+   *
+   * @generated from field: repeated api.v1.MissedSlot missed = 18;
+   */
+  missed: MissedSlot[];
+
+  /**
+   * Head changes whose ancestry to the previous head was unknown, e.g. across a catchup gap, so reorgs among them are not in reorg_count
+   *
+   * @generated from field: uint64 unlinked_head_changes = 19;
+   */
+  unlinkedHeadChanges: bigint;
+};
+
+/**
+ * Describes the message api.v1.NetworkSummary.
+ * Use `create(NetworkSummarySchema)` to create a new message.
+ */
+export const NetworkSummarySchema: GenMessage<NetworkSummary> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_network, 0);
+
+/**
+ * MissedSlot is a slot without a stored block and the proposer expected to fill it
+ *
+ * @generated from message api.v1.MissedSlot
+ */
+export type MissedSlot = Message<"api.v1.MissedSlot"> & {
+  /**
+   * @generated from field: uint64 slot = 1;
+   */
+  slot: bigint;
+
+  /**
+   * Round robin proposer of the slot (0 if the validator count is unknown)
+   *
+   * @generated from field: uint64 proposer_index = 2;
+   */
+  proposerIndex: bigint;
+
+  /**
+   * Whether the validator count is known, so proposer_index is meaningful
+   *
+   * @generated from field: bool proposer_known = 3;
+   */
+  proposerKnown: boolean;
+
+  /**
+   * Client running the proposer per the validator config (empty if unassigned)
+   *
+   * @generated from field: string client = 4;
+   */
+  client: string;
+};
+
+/**
+ * Describes the message api.v1.MissedSlot.
+ * Use `create(MissedSlotSchema)` to create a new message.
+ */
+export const MissedSlotSchema: GenMessage<MissedSlot> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_network, 1);
+
+/**
+ * GetNetworkSummaryRequest - summarize the devnet
+ *
+ * @generated from message api.v1.GetNetworkSummaryRequest
+ */
+export type GetNetworkSummaryRequest = Message<"api.v1.GetNetworkSummaryRequest"> & {
+  /**
+   * Slots ending at the head (default: 64, max: 1024)
+   *
+   * @generated from field: uint64 missed_slot_window = 1;
+   */
+  missedSlotWindow: bigint;
+};
+
+/**
+ * Describes the message api.v1.GetNetworkSummaryRequest.
+ * Use `create(GetNetworkSummaryRequestSchema)` to create a new message.
+ */
+export const GetNetworkSummaryRequestSchema: GenMessage<GetNetworkSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_network, 2);
+
+/**
+ * @generated from message api.v1.GetNetworkSummaryResponse
+ */
+export type GetNetworkSummaryResponse = Message<"api.v1.GetNetworkSummaryResponse"> & {
+  /**
+   * @generated from field: api.v1.NetworkSummary summary = 1;
+   */
+  summary?: NetworkSummary;
+};
+
+/**
+ * Describes the message api.v1.GetNetworkSummaryResponse.
+ * Use `create(GetNetworkSummaryResponseSchema)` to create a new message.
+ */
+export const GetNetworkSummaryResponseSchema: GenMessage<GetNetworkSummaryResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_network, 3);
+
+/**
+ * NetworkService provides a devnet wide health summary
+ *
+ * @generated from service api.v1.NetworkService
+ */
+export const NetworkService: GenService<{
+  /**
+   * Get a summary of chain progress, finality and client health
+   *
+   * @generated from rpc api.v1.NetworkService.GetNetworkSummary
+   */
+  getNetworkSummary: {
+    methodKind: "unary";
+    input: typeof GetNetworkSummaryRequestSchema;
+    output: typeof GetNetworkSummaryResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_network, 0);
+
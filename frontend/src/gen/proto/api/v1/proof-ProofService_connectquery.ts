@@ -0,0 +1,19 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/proof.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { ProofService } from "./proof_pb";
+
+/**
+ * Get Merkle proofs of the fields of a block header
+ *
+ * @generated from rpc api.v1.ProofService.GetHeaderProof
+ */
+export const getHeaderProof = ProofService.method.getHeaderProof;
+
+/**
+ * Compare two block headers field by field, with proofs of every differing field
+ *
+ * @generated from rpc api.v1.ProofService.DiffHeaders
+ */
+export const diffHeaders = ProofService.method.diffHeaders;
@@ -0,0 +1,267 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/proof.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { BlockHeaderWithRoot } from "./block_pb";
+import { file_proto_api_v1_block } from "./block_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/proof.proto.
+ */
+export const file_proto_api_v1_proof: GenFile = /*@__PURE__*/
+  fileDesc("Chhwcm90by9hcGkvdjEvcHJvb2YucHJvdG8SBmFwaS52MSI6CgxIZWFkZXJTb3VyY2USDgoGY2xpZW50GAEgASgJEgwKBHNsb3QYAiABKAQSDAoEcm9vdBgDIAEoCSJUCgpGaWVsZFByb29mEg0KBWZpZWxkGAEgASgJEhkKEWdlbmVyYWxpemVkX2luZGV4GAIgASgEEgwKBGxlYWYYAyABKAkSDgoGYnJhbmNoGAQgAygJIoYBCglGaWVsZERpZmYSDQoFZmllbGQYASABKAkSDwoHdmFsdWVfYRgCIAEoCRIPCgd2YWx1ZV9iGAMgASgJEiMKB3Byb29mX2EYBCABKAsyEi5hcGkudjEuRmllbGRQcm9vZhIjCgdwcm9vZl9iGAUgASgLMhIuYXBpLnYxLkZpZWxkUHJvb2YiTAoVR2V0SGVhZGVyUHJvb2ZSZXF1ZXN0EiQKBnNvdXJjZRgBIAEoCzIULmFwaS52MS5IZWFkZXJTb3VyY2USDQoFZmllbGQYAiABKAkiaAoWR2V0SGVhZGVyUHJvb2ZSZXNwb25zZRIqCgVibG9jaxgBIAEoCzIbLmFwaS52MS5CbG9ja0hlYWRlcldpdGhSb290EiIKBnByb29mcxgCIAMoCzISLmFwaS52MS5GaWVsZFByb29mIlYKEkRpZmZIZWFkZXJzUmVxdWVzdBIfCgFhGAEgASgLMhQuYXBpLnYxLkhlYWRlclNvdXJjZRIfCgFiGAIgASgLMhQuYXBpLnYxLkhlYWRlclNvdXJjZSKuAQoTRGlmZkhlYWRlcnNSZXNwb25zZRIsCgdibG9ja19hGAEgASgLMhsuYXBpLnYxLkJsb2NrSGVhZGVyV2l0aFJvb3QSLAoHYmxvY2tfYhgCIAEoCzIbLmFwaS52MS5CbG9ja0hlYWRlcldpdGhSb290EhMKC3Jvb3RzX21hdGNoGAMgASgIEiYKC2RpZmZlcmVuY2VzGAQgAygLMhEuYXBpLnYxLkZpZWxkRGlmZjKnAQoMUHJvb2ZTZXJ2aWNlEk8KDkdldEhlYWRlclByb29mEh0uYXBpLnYxLkdldEhlYWRlclByb29mUmVxdWVzdBoeLmFwaS52MS5HZXRIZWFkZXJQcm9vZlJlc3BvbnNlEkYKC0RpZmZIZWFkZXJzEhouYXBpLnYxLkRpZmZIZWFkZXJzUmVxdWVzdBobLmFwaS52MS5EaWZmSGVhZGVyc1Jlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_proto_api_v1_block]);
+
+/**
+ * HeaderSource selects a block header from the indexed chain or from a client
+ *
+ * @generated from message api.v1.HeaderSource
+ */
+export type HeaderSource = Message<"api.v1.HeaderSource"> & {
+  /**
+   * Client to fetch the header from, empty for the indexed chain
+   *
+   * @generated from field: string client = 1;
+   */
+  client: string;
+
+  /**
+   * @generated from field: uint64 slot = 2;
+   */
+  slot: bigint;
+
+  /**
+   * Block root hex encoded with 0x prefix, takes precedence over slot
+   *
+   * @generated from field: string root = 3;
+   */
+  root: string;
+};
+
+/**
+ * Describes the message api.v1.HeaderSource.
+ * Use `create(HeaderSourceSchema)` to create a new message.
+ */
+export const HeaderSourceSchema: GenMessage<HeaderSource> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proof, 0);
+
+/**
+ * FieldProof proves the hash tree root of a field against the block root
+ *
+ * @generated from message api.v1.FieldProof
+ */
+export type FieldProof = Message<"api.v1.FieldProof"> & {
+  /**
+   * Field name as in JSON, e.g. state_root
+   *
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: uint64 generalized_index = 2;
+   */
+  generalizedIndex: bigint;
+
+  /**
+   * Hash tree root of the field, hex encoded with 0x prefix
+   *
+   * @generated from field: string leaf = 3;
+   */
+  leaf: string;
+
+  /**
+   * Sibling hashes from the leaf up to the root, hex encoded with 0x prefix
+   *
+   * @generated from field: repeated string branch = 4;
+   */
+  branch: string[];
+};
+
+/**
+ * Describes the message api.v1.FieldProof.
+ * Use `create(FieldProofSchema)` to create a new message.
+ */
+export const FieldProofSchema: GenMessage<FieldProof> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proof, 1);
+
+/**
+ * FieldDiff is a field that differs between two headers
+ *
+ * @generated from message api.v1.FieldDiff
+ */
+export type FieldDiff = Message<"api.v1.FieldDiff"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * Decimal for numbers, hex encoded with 0x prefix for roots
+   *
+   * @generated from field: string value_a = 2;
+   */
+  valueA: string;
+
+  /**
+   * @generated from field: string value_b = 3;
+   */
+  valueB: string;
+
+  /**
+   * @generated from field: api.v1.FieldProof proof_a = 4;
+   */
+  proofA?: FieldProof;
+
+  /**
+   * @generated from field: api.v1.FieldProof proof_b = 5;
+   */
+  proofB?: FieldProof;
+};
+
+/**
+ * Describes the message api.v1.FieldDiff.
+ * Use `create(FieldDiffSchema)` to create a new message.
+ */
+export const FieldDiffSchema: GenMessage<FieldDiff> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proof, 2);
+
+/**
+ * GetHeaderProofRequest - prove one field or all of them
+ *
+ * @generated from message api.v1.GetHeaderProofRequest
+ */
+export type GetHeaderProofRequest = Message<"api.v1.GetHeaderProofRequest"> & {
+  /**
+   * @generated from field: api.v1.HeaderSource source = 1;
+   */
+  source?: HeaderSource;
+
+  /**
+   * Empty proves every field
+   *
+   * @generated from field: string field = 2;
+   */
+  field: string;
+};
+
+/**
+ * Describes the message api.v1.GetHeaderProofRequest.
+ * Use `create(GetHeaderProofRequestSchema)` to create a new message.
+ */
+export const GetHeaderProofRequestSchema: GenMessage<GetHeaderProofRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proof, 3);
+
+/**
+ * @generated from message api.v1.GetHeaderProofResponse
+ */
+export type GetHeaderProofResponse = Message<"api.v1.GetHeaderProofResponse"> & {
+  /**
+   * @generated from field: api.v1.BlockHeaderWithRoot block = 1;
+   */
+  block?: BlockHeaderWithRoot;
+
+  /**
+   * In SSZ field order
+   *
+   * @generated from field: repeated api.v1.FieldProof proofs = 2;
+   */
+  proofs: FieldProof[];
+};
+
+/**
+ * Describes the message api.v1.GetHeaderProofResponse.
+ * Use `create(GetHeaderProofResponseSchema)` to create a new message.
+ */
+export const GetHeaderProofResponseSchema: GenMessage<GetHeaderProofResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proof, 4);
+
+/**
+ * DiffHeadersRequest - compare e.g. the headers two clients have at a slot
+ *
+ * @generated from message api.v1.DiffHeadersRequest
+ */
+export type DiffHeadersRequest = Message<"api.v1.DiffHeadersRequest"> & {
+  /**
+   * @generated from field: api.v1.HeaderSource a = 1;
+   */
+  a?: HeaderSource;
+
+  /**
+   * @generated from field: api.v1.HeaderSource b = 2;
+   */
+  b?: HeaderSource;
+};
+
+/**
+ * Describes the message api.v1.DiffHeadersRequest.
+ * Use `create(DiffHeadersRequestSchema)` to create a new message.
+ */
+export const DiffHeadersRequestSchema: GenMessage<DiffHeadersRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proof, 5);
+
+/**
+ * @generated from message api.v1.DiffHeadersResponse
+ */
+export type DiffHeadersResponse = Message<"api.v1.DiffHeadersResponse"> & {
+  /**
+   * @generated from field: api.v1.BlockHeaderWithRoot block_a = 1;
+   */
+  blockA?: BlockHeaderWithRoot;
+
+  /**
+   * @generated from field: api.v1.BlockHeaderWithRoot block_b = 2;
+   */
+  blockB?: BlockHeaderWithRoot;
+
+  /**
+   * @generated from field: bool roots_match = 3;
+   */
+  rootsMatch: boolean;
+
+  /**
+   * In SSZ field order, empty if the roots match
+   *
+   * @generated from field: repeated api.v1.FieldDiff differences = 4;
+   */
+  differences: FieldDiff[];
+};
+
+/**
+ * Describes the message api.v1.DiffHeadersResponse.
+ * Use `create(DiffHeadersResponseSchema)` to create a new message.
+ */
+export const DiffHeadersResponseSchema: GenMessage<DiffHeadersResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proof, 6);
+
+/**
+ * ProofService proves block header fields against the block root with SSZ Merkle proofs
+ *
+ * @generated from service api.v1.ProofService
+ */
+export const ProofService: GenService<{
+  /**
+   * Get Merkle proofs of the fields of a block header
+   *
+   * @generated from rpc api.v1.ProofService.GetHeaderProof
+   */
+  getHeaderProof: {
+    methodKind: "unary";
+    input: typeof GetHeaderProofRequestSchema;
+    output: typeof GetHeaderProofResponseSchema;
+  },
+  /**
+   * Compare two block headers field by field, with proofs of every differing field
+   *
+   * @generated from rpc api.v1.ProofService.DiffHeaders
+   */
+  diffHeaders: {
+    methodKind: "unary";
+    input: typeof DiffHeadersRequestSchema;
+    output: typeof DiffHeadersResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_proof, 0);
+
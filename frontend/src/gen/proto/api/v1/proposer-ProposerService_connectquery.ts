@@ -0,0 +1,19 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/proposer.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { ProposerService } from "./proposer_pb";
+
+/**
+ * Get proposal statistics for a single proposer index
+ *
+ * @generated from rpc api.v1.ProposerService.GetProposerStats
+ */
+export const getProposerStats = ProposerService.method.getProposerStats;
+
+/**
+ * Get all proposers sorted by miss rate over a slot window
+ *
+ * @generated from rpc api.v1.ProposerService.GetProposerLeaderboard
+ */
+export const getProposerLeaderboard = ProposerService.method.getProposerLeaderboard;
@@ -0,0 +1,244 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/proposer.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/proposer.proto.
+ */
+export const file_proto_api_v1_proposer: GenFile = /*@__PURE__*/
+  fileDesc("Chtwcm90by9hcGkvdjEvcHJvcG9zZXIucHJvdG8SBmFwaS52MSLnAQoNUHJvcG9zZXJTdGF0cxIWCg5wcm9wb3Nlcl9pbmRleBgBIAEoBBIXCg9ibG9ja3NfcHJvcG9zZWQYAiABKAQSGgoSZXhwZWN0ZWRfcHJvcG9zYWxzGAMgASgEEhgKEG1pc3NlZF9wcm9wb3NhbHMYBCABKAQSGgoSb3JwaGFuZWRfcHJvcG9zYWxzGAUgASgEEhoKEmxhc3RfcHJvcG9zZWRfc2xvdBgGIAEoBBIRCgltaXNzX3JhdGUYByABKAESFAoMbWlzc2VkX3Nsb3RzGAggAygEEg4KBmNsaWVudBgJIAEoCSJBChdHZXRQcm9wb3NlclN0YXRzUmVxdWVzdBIWCg5wcm9wb3Nlcl9pbmRleBgBIAEoBBIOCgZ3aW5kb3cYAiABKAQifwoYR2V0UHJvcG9zZXJTdGF0c1Jlc3BvbnNlEiQKBXN0YXRzGAEgASgLMhUuYXBpLnYxLlByb3Bvc2VyU3RhdHMSEgoKc3RhcnRfc2xvdBgCIAEoBBIQCghlbmRfc2xvdBgDIAEoBBIXCg92YWxpZGF0b3JfY291bnQYBCABKAQiPgodR2V0UHJvcG9zZXJMZWFkZXJib2FyZFJlcXVlc3QSDgoGd2luZG93GAEgASgEEg0KBWxpbWl0GAIgASgNIokBCh5HZXRQcm9wb3NlckxlYWRlcmJvYXJkUmVzcG9uc2USKAoJcHJvcG9zZXJzGAEgAygLMhUuYXBpLnYxLlByb3Bvc2VyU3RhdHMSEgoKc3RhcnRfc2xvdBgCIAEoBBIQCghlbmRfc2xvdBgDIAEoBBIXCg92YWxpZGF0b3JfY291bnQYBCABKAQy0QEKD1Byb3Bvc2VyU2VydmljZRJVChBHZXRQcm9wb3NlclN0YXRzEh8uYXBpLnYxLkdldFByb3Bvc2VyU3RhdHNSZXF1ZXN0GiAuYXBpLnYxLkdldFByb3Bvc2VyU3RhdHNSZXNwb25zZRJnChZHZXRQcm9wb3NlckxlYWRlcmJvYXJkEiUuYXBpLnYxLkdldFByb3Bvc2VyTGVhZGVyYm9hcmRSZXF1ZXN0GiYuYXBpLnYxLkdldFByb3Bvc2VyTGVhZGVyYm9hcmRSZXNwb25zZUI7WjlnaXRodWIuY29tL3N5am45OS9sZWFuVmlldy9iYWNrZW5kL2dlbi9wcm90by9hcGkvdjE7YXBpdjFiBnByb3RvMw==");
+
+/**
+ * ProposerStats summarizes the proposals of one validator over a slot window
+ *
+ * @generated from message api.v1.ProposerStats
+ */
+export type ProposerStats = Message<"api.v1.ProposerStats"> & {
+  /**
+   * @generated from field: uint64 proposer_index = 1;
+   */
+  proposerIndex: bigint;
+
+  /**
+   * Blocks stored for this proposer within the window
+   *
+   * @generated from field: uint64 blocks_proposed = 2;
+   */
+  blocksProposed: bigint;
+
+  /**
+   * Slots assigned under round robin within the window
+   *
+   * @generated from field: uint64 expected_proposals = 3;
+   */
+  expectedProposals: bigint;
+
+  /**
+   * Assigned slots without a stored block
+   *
+   * @generated from field: uint64 missed_proposals = 4;
+   */
+  missedProposals: bigint;
+
+  /**
+   * Blocks the latest stored block does not descend from
+   *
+   * @generated from field: uint64 orphaned_proposals = 5;
+   */
+  orphanedProposals: bigint;
+
+  /**
+   * Latest slot proposed by this index (any time)
+   *
+   * @generated from field: uint64 last_proposed_slot = 6;
+   */
+  lastProposedSlot: bigint;
+
+  /**
+   * missed_proposals / expected_proposals
+   *
+   * @generated from field: double miss_rate = 7;
+   */
+  missRate: number;
+
+  /**
+   * Assigned slots without a stored block
+   *
+   * @generated from field: repeated uint64 missed_slots = 8;
+   */
+  missedSlots: bigint[];
+
+  /**
+   * Client running this validator (empty if unknown)
+   *
+   * @generated from field: string client = 9;
+   */
+  client: string;
+};
+
+/**
+ * Describes the message api.v1.ProposerStats.
+ * Use `create(ProposerStatsSchema)` to create a new message.
+ */
+export const ProposerStatsSchema: GenMessage<ProposerStats> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proposer, 0);
+
+/**
+ * GetProposerStatsRequest - statistics for one proposer
+ *
+ * @generated from message api.v1.GetProposerStatsRequest
+ */
+export type GetProposerStatsRequest = Message<"api.v1.GetProposerStatsRequest"> & {
+  /**
+   * @generated from field: uint64 proposer_index = 1;
+   */
+  proposerIndex: bigint;
+
+  /**
+   * Slots ending at the head (default: 256, max: 8192)
+   *
+   * @generated from field: uint64 window = 2;
+   */
+  window: bigint;
+};
+
+/**
+ * Describes the message api.v1.GetProposerStatsRequest.
+ * Use `create(GetProposerStatsRequestSchema)` to create a new message.
+ */
+export const GetProposerStatsRequestSchema: GenMessage<GetProposerStatsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proposer, 1);
+
+/**
+ * @generated from message api.v1.GetProposerStatsResponse
+ */
+export type GetProposerStatsResponse = Message<"api.v1.GetProposerStatsResponse"> & {
+  /**
+   * @generated from field: api.v1.ProposerStats stats = 1;
+   */
+  stats?: ProposerStats;
+
+  /**
+   * First slot of the window (inclusive)
+   *
+   * @generated from field: uint64 start_slot = 2;
+   */
+  startSlot: bigint;
+
+  /**
+   * Last slot of the window (inclusive)
+   *
+   * @generated from field: uint64 end_slot = 3;
+   */
+  endSlot: bigint;
+
+  /**
+   * Validator count used for round robin
+   *
+   * @generated from field: uint64 validator_count = 4;
+   */
+  validatorCount: bigint;
+};
+
+/**
+ * Describes the message api.v1.GetProposerStatsResponse.
+ * Use `create(GetProposerStatsResponseSchema)` to create a new message.
+ */
+export const GetProposerStatsResponseSchema: GenMessage<GetProposerStatsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proposer, 2);
+
+/**
+ * GetProposerLeaderboardRequest - proposers ranked by miss rate
+ *
+ * @generated from message api.v1.GetProposerLeaderboardRequest
+ */
+export type GetProposerLeaderboardRequest = Message<"api.v1.GetProposerLeaderboardRequest"> & {
+  /**
+   * Slots ending at the head (default: 256, max: 8192)
+   *
+   * @generated from field: uint64 window = 1;
+   */
+  window: bigint;
+
+  /**
+   * Max entries to return (default: all)
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message api.v1.GetProposerLeaderboardRequest.
+ * Use `create(GetProposerLeaderboardRequestSchema)` to create a new message.
+ */
+export const GetProposerLeaderboardRequestSchema: GenMessage<GetProposerLeaderboardRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proposer, 3);
+
+/**
+ * @generated from message api.v1.GetProposerLeaderboardResponse
+ */
+export type GetProposerLeaderboardResponse = Message<"api.v1.GetProposerLeaderboardResponse"> & {
+  /**
+   * Highest miss rate first
+   *
+   * @generated from field: repeated api.v1.ProposerStats proposers = 1;
+   */
+  proposers: ProposerStats[];
+
+  /**
+   * @generated from field: uint64 start_slot = 2;
+   */
+  startSlot: bigint;
+
+  /**
+   * @generated from field: uint64 end_slot = 3;
+   */
+  endSlot: bigint;
+
+  /**
+   * @generated from field: uint64 validator_count = 4;
+   */
+  validatorCount: bigint;
+};
+
+/**
+ * Describes the message api.v1.GetProposerLeaderboardResponse.
+ * Use `create(GetProposerLeaderboardResponseSchema)` to create a new message.
+ */
+export const GetProposerLeaderboardResponseSchema: GenMessage<GetProposerLeaderboardResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_proposer, 4);
+
+/**
+ * ProposerService provides round robin proposal statistics per proposer index
+ *
+ * @generated from service api.v1.ProposerService
+ */
+export const ProposerService: GenService<{
+  /**
+   * Get proposal statistics for a single proposer index
+   *
+   * @generated from rpc api.v1.ProposerService.GetProposerStats
+   */
+  getProposerStats: {
+    methodKind: "unary";
+    input: typeof GetProposerStatsRequestSchema;
+    output: typeof GetProposerStatsResponseSchema;
+  },
+  /**
+   * Get all proposers sorted by miss rate over a slot window
+   *
+   * @generated from rpc api.v1.ProposerService.GetProposerLeaderboard
+   */
+  getProposerLeaderboard: {
+    methodKind: "unary";
+    input: typeof GetProposerLeaderboardRequestSchema;
+    output: typeof GetProposerLeaderboardResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_proposer, 0);
+
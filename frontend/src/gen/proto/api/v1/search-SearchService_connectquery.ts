@@ -0,0 +1,12 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/search.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { SearchService } from "./search_pb";
+
+/**
+ * Search interprets the query and returns every matching entity
+ *
+ * @generated from rpc api.v1.SearchService.Search
+ */
+export const search = SearchService.method.search;
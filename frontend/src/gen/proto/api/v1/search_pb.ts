@@ -0,0 +1,215 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/search.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { BlockHeaderWithRoot } from "./block_pb";
+import { file_proto_api_v1_block } from "./block_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/search.proto.
+ */
+export const file_proto_api_v1_search: GenFile = /*@__PURE__*/
+  fileDesc("Chlwcm90by9hcGkvdjEvc2VhcmNoLnByb3RvEgZhcGkudjEiHgoNU2VhcmNoUmVxdWVzdBINCgVxdWVyeRgBIAEoCSJGCg5TZWFyY2hSZXNwb25zZRINCgVxdWVyeRgBIAEoCRIlCgdyZXN1bHRzGAIgAygLMhQuYXBpLnYxLlNlYXJjaFJlc3VsdCLeAgoMU2VhcmNoUmVzdWx0Ei0KBHR5cGUYASABKA4yHy5hcGkudjEuU2VhcmNoUmVzdWx0LlJlc3VsdFR5cGUSDQoFbGFiZWwYAiABKAkSDAoEbGluaxgDIAEoCRIqCgVibG9jaxgEIAEoCzIbLmFwaS52MS5CbG9ja0hlYWRlcldpdGhSb290EhYKDnByb3Bvc2VyX2luZGV4GAUgASgEEhMKC2Jsb2NrX2NvdW50GAYgASgNEhQKDGNsaWVudF9sYWJlbBgHIAEoCRIUCgxlbmRwb2ludF91cmwYCCABKAkifQoKUmVzdWx0VHlwZRILCgdVTktOT1dOEAASCAoEU0xPVBABEgwKCFBST1BPU0VSEAISDgoKQkxPQ0tfUk9PVBADEg8KC1BBUkVOVF9ST09UEAQSDgoKU1RBVEVfUk9PVBAFEg0KCUJPRFlfUk9PVBAGEgoKBkNMSUVOVBAHMkgKDVNlYXJjaFNlcnZpY2USNwoGU2VhcmNoEhUuYXBpLnYxLlNlYXJjaFJlcXVlc3QaFi5hcGkudjEuU2VhcmNoUmVzcG9uc2VCO1o5Z2l0aHViLmNvbS9zeWpuOTkvbGVhblZpZXcvYmFja2VuZC9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxYgZwcm90bzM=", [file_proto_api_v1_block]);
+
+/**
+ * SearchRequest - free text query
+ *
+ * @generated from message api.v1.SearchRequest
+ */
+export type SearchRequest = Message<"api.v1.SearchRequest"> & {
+  /**
+   * Decimal slot or proposer index, 0x-prefixed 32-byte root, or client label
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+};
+
+/**
+ * Describes the message api.v1.SearchRequest.
+ * Use `create(SearchRequestSchema)` to create a new message.
+ */
+export const SearchRequestSchema: GenMessage<SearchRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_search, 0);
+
+/**
+ * @generated from message api.v1.SearchResponse
+ */
+export type SearchResponse = Message<"api.v1.SearchResponse"> & {
+  /**
+   * Normalized query
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * Matches, most specific first
+   *
+   * @generated from field: repeated api.v1.SearchResult results = 2;
+   */
+  results: SearchResult[];
+};
+
+/**
+ * Describes the message api.v1.SearchResponse.
+ * Use `create(SearchResponseSchema)` to create a new message.
+ */
+export const SearchResponseSchema: GenMessage<SearchResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_search, 1);
+
+/**
+ * SearchResult is a single typed match with a link to its detail view
+ *
+ * @generated from message api.v1.SearchResult
+ */
+export type SearchResult = Message<"api.v1.SearchResult"> & {
+  /**
+   * @generated from field: api.v1.SearchResult.ResultType type = 1;
+   */
+  type: SearchResult_ResultType;
+
+  /**
+   * Short human readable description
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Relative UI path of the result
+   *
+   * @generated from field: string link = 3;
+   */
+  link: string;
+
+  /**
+   * Matched block (slot, proposer and root results)
+   *
+   * @generated from field: api.v1.BlockHeaderWithRoot block = 4;
+   */
+  block?: BlockHeaderWithRoot;
+
+  /**
+   * Proposer results only
+   *
+   * @generated from field: uint64 proposer_index = 5;
+   */
+  proposerIndex: bigint;
+
+  /**
+   * Number of blocks by the proposer
+   *
+   * @generated from field: uint32 block_count = 6;
+   */
+  blockCount: number;
+
+  /**
+   * Client results, or the client running a proposer
+   *
+   * @generated from field: string client_label = 7;
+   */
+  clientLabel: string;
+
+  /**
+   * Client results only
+   *
+   * @generated from field: string endpoint_url = 8;
+   */
+  endpointUrl: string;
+};
+
+/**
+ * Describes the message api.v1.SearchResult.
+ * Use `create(SearchResultSchema)` to create a new message.
+ */
+export const SearchResultSchema: GenMessage<SearchResult> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_search, 2);
+
+/**
+ * @generated from enum api.v1.SearchResult.ResultType
+ */
+export enum SearchResult_ResultType {
+  /**
+   * @generated from enum value: UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * Block at the queried slot
+   *
+   * @generated from enum value: SLOT = 1;
+   */
+  SLOT = 1,
+
+  /**
+   * Blocks proposed by the queried index
+   *
+   * @generated from enum value: PROPOSER = 2;
+   */
+  PROPOSER = 2,
+
+  /**
+   * Block whose hash tree root matches
+   *
+   * @generated from enum value: BLOCK_ROOT = 3;
+   */
+  BLOCK_ROOT = 3,
+
+  /**
+   * Block whose parent_root matches
+   *
+   * @generated from enum value: PARENT_ROOT = 4;
+   */
+  PARENT_ROOT = 4,
+
+  /**
+   * Block whose state_root matches
+   *
+   * @generated from enum value: STATE_ROOT = 5;
+   */
+  STATE_ROOT = 5,
+
+  /**
+   * Block whose body_root matches
+   *
+   * @generated from enum value: BODY_ROOT = 6;
+   */
+  BODY_ROOT = 6,
+
+  /**
+   * Configured lean node endpoint
+   *
+   * @generated from enum value: CLIENT = 7;
+   */
+  CLIENT = 7,
+}
+
+/**
+ * Describes the enum api.v1.SearchResult.ResultType.
+ */
+export const SearchResult_ResultTypeSchema: GenEnum<SearchResult_ResultType> = /*@__PURE__*/
+  enumDesc(file_proto_api_v1_search, 2, 0);
+
+/**
+ * SearchService resolves free text queries into slots, roots, proposers and clients
+ *
+ * @generated from service api.v1.SearchService
+ */
+export const SearchService: GenService<{
+  /**
+   * Search interprets the query and returns every matching entity
+   *
+   * @generated from rpc api.v1.SearchService.Search
+   */
+  search: {
+    methodKind: "unary";
+    input: typeof SearchRequestSchema;
+    output: typeof SearchResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_search, 0);
+
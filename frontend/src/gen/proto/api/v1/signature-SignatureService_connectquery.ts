@@ -0,0 +1,19 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/signature.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { SignatureService } from "./signature_pb";
+
+/**
+ * Get the stored signatures of the blocks at a slot or of a block root
+ *
+ * @generated from rpc api.v1.SignatureService.GetBlockSignatures
+ */
+export const getBlockSignatures = SignatureService.method.getBlockSignatures;
+
+/**
+ * Get signature and block size statistics per slot range and per fork
+ *
+ * @generated from rpc api.v1.SignatureService.GetSizeTrend
+ */
+export const getSizeTrend = SignatureService.method.getSizeTrend;
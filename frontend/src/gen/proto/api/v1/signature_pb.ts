@@ -0,0 +1,376 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/signature.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/signature.proto.
+ */
+export const file_proto_api_v1_signature: GenFile = /*@__PURE__*/
+  fileDesc("Chxwcm90by9hcGkvdjEvc2lnbmF0dXJlLnByb3RvEgZhcGkudjEiywEKDkJsb2NrU2lnbmF0dXJlEhIKCmJsb2NrX3Jvb3QYASABKAkSDAoEc2xvdBgCIAEoBBIWCg5wcm9wb3Nlcl9pbmRleBgDIAEoBBIMCgRmb3JrGAQgASgJEhEKCXNpZ25hdHVyZRgFIAEoCRIWCg5zaWduYXR1cmVfc2l6ZRgGIAEoBBISCgpibG9ja19zaXplGAcgASgEEg0KBXZvdGVzGAggASgEEg4KBmNsaWVudBgJIAEoCRITCgtvYnNlcnZlZF9hdBgKIAEoAyLzAQoJU2l6ZVN0YXRzEg4KBmJsb2NrcxgBIAEoBBISCgpmaXJzdF9zbG90GAIgASgEEhEKCWxhc3Rfc2xvdBgDIAEoBBIaChJtaW5fc2lnbmF0dXJlX3NpemUYBCABKAQSGgoSbWF4X3NpZ25hdHVyZV9zaXplGAUgASgEEhoKEmF2Z19zaWduYXR1cmVfc2l6ZRgGIAEoARIWCg5taW5fYmxvY2tfc2l6ZRgHIAEoBBIWCg5tYXhfYmxvY2tfc2l6ZRgIIAEoBBIWCg5hdmdfYmxvY2tfc2l6ZRgJIAEoARITCgt0b3RhbF9ieXRlcxgKIAEoBCJ2CgpTaXplQnVja2V0EhIKCnN0YXJ0X3Nsb3QYASABKAQSEAoIZW5kX3Nsb3QYAiABKAQSEgoKc3RhcnRfdGltZRgDIAEoAxIMCgRmb3JrGAQgASgJEiAKBXN0YXRzGAUgASgLMhEuYXBpLnYxLlNpemVTdGF0cyJrCg1Gb3JrU2l6ZVN0YXRzEgwKBGZvcmsYASABKAkSFwoPYWN0aXZhdGlvbl9zbG90GAIgASgEEhEKCXNjaGVkdWxlZBgDIAEoCBIgCgVzdGF0cxgEIAEoCzIRLmFwaS52MS5TaXplU3RhdHMiNwoZR2V0QmxvY2tTaWduYXR1cmVzUmVxdWVzdBIMCgRzbG90GAEgASgEEgwKBHJvb3QYAiABKAkiSAoaR2V0QmxvY2tTaWduYXR1cmVzUmVzcG9uc2USKgoKc2lnbmF0dXJlcxgBIAMoCzIWLmFwaS52MS5CbG9ja1NpZ25hdHVyZSJIChNHZXRTaXplVHJlbmRSZXF1ZXN0EhQKDGJ1Y2tldF9zbG90cxgBIAEoBBINCgVsaW1pdBgCIAEoDRIMCgRmb3JrGAMgASgJImEKFEdldFNpemVUcmVuZFJlc3BvbnNlEiMKB2J1Y2tldHMYASADKAsyEi5hcGkudjEuU2l6ZUJ1Y2tldBIkCgVmb3JrcxgCIAMoCzIVLmFwaS52MS5Gb3JrU2l6ZVN0YXRzMroBChBTaWduYXR1cmVTZXJ2aWNlElsKEkdldEJsb2NrU2lnbmF0dXJlcxIhLmFwaS52MS5HZXRCbG9ja1NpZ25hdHVyZXNSZXF1ZXN0GiIuYXBpLnYxLkdldEJsb2NrU2lnbmF0dXJlc1Jlc3BvbnNlEkkKDEdldFNpemVUcmVuZBIbLmFwaS52MS5HZXRTaXplVHJlbmRSZXF1ZXN0GhwuYXBpLnYxLkdldFNpemVUcmVuZFJlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z");
+
+/**
+ * BlockSignature is the proposer signature of a fetched block
+ *
+ * @generated from message api.v1.BlockSignature
+ */
+export type BlockSignature = Message<"api.v1.BlockSignature"> & {
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string block_root = 1;
+   */
+  blockRoot: string;
+
+  /**
+   * @generated from field: uint64 slot = 2;
+   */
+  slot: bigint;
+
+  /**
+   * @generated from field: uint64 proposer_index = 3;
+   */
+  proposerIndex: bigint;
+
+  /**
+   * Fork whose container layout the block was decoded in, e.g. devnet0
+   *
+   * @generated from field: string fork = 4;
+   */
+  fork: string;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string signature = 5;
+   */
+  signature: string;
+
+  /**
+   * Bytes
+   *
+   * @generated from field: uint64 signature_size = 6;
+   */
+  signatureSize: bigint;
+
+  /**
+   * SSZ encoded signed block size in the fork's layout
+   *
+   * @generated from field: uint64 block_size = 7;
+   */
+  blockSize: bigint;
+
+  /**
+   * @generated from field: uint64 votes = 8;
+   */
+  votes: bigint;
+
+  /**
+   * Client the block was first fetched from
+   *
+   * @generated from field: string client = 9;
+   */
+  client: string;
+
+  /**
+   * Unix timestamp in milliseconds
+   *
+   * @generated from field: int64 observed_at = 10;
+   */
+  observedAt: bigint;
+};
+
+/**
+ * Describes the message api.v1.BlockSignature.
+ * Use `create(BlockSignatureSchema)` to create a new message.
+ */
+export const BlockSignatureSchema: GenMessage<BlockSignature> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 0);
+
+/**
+ * SizeStats summarizes the signature and block sizes of a set of blocks
+ *
+ * @generated from message api.v1.SizeStats
+ */
+export type SizeStats = Message<"api.v1.SizeStats"> & {
+  /**
+   * @generated from field: uint64 blocks = 1;
+   */
+  blocks: bigint;
+
+  /**
+   * @generated from field: uint64 first_slot = 2;
+   */
+  firstSlot: bigint;
+
+  /**
+   * @generated from field: uint64 last_slot = 3;
+   */
+  lastSlot: bigint;
+
+  /**
+   * @generated from field: uint64 min_signature_size = 4;
+   */
+  minSignatureSize: bigint;
+
+  /**
+   * @generated from field: uint64 max_signature_size = 5;
+   */
+  maxSignatureSize: bigint;
+
+  /**
+   * @generated from field: double avg_signature_size = 6;
+   */
+  avgSignatureSize: number;
+
+  /**
+   * @generated from field: uint64 min_block_size = 7;
+   */
+  minBlockSize: bigint;
+
+  /**
+   * @generated from field: uint64 max_block_size = 8;
+   */
+  maxBlockSize: bigint;
+
+  /**
+   * @generated from field: double avg_block_size = 9;
+   */
+  avgBlockSize: number;
+
+  /**
+   * Sum of the block sizes
+   *
+   * @generated from field: uint64 total_bytes = 10;
+   */
+  totalBytes: bigint;
+};
+
+/**
+ * Describes the message api.v1.SizeStats.
+ * Use `create(SizeStatsSchema)` to create a new message.
+ */
+export const SizeStatsSchema: GenMessage<SizeStats> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 1);
+
+/**
+ * SizeBucket is the size statistics of the blocks of one fork in a slot range
+ *
+ * @generated from message api.v1.SizeBucket
+ */
+export type SizeBucket = Message<"api.v1.SizeBucket"> & {
+  /**
+   * @generated from field: uint64 start_slot = 1;
+   */
+  startSlot: bigint;
+
+  /**
+   * Inclusive
+   *
+   * @generated from field: uint64 end_slot = 2;
+   */
+  endSlot: bigint;
+
+  /**
+   * Unix timestamp in milliseconds of start_slot, 0 without chain.genesisTime
+   *
+   * @generated from field: int64 start_time = 3;
+   */
+  startTime: bigint;
+
+  /**
+   * @generated from field: string fork = 4;
+   */
+  fork: string;
+
+  /**
+   * @generated from field: api.v1.SizeStats stats = 5;
+   */
+  stats?: SizeStats;
+};
+
+/**
+ * Describes the message api.v1.SizeBucket.
+ * Use `create(SizeBucketSchema)` to create a new message.
+ */
+export const SizeBucketSchema: GenMessage<SizeBucket> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 2);
+
+/**
+ * ForkSizeStats is the size statistics of all stored blocks of a fork
+ *
+ * @generated from message api.v1.ForkSizeStats
+ */
+export type ForkSizeStats = Message<"api.v1.ForkSizeStats"> & {
+  /**
+   * @generated from field: string fork = 1;
+   */
+  fork: string;
+
+  /**
+   * Slot the fork activates at in the configured schedule
+   *
+   * @generated from field: uint64 activation_slot = 2;
+   */
+  activationSlot: bigint;
+
+  /**
+   * Whether the fork is in the configured schedule
+   *
+   * @generated from field: bool scheduled = 3;
+   */
+  scheduled: boolean;
+
+  /**
+   * @generated from field: api.v1.SizeStats stats = 4;
+   */
+  stats?: SizeStats;
+};
+
+/**
+ * Describes the message api.v1.ForkSizeStats.
+ * Use `create(ForkSizeStatsSchema)` to create a new message.
+ */
+export const ForkSizeStatsSchema: GenMessage<ForkSizeStats> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 3);
+
+/**
+ * GetBlockSignaturesRequest - the blocks at a slot, or a single block by root
+ *
+ * @generated from message api.v1.GetBlockSignaturesRequest
+ */
+export type GetBlockSignaturesRequest = Message<"api.v1.GetBlockSignaturesRequest"> & {
+  /**
+   * @generated from field: uint64 slot = 1;
+   */
+  slot: bigint;
+
+  /**
+   * Block root hex encoded with 0x prefix, takes precedence over slot
+   *
+   * @generated from field: string root = 2;
+   */
+  root: string;
+};
+
+/**
+ * Describes the message api.v1.GetBlockSignaturesRequest.
+ * Use `create(GetBlockSignaturesRequestSchema)` to create a new message.
+ */
+export const GetBlockSignaturesRequestSchema: GenMessage<GetBlockSignaturesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 4);
+
+/**
+ * GetBlockSignaturesResponse - the stored signatures, empty if none of the blocks was fetched
+ *
+ * @generated from message api.v1.GetBlockSignaturesResponse
+ */
+export type GetBlockSignaturesResponse = Message<"api.v1.GetBlockSignaturesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.BlockSignature signatures = 1;
+   */
+  signatures: BlockSignature[];
+};
+
+/**
+ * Describes the message api.v1.GetBlockSignaturesResponse.
+ * Use `create(GetBlockSignaturesResponseSchema)` to create a new message.
+ */
+export const GetBlockSignaturesResponseSchema: GenMessage<GetBlockSignaturesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 5);
+
+/**
+ * GetSizeTrendRequest - buckets of bucket_slots slots, latest first
+ *
+ * @generated from message api.v1.GetSizeTrendRequest
+ */
+export type GetSizeTrendRequest = Message<"api.v1.GetSizeTrendRequest"> & {
+  /**
+   * Slots per bucket, default 32
+   *
+   * @generated from field: uint64 bucket_slots = 1;
+   */
+  bucketSlots: bigint;
+
+  /**
+   * Buckets, default 50, max 500
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * Only blocks of this fork, empty for all forks
+   *
+   * @generated from field: string fork = 3;
+   */
+  fork: string;
+};
+
+/**
+ * Describes the message api.v1.GetSizeTrendRequest.
+ * Use `create(GetSizeTrendRequestSchema)` to create a new message.
+ */
+export const GetSizeTrendRequestSchema: GenMessage<GetSizeTrendRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 6);
+
+/**
+ * GetSizeTrendResponse - size buckets and the totals of every fork
+ *
+ * @generated from message api.v1.GetSizeTrendResponse
+ */
+export type GetSizeTrendResponse = Message<"api.v1.GetSizeTrendResponse"> & {
+  /**
+   * Latest first, a range spanning a fork activation has a bucket per fork
+   *
+   * @generated from field: repeated api.v1.SizeBucket buckets = 1;
+   */
+  buckets: SizeBucket[];
+
+  /**
+   * Stored and scheduled forks in activation order
+   *
+   * @generated from field: repeated api.v1.ForkSizeStats forks = 2;
+   */
+  forks: ForkSizeStats[];
+};
+
+/**
+ * Describes the message api.v1.GetSizeTrendResponse.
+ * Use `create(GetSizeTrendResponseSchema)` to create a new message.
+ */
+export const GetSizeTrendResponseSchema: GenMessage<GetSizeTrendResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_signature, 7);
+
+/**
+ * SignatureService exposes the stored block signatures and how signature and block sizes evolve across forks
+ *
+ * @generated from service api.v1.SignatureService
+ */
+export const SignatureService: GenService<{
+  /**
+   * Get the stored signatures of the blocks at a slot or of a block root
+   *
+   * @generated from rpc api.v1.SignatureService.GetBlockSignatures
+   */
+  getBlockSignatures: {
+    methodKind: "unary";
+    input: typeof GetBlockSignaturesRequestSchema;
+    output: typeof GetBlockSignaturesResponseSchema;
+  },
+  /**
+   * Get signature and block size statistics per slot range and per fork
+   *
+   * @generated from rpc api.v1.SignatureService.GetSizeTrend
+   */
+  getSizeTrend: {
+    methodKind: "unary";
+    input: typeof GetSizeTrendRequestSchema;
+    output: typeof GetSizeTrendResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_signature, 0);
+
@@ -0,0 +1,19 @@
+// @generated by protoc-gen-connect-query v2.1.1 with parameter "target=ts"
+// @generated from file proto/api/v1/state.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import { StateService } from "./state_pb";
+
+/**
+ * Get a state's checkpoints and justification bitfields, with its block hashes compared to the indexed chain
+ *
+ * @generated from rpc api.v1.StateService.GetState
+ */
+export const getState = StateService.method.getState;
+
+/**
+ * List the stored state snapshots
+ *
+ * @generated from rpc api.v1.StateService.GetStateSnapshots
+ */
+export const getStateSnapshots = StateService.method.getStateSnapshots;
@@ -0,0 +1,373 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file proto/api/v1/state.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { BlockHeader } from "./block_pb";
+import { file_proto_api_v1_block } from "./block_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/api/v1/state.proto.
+ */
+export const file_proto_api_v1_state: GenFile = /*@__PURE__*/
+  fileDesc("Chhwcm90by9hcGkvdjEvc3RhdGUucHJvdG8SBmFwaS52MSKqAgoNU3RhdGVTbmFwc2hvdBISCgpzdGF0ZV9yb290GAEgASgJEgwKBHNsb3QYAiABKAQSEgoKYmxvY2tfcm9vdBgDIAEoCRIOCgZjbGllbnQYBCABKAkSFgoOanVzdGlmaWVkX3Nsb3QYBSABKAQSFgoOanVzdGlmaWVkX3Jvb3QYBiABKAkSFgoOZmluYWxpemVkX3Nsb3QYByABKAQSFgoOZmluYWxpemVkX3Jvb3QYCCABKAkSEgoKc2l6ZV9ieXRlcxgJIAEoBBIWCg5jaGVja2VkX2hhc2hlcxgKIAEoBBIZChFtaXNtYXRjaGVkX2hhc2hlcxgLIAEoBBIYChB1bmluZGV4ZWRfaGFzaGVzGAwgASgEEhIKCmZldGNoZWRfYXQYDSABKAMiaQoUUGVuZGluZ0p1c3RpZmljYXRpb24SDAoEcm9vdBgBIAEoCRIMCgRzbG90GAIgASgEEhIKCnNsb3Rfa25vd24YAyABKAgSDQoFdm90ZXMYBCABKAQSEgoKdmFsaWRhdG9ycxgFIAEoCSJSCgxIYXNoTWlzbWF0Y2gSDAoEc2xvdBgBIAEoBBIYChBzdGF0ZV9ibG9ja19yb290GAIgASgJEhoKEmluZGV4ZWRfYmxvY2tfcm9vdBgDIAEoCSI9Cg9HZXRTdGF0ZVJlcXVlc3QSDAoEc2xvdBgBIAEoBBIMCgRyb290GAIgASgJEg4KBmNsaWVudBgDIAEoCSLuAgoQR2V0U3RhdGVSZXNwb25zZRInCghzbmFwc2hvdBgBIAEoCzIVLmFwaS52MS5TdGF0ZVNuYXBzaG90EhYKDm51bV92YWxpZGF0b3JzGAIgASgEEhQKDGdlbmVzaXNfdGltZRgDIAEoBBIwChNsYXRlc3RfYmxvY2tfaGVhZGVyGAQgASgLMhMuYXBpLnYxLkJsb2NrSGVhZGVyEh8KF2hpc3RvcmljYWxfYmxvY2tfaGFzaGVzGAUgASgEEhcKD2p1c3RpZmllZF9zbG90cxgGIAEoCRIeChZqdXN0aWZpZWRfc2xvdHNfbGVuZ3RoGAcgASgEEjQKDmp1c3RpZmljYXRpb25zGAggAygLMhwuYXBpLnYxLlBlbmRpbmdKdXN0aWZpY2F0aW9uEigKCm1pc21hdGNoZXMYCSADKAsyFC5hcGkudjEuSGFzaE1pc21hdGNoEhcKD3VuaW5kZXhlZF9zbG90cxgKIAMoBCIpChhHZXRTdGF0ZVNuYXBzaG90c1JlcXVlc3QSDQoFbGltaXQYASABKA0iRQoZR2V0U3RhdGVTbmFwc2hvdHNSZXNwb25zZRIoCglzbmFwc2hvdHMYASADKAsyFS5hcGkudjEuU3RhdGVTbmFwc2hvdDKnAQoMU3RhdGVTZXJ2aWNlEj0KCEdldFN0YXRlEhcuYXBpLnYxLkdldFN0YXRlUmVxdWVzdBoYLmFwaS52MS5HZXRTdGF0ZVJlc3BvbnNlElgKEUdldFN0YXRlU25hcHNob3RzEiAuYXBpLnYxLkdldFN0YXRlU25hcHNob3RzUmVxdWVzdBohLmFwaS52MS5HZXRTdGF0ZVNuYXBzaG90c1Jlc3BvbnNlQjtaOWdpdGh1Yi5jb20vc3lqbjk5L2xlYW5WaWV3L2JhY2tlbmQvZ2VuL3Byb3RvL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_proto_api_v1_block]);
+
+/**
+ * StateSnapshot is a state fetched from a client
+ *
+ * @generated from message api.v1.StateSnapshot
+ */
+export type StateSnapshot = Message<"api.v1.StateSnapshot"> & {
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string state_root = 1;
+   */
+  stateRoot: string;
+
+  /**
+   * @generated from field: uint64 slot = 2;
+   */
+  slot: bigint;
+
+  /**
+   * Root of the state's latest block, hex encoded with 0x prefix
+   *
+   * @generated from field: string block_root = 3;
+   */
+  blockRoot: string;
+
+  /**
+   * Client the state was fetched from
+   *
+   * @generated from field: string client = 4;
+   */
+  client: string;
+
+  /**
+   * @generated from field: uint64 justified_slot = 5;
+   */
+  justifiedSlot: bigint;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string justified_root = 6;
+   */
+  justifiedRoot: string;
+
+  /**
+   * @generated from field: uint64 finalized_slot = 7;
+   */
+  finalizedSlot: bigint;
+
+  /**
+   * Hex encoded with 0x prefix
+   *
+   * @generated from field: string finalized_root = 8;
+   */
+  finalizedRoot: string;
+
+  /**
+   * SSZ encoded size
+   *
+   * @generated from field: uint64 size_bytes = 9;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * Block hashes compared with the indexed chain when fetched
+   *
+   * @generated from field: uint64 checked_hashes = 10;
+   */
+  checkedHashes: bigint;
+
+  /**
+   * Compared block hashes that differ from the indexed chain
+   *
+   * @generated from field: uint64 mismatched_hashes = 11;
+   */
+  mismatchedHashes: bigint;
+
+  /**
+   * Block hashes of slots without an indexed header
+   *
+   * @generated from field: uint64 unindexed_hashes = 12;
+   */
+  unindexedHashes: bigint;
+
+  /**
+   * Unix timestamp in milliseconds
+   *
+   * @generated from field: int64 fetched_at = 13;
+   */
+  fetchedAt: bigint;
+};
+
+/**
+ * Describes the message api.v1.StateSnapshot.
+ * Use `create(StateSnapshotSchema)` to create a new message.
+ */
+export const StateSnapshotSchema: GenMessage<StateSnapshot> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_state, 0);
+
+/**
+ * PendingJustification is a target root with votes that did not justify it yet
+ *
+ * @generated from message api.v1.PendingJustification
+ */
+export type PendingJustification = Message<"api.v1.PendingJustification"> & {
+  /**
+   * Block root hex encoded with 0x prefix
+   *
+   * @generated from field: string root = 1;
+   */
+  root: string;
+
+  /**
+   * Slot of the root in historical_block_hashes
+   *
+   * @generated from field: uint64 slot = 2;
+   */
+  slot: bigint;
+
+  /**
+   * Whether the root is in historical_block_hashes
+   *
+   * @generated from field: bool slot_known = 3;
+   */
+  slotKnown: boolean;
+
+  /**
+   * @generated from field: uint64 votes = 4;
+   */
+  votes: bigint;
+
+  /**
+   * Bitfield of the voting validators, bit i of byte i/8 for validator i, hex encoded with 0x prefix
+   *
+   * @generated from field: string validators = 5;
+   */
+  validators: string;
+};
+
+/**
+ * Describes the message api.v1.PendingJustification.
+ * Use `create(PendingJustificationSchema)` to create a new message.
+ */
+export const PendingJustificationSchema: GenMessage<PendingJustification> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_state, 1);
+
+/**
+ * HashMismatch is a slot whose block hash in the state differs from the indexed chain
+ *
+ * @generated from message api.v1.HashMismatch
+ */
+export type HashMismatch = Message<"api.v1.HashMismatch"> & {
+  /**
+   * @generated from field: uint64 slot = 1;
+   */
+  slot: bigint;
+
+  /**
+   * Block root in the state, zero for a missed slot, hex encoded with 0x prefix
+   *
+   * @generated from field: string state_block_root = 2;
+   */
+  stateBlockRoot: string;
+
+  /**
+   * Root of the indexed header, hex encoded with 0x prefix
+   *
+   * @generated from field: string indexed_block_root = 3;
+   */
+  indexedBlockRoot: string;
+};
+
+/**
+ * Describes the message api.v1.HashMismatch.
+ * Use `create(HashMismatchSchema)` to create a new message.
+ */
+export const HashMismatchSchema: GenMessage<HashMismatch> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_state, 2);
+
+/**
+ * GetStateRequest - a stored snapshot, or the state a client serves, by slot or state root
+ *
+ * @generated from message api.v1.GetStateRequest
+ */
+export type GetStateRequest = Message<"api.v1.GetStateRequest"> & {
+  /**
+   * @generated from field: uint64 slot = 1;
+   */
+  slot: bigint;
+
+  /**
+   * State root hex encoded with 0x prefix, takes precedence over slot
+   *
+   * @generated from field: string root = 2;
+   */
+  root: string;
+
+  /**
+   * Fetch the state from this client instead of the stored snapshots
+   *
+   * @generated from field: string client = 3;
+   */
+  client: string;
+};
+
+/**
+ * Describes the message api.v1.GetStateRequest.
+ * Use `create(GetStateRequestSchema)` to create a new message.
+ */
+export const GetStateRequestSchema: GenMessage<GetStateRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_state, 3);
+
+/**
+ * @generated from message api.v1.GetStateResponse
+ */
+export type GetStateResponse = Message<"api.v1.GetStateResponse"> & {
+  /**
+   * Hash counts as of the fetch, now for a state fetched from a client
+   *
+   * @generated from field: api.v1.StateSnapshot snapshot = 1;
+   */
+  snapshot?: StateSnapshot;
+
+  /**
+   * @generated from field: uint64 num_validators = 2;
+   */
+  numValidators: bigint;
+
+  /**
+   * @generated from field: uint64 genesis_time = 3;
+   */
+  genesisTime: bigint;
+
+  /**
+   * As in the state, the state root is zero after a block
+   *
+   * @generated from field: api.v1.BlockHeader latest_block_header = 4;
+   */
+  latestBlockHeader?: BlockHeader;
+
+  /**
+   * Number of block hashes
+   *
+   * @generated from field: uint64 historical_block_hashes = 5;
+   */
+  historicalBlockHashes: bigint;
+
+  /**
+   * Bitfield of justified slots, bit i of byte i/8 for slot i, hex encoded with 0x prefix
+   *
+   * @generated from field: string justified_slots = 6;
+   */
+  justifiedSlots: string;
+
+  /**
+   * Slots covered by the bitfield
+   *
+   * @generated from field: uint64 justified_slots_length = 7;
+   */
+  justifiedSlotsLength: bigint;
+
+  /**
+   * In state order
+   *
+   * @generated from field: repeated api.v1.PendingJustification justifications = 8;
+   */
+  justifications: PendingJustification[];
+
+  /**
+   * Compared with the indexed chain now, in slot order
+   *
+   * @generated from field: repeated api.v1.HashMismatch mismatches = 9;
+   */
+  mismatches: HashMismatch[];
+
+  /**
+   * Slots with a block in the state but no indexed header, in slot order
+   *
+   * @generated from field: repeated uint64 unindexed_slots = 10;
+   */
+  unindexedSlots: bigint[];
+};
+
+/**
+ * Describes the message api.v1.GetStateResponse.
+ * Use `create(GetStateResponseSchema)` to create a new message.
+ */
+export const GetStateResponseSchema: GenMessage<GetStateResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_state, 4);
+
+/**
+ * GetStateSnapshotsRequest - highest slot first
+ *
+ * @generated from message api.v1.GetStateSnapshotsRequest
+ */
+export type GetStateSnapshotsRequest = Message<"api.v1.GetStateSnapshotsRequest"> & {
+  /**
+   * Max snapshots to return (default: 50, max: 500)
+   *
+   * @generated from field: uint32 limit = 1;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message api.v1.GetStateSnapshotsRequest.
+ * Use `create(GetStateSnapshotsRequestSchema)` to create a new message.
+ */
+export const GetStateSnapshotsRequestSchema: GenMessage<GetStateSnapshotsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_state, 5);
+
+/**
+ * @generated from message api.v1.GetStateSnapshotsResponse
+ */
+export type GetStateSnapshotsResponse = Message<"api.v1.GetStateSnapshotsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.StateSnapshot snapshots = 1;
+   */
+  snapshots: StateSnapshot[];
+};
+
+/**
+ * Describes the message api.v1.GetStateSnapshotsResponse.
+ * Use `create(GetStateSnapshotsResponseSchema)` to create a new message.
+ */
+export const GetStateSnapshotsResponseSchema: GenMessage<GetStateSnapshotsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_api_v1_state, 6);
+
+/**
+ * StateService inspects the states the indexer stores as snapshots or fetches from clients
+ *
+ * @generated from service api.v1.StateService
+ */
+export const StateService: GenService<{
+  /**
+   * Get a state's checkpoints and justification bitfields, with its block hashes compared to the indexed chain
+   *
+   * @generated from rpc api.v1.StateService.GetState
+   */
+  getState: {
+    methodKind: "unary";
+    input: typeof GetStateRequestSchema;
+    output: typeof GetStateResponseSchema;
+  },
+  /**
+   * List the stored state snapshots
+   *
+   * @generated from rpc api.v1.StateService.GetStateSnapshots
+   */
+  getStateSnapshots: {
+    methodKind: "unary";
+    input: typeof GetStateSnapshotsRequestSchema;
+    output: typeof GetStateSnapshotsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_api_v1_state, 0);
+